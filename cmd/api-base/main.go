@@ -25,6 +25,8 @@ var (
 )
 
 const captchaSrvDomain = "dns:///srv-captcha:9090"
+const contentSrvDomain = "dns:///srv-content:9091"
+const messageSrvDomain = "dns:///srv-message:9092"
 const uaaSrvDomain = "dns:///srv-uaa:9093"
 
 func init() {
@@ -59,6 +61,15 @@ func main() {
 		log.Fatal(err)
 	}
 
+	revokedFunc, err := clients.UaaRevokedFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+	personalTokenFunc, err := clients.UaaPersonalTokenFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	jwtMiddleware, err := gin_jwt.NewGinJwtMiddleware(gin_jwt.MiddlewareConfig{
 		Realm:   "base.teddy.com",
 		Issuer:  "uaa@teddy.com",
@@ -66,6 +77,8 @@ func main() {
 		Audience: []string{
 			"base",
 		},
+		RevokedFunc:       gin_jwt.CachedRevokedFunc(revokedFunc, 30*time.Second),
+		PersonalTokenFunc: gin_jwt.CachedPersonalTokenFunc(personalTokenFunc, 30*time.Second),
 		ErrorHandler: func(ctx *gin.Context, err error) {
 			ctx.Header("WWW-Authenticate", "JWT realm=base.teddy.com")
 			if err == gin_jwt.ErrForbidden {
@@ -104,6 +117,11 @@ func main() {
 		log.Fatal(err)
 	}
 
+	exportHandler, err := base.NewExportHandler(jwtMiddleware, minioClient, minioConfig.Bucket)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	healthHandler, err := base.NewHealthHandler(jwtMiddleware)
 	if err != nil {
 		log.Fatal(err)
@@ -111,6 +129,7 @@ func main() {
 
 	// Create RESTful server (using Gin)
 	router := gin.Default()
+	router.Use(clients.ClientIPNew(confType.Server.TrustedIPHeader))
 	router.Use(cors.New(cors.Config{
 		AllowMethods:     []string{"GET", "POST", "PUT", "HEAD", "DELETE"},
 		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
@@ -122,12 +141,23 @@ func main() {
 
 	baseGroup := router.Group("/v1/anon/base")
 	baseGroup.Use(clients.CaptchaNew(captchaSrvDomain))
+	baseGroup.Use(clients.UaaNew(uaaSrvDomain))
 	baseHandler.HandlerNormal(baseGroup.Use(jwtMiddleware.Handler()))
-	baseHandler.HandlerAuth(baseGroup.Use(jwtMiddleware.Handler()))
+	exportHandler.HandlerNormal(baseGroup)
+
+	baseAuthGroup := router.Group("/v1/auth/base")
+	baseAuthGroup.Use(clients.UaaNew(uaaSrvDomain))
+	baseAuthGroup.Use(clients.ContentNew(contentSrvDomain))
+	baseAuthGroup.Use(clients.MessageNew(messageSrvDomain))
+	baseHandler.HandlerAuth(baseAuthGroup.Use(jwtMiddleware.Handler()))
+	exportHandler.HandlerAuth(baseAuthGroup)
 
 	imageGroup := router.Group("/v1/anon/image")
 	imageHandler.HandlerNormal(imageGroup.Use(jwtMiddleware.Handler()))
-	imageHandler.HandlerAuth(imageGroup.Use(jwtMiddleware.Handler()))
+
+	imageAuthGroup := router.Group("/v1/auth/image")
+	imageAuthGroup.Use(clients.UaaNew(uaaSrvDomain))
+	imageHandler.HandlerAuth(imageAuthGroup.Use(jwtMiddleware.Handler()))
 
 	// For normal request
 	srv1 := http.Server{
@@ -60,6 +60,15 @@ func main() {
 		log.Fatal(err)
 	}
 
+	revokedFunc, err := clients.UaaRevokedFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+	personalTokenFunc, err := clients.UaaPersonalTokenFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	jwtMiddleware, err := gin_jwt.NewGinJwtMiddleware(gin_jwt.MiddlewareConfig{
 		Realm:   "content.teddy.com",
 		Issuer:  "uaa@teddy.com",
@@ -67,6 +76,8 @@ func main() {
 		Audience: []string{
 			"content",
 		},
+		RevokedFunc:       gin_jwt.CachedRevokedFunc(revokedFunc, 30*time.Second),
+		PersonalTokenFunc: gin_jwt.CachedPersonalTokenFunc(personalTokenFunc, 30*time.Second),
 	}, adapter)
 	if err != nil {
 		log.Fatal(err)
@@ -1,7 +1,24 @@
 package main
 
-import "teddy-backend/internal/types"
+import (
+	"teddy-backend/internal/types"
+	"teddy-backend/pkg/oauth"
+)
 
 type Config struct {
-	Server types.Server `json:"server"`
+	Server types.Server            `json:"server"`
+	OAuth  map[string]oauth.Config `mapstructure:"oauth"`
+	// Calling code for phone numbers given without one, e.g. "86"
+	DefaultCallingCode string `mapstructure:"default_calling_code"`
+	// Base of links sent by email and issuer of ID tokens, required, e.g.
+	// "https://api.teddy.com"
+	PublicURL string `mapstructure:"public_url"`
+	// Audience of ID tokens, the client id OIDC clients are configured with
+	OIDCClientID string `mapstructure:"oidc_client_id"`
+	// Login page of the web app users are sent to by the authorization
+	// endpoint, it confirms the request once they logged in, required
+	OIDCLoginURL string `mapstructure:"oidc_login_url"`
+	// Files in secret/ of keys replaced by JWTPkcs8, published until tokens
+	// they signed expire
+	JwtRetiringKeys []string `mapstructure:"jwt_retiring_keys"`
 }
@@ -18,6 +18,7 @@ import (
 	"teddy-backend/pkg/config"
 	"teddy-backend/pkg/config/source/file"
 	"teddy-backend/pkg/grpcadapter"
+	"teddy-backend/pkg/oauth"
 	"time"
 )
 
@@ -40,28 +41,43 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	var confType Config
+	confType := Config{OIDCClientID: "teddy"}
 	err = conf.Scan(&confType)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	certPEM, err := ioutil.ReadFile("secret/JWTPkcs8")
+	confSecret, err := config.NewConfig(file.NewSource(file.WithFormat(config.Yaml), file.WithPath("secret/config.yaml")))
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	err = confSecret.Scan(&confType)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Issuer of ID tokens, it can't come from the requests
+	if confType.PublicURL == "" {
+		log.Fatal("public_url must be set")
+	}
+	if confType.OIDCLoginURL == "" {
+		log.Fatal("oidc_login_url must be set")
+	}
+
 	// New jwt generator and extractor
 	const SigningAlgorithm = "RS256"
-	// Load Jwt PublicKey
-	block, _ := pem.Decode(certPEM)
-	if block == nil {
-		log.Fatal("Jwt private key decode error")
-	}
-	parseResult, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	key, err := loadJwtKey("secret/JWTPkcs8")
 	if err != nil {
 		log.Fatal(err)
 	}
-	key := parseResult.(*rsa.PrivateKey)
+	retiringKeys := make([]interface{}, 0, len(confType.JwtRetiringKeys))
+	for _, v := range confType.JwtRetiringKeys {
+		retiringKey, err := loadJwtKey("secret/" + v)
+		if err != nil {
+			log.Fatal(err)
+		}
+		retiringKeys = append(retiringKeys, retiringKey)
+	}
 
 	jwtGenerator, err := gin_jwt.NewGinJwtGenerator(gin_jwt.GeneratorConfig{
 		Issuer:           "uaa@teddy.com",
@@ -69,6 +85,7 @@ func main() {
 		KeyFunc: func() interface{} {
 			return key
 		},
+		RetiringKeys: retiringKeys,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -79,20 +96,40 @@ func main() {
 		log.Fatal(err)
 	}
 
+	revokedFunc, err := clients.UaaRevokedFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+	personalTokenFunc, err := clients.UaaPersonalTokenFunc(uaaSrvDomain)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	jwtMiddleware, err := gin_jwt.NewGinJwtMiddleware(gin_jwt.MiddlewareConfig{
-		Realm:  "uaa.teddy.com",
-		Issuer: "uaa@teddy.com",
-		KeyFunc: func() interface{} {
-			return key.Public()
-		},
+		Realm:   "uaa.teddy.com",
+		Issuer:  "uaa@teddy.com",
+		KeyFunc: jwtGenerator.KeyFunc(),
 		Audience: []string{
 			"uaa",
 		},
+		RevokedFunc:       gin_jwt.CachedRevokedFunc(revokedFunc, 30*time.Second),
+		PersonalTokenFunc: gin_jwt.CachedPersonalTokenFunc(personalTokenFunc, 30*time.Second),
+		// Personal tokens are for automation, never for managing the account
+		PersonalTokenDenyPrefixes: []string{"/v1/auth/uaa"},
+		PersonalTokenAllowPaths:   []string{"/v1/auth/uaa/userinfo"},
+		// Clients authenticate with basic auth there
+		OtherSchemePaths: []string{"/v1/anon/uaa/token"},
 	}, adapter)
 	if err != nil {
 		log.Fatal(err)
 	}
-	uaaHandler, err := uaa.NewUaaHandler(jwtMiddleware, jwtGenerator)
+	oauthProviders, err := oauth.NewProviders(confType.OAuth)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	uaaHandler, err := uaa.NewUaaHandler(jwtMiddleware, jwtGenerator, oauthProviders, confType.DefaultCallingCode,
+		confType.PublicURL, confType.OIDCClientID, confType.OIDCLoginURL)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -104,8 +141,9 @@ func main() {
 		path := ctx.Request.URL.Path
 		ctx.Next()
 
-		log.Infof("PATH %s HEADERS %v", path, ctx.Request.Header)
+		log.Infof("PATH %s HEADERS %v", path, redactHeader(ctx.Request.Header))
 	})
+	router.Use(clients.ClientIPNew(confType.Server.TrustedIPHeader))
 	router.Use(cors.New(cors.Config{
 		AllowMethods:     []string{"GET", "POST", "PUT", "HEAD", "DELETE"},
 		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
@@ -116,6 +154,8 @@ func main() {
 	router.Use(clients.MessageNew(messageSrvDomain))
 	router.Use(clients.UaaNew(uaaSrvDomain))
 	router.Use(clients.CaptchaNew(captchaSrvDomain))
+	router.Use(clients.PolicyAdapterNew(uaaSrvDomain))
+	router.Use(clients.AuditNew(uaaSrvDomain))
 
 	uaaHandler.HandlerNormal(router.Group("/v1/anon/uaa").Use(jwtMiddleware.Handler()))
 	uaaHandler.HandlerAuth(router.Group("/v1/auth/uaa").Use(jwtMiddleware.Handler()))
@@ -149,3 +189,42 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// Headers carrying credentials, e.g. client secrets by basic auth and
+// personal tokens, never logged
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactHeader copies header with credentials hidden for logging.
+func redactHeader(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for k, v := range header {
+		redacted[k] = v
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := redacted[k]; ok {
+			redacted[k] = []string{"[REDACTED]"}
+		}
+	}
+	return redacted
+}
+
+// loadJwtKey reads a PEM encoded PKCS8 RSA private key.
+func loadJwtKey(path string) (*rsa.PrivateKey, error) {
+	certPEM, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("jwt private key %s decode error", path)
+	}
+	parseResult, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parseResult.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("jwt private key %s isn't rsa", path)
+	}
+	return key, nil
+}
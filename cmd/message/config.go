@@ -1,9 +1,13 @@
 package main
 
-import "teddy-backend/internal/types"
+import (
+	"teddy-backend/internal/types"
+	"teddy-backend/pkg/sms"
+)
 
 type Config struct {
 	Server    types.Server      `mapstructure:"server"`
 	Databases map[string]string `mapstructure:"databases"`
 	Mail      types.Mail        `mapstructure:"mail"`
+	SMS       sms.Config        `mapstructure:"sms"`
 }
@@ -14,6 +14,7 @@ import (
 	"teddy-backend/internal/server/message"
 	"teddy-backend/pkg/config"
 	"teddy-backend/pkg/config/source/file"
+	"teddy-backend/pkg/sms"
 )
 
 func init() {
@@ -49,9 +50,14 @@ func main() {
 		log.Fatal(err)
 	}
 
+	smsSender, err := sms.NewSender(confType.SMS)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// New Handler
 	messageSrv, err := message.NewMessageServer(inboxRepo, confType.Mail.Host, confType.Mail.Port,
-		confType.Mail.Username, confType.Mail.Password)
+		confType.Mail.Username, confType.Mail.Password, smsSender)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,8 +1,25 @@
 package main
 
-import "teddy-backend/internal/types"
+import (
+	"teddy-backend/internal/types"
+	"teddy-backend/pkg/password"
+)
+
+type UID struct {
+	// Unique among running instances
+	WorkerID int64 `mapstructure:"worker_id"`
+}
+
+type Deletion struct {
+	// Days an account can cancel its deletion, 30 if not set
+	GraceDays int `mapstructure:"grace_days"`
+}
 
 type Config struct {
-	Server    types.Server      `mapstructure:"server"`
-	Databases map[string]string `mapstructure:"databases"`
+	Server    types.Server        `mapstructure:"server"`
+	Databases map[string]string   `mapstructure:"databases"`
+	UID       UID                 `mapstructure:"uid"`
+	Deletion  Deletion            `mapstructure:"deletion"`
+	Password  password.Policy     `mapstructure:"password"`
+	Hash      password.HashConfig `mapstructure:"password_hash"`
 }
@@ -11,15 +11,26 @@ import (
 	"net"
 	"os"
 	"teddy-backend/internal/components"
+	auditProto "teddy-backend/internal/proto/audit"
+	"teddy-backend/internal/proto/content"
+	"teddy-backend/internal/proto/message"
 	uaaProto "teddy-backend/internal/proto/uaa"
 	"teddy-backend/internal/repositories"
+	"teddy-backend/internal/server/audit"
 	"teddy-backend/internal/server/uaa"
 	"teddy-backend/pkg/config"
 	"teddy-backend/pkg/config/source/file"
 	"teddy-backend/pkg/grpcadapter"
 	"teddy-backend/pkg/mongo-grpcadapter"
+	"teddy-backend/pkg/password"
+	"time"
 )
 
+const contentSrvDomain = "dns:///srv-content:9091"
+const messageSrvDomain = "dns:///srv-message:9092"
+
+const defaultDeletionGraceDays = 30
+
 func init() {
 	log.SetOutput(os.Stdout)
 	log.SetLevel(log.InfoLevel)
@@ -35,7 +46,11 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	var confType Config
+	// Password settings not configured keep the defaults
+	confType := Config{
+		Password: password.DefaultPolicy(),
+		Hash:     password.DefaultHashConfig(),
+	}
 	err = conf.Scan(&confType)
 	if err != nil {
 		log.Fatal(err)
@@ -55,15 +70,82 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	refreshTokenRepo, err := repositories.NewRefreshTokenRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	revokedTokenRepo, err := repositories.NewRevokedTokenRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	loginFailureRepo, err := repositories.NewLoginFailureRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	profileRepo, err := repositories.NewProfileRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sessionRepo, err := repositories.NewSessionRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	deletionRepo, err := repositories.NewDeletionRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	clientRepo, err := repositories.NewOAuthClientRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	personalTokenRepo, err := repositories.NewPersonalTokenRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+	auditRepo, err := repositories.NewAuditRepository(mongodbClient)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// New clients of services holding account data
+	contentConn, err := grpc.Dial(contentSrvDomain, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal(err)
+	}
+	messageConn, err := grpc.Dial(messageSrvDomain, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// New components
-	uidGenerator, err := components.NewUidGenerator(accountRepo)
+	uidGenerator, err := components.NewUidGenerator(confType.UID.WorkerID)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	passwordHasher, err := password.NewHasher(confType.Hash)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	auditSrv, err := audit.NewAuditServer(auditRepo)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	policyAdapterServer := mongo_grpcadapter.NewServer(mongodbClient, "teddy", "casbin_rule")
+
+	graceDays := confType.Deletion.GraceDays
+	if graceDays <= 0 {
+		graceDays = defaultDeletionGraceDays
+	}
+
 	// New Handler
-	accountSrv, err := uaa.NewAccountServer(accountRepo, uidGenerator)
+	accountSrv, err := uaa.NewAccountServer(accountRepo, refreshTokenRepo, revokedTokenRepo, loginFailureRepo,
+		profileRepo, sessionRepo, deletionRepo, clientRepo, personalTokenRepo, policyAdapterServer, auditSrv,
+		content.NewContentClient(contentConn),
+		message.NewMessageClient(messageConn), uidGenerator, time.Duration(graceDays)*24*time.Hour,
+		confType.Password, passwordHasher)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -76,8 +158,9 @@ func main() {
 	grpcServer := grpc.NewServer()
 	uaaProto.RegisterUAAServer(grpcServer, accountSrv)
 
-	policyAdapterServer := mongo_grpcadapter.NewServer(mongodbClient, "teddy", "casbin_rule")
-	grpcadapter.RegisterPolicyAdapterServer(grpcServer, policyAdapterServer)
+	// Edits through the api are audited, role bindings by uaa are recorded as role changes
+	grpcadapter.RegisterPolicyAdapterServer(grpcServer, audit.NewPolicyAdapterServer(policyAdapterServer, auditSrv))
+	auditProto.RegisterAuditServer(grpcServer, auditSrv)
 
 	healthSrv := grpcHealth.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthSrv)
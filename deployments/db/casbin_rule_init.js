@@ -5,14 +5,28 @@ db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/base/captcha", v2: "GET
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/base/captcha/:id", v2: "GET"});
 
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/base/profile/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/base/profiles", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/base/export/:id", v2: "GET"});
 
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/image/:id", v2: "GET"});
 
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/register", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/login", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/login/twoFactor", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/sendEmailCaptcha", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/sendPhoneCaptcha", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/resetPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/confirmResetPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/changeExpiredPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/token/refresh", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/token", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/jwks.json", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/.well-known/openid-configuration", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/authorize", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/oauth/:provider/authorize", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/oauth/:provider/callback", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/email/verify", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/uaa/email/verify", v2: "POST"});
 
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/content/tags", v2: "GET"});
 db.casbin_rule.insert({ptype: "p", v0: "", v1: "/v1/anon/content/tags/:tagID", v2: "GET"});
@@ -36,16 +50,57 @@ db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/base/captcha", v2:
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/base/captcha/:id", v2: "GET"});
 
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/base/profile/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/base/profiles", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/base/export/:id", v2: "GET"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/base/profile/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/base/export", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/image/avatar", v2: "POST"});
 
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/register", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/login", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/login/twoFactor", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/sendEmailCaptcha", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/sendPhoneCaptcha", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/resetPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/confirmResetPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/changeExpiredPassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/token/refresh", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/token", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/jwks.json", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/.well-known/openid-configuration", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/authorize", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/oauth/:provider/authorize", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/oauth/:provider/callback", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/email/verify", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/uaa/email/verify", v2: "POST"});
 
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/logout", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/logoutAll", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/sessions", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/revokeSession", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/revokeOtherSessions", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/personalTokens", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/personalTokens", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/revokePersonalToken", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/identities", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/identities/merge", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/identity/:provider/link", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/identity/:provider/unlink", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/identity/:provider/reauth", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/changePassword", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/userinfo", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/userinfo", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/authorize", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/email/sendVerification", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/email/change", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/email/change/confirm", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/twoFactor/enroll", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/twoFactor/confirm", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/twoFactor/disable", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/twoFactor/recoveryCodes", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/deletion", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/deletion", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/uaa/deletion/cancel", v2: "POST"});
 
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/content/tags", v2: "GET"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/anon/content/tags/:tagID", v2: "GET"});
@@ -88,4 +143,68 @@ db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/content/thumbDown/u
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/content/thumbDown/info/:id", v2: "POST"});
 db.casbin_rule.insert({ptype: "p", v0: "user", v1: "/v1/auth/content/thumbDown/info/:id", v2: "DELETE"});
 
+// for personal token scopes, never roles so a token can't manage its account
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/tags", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/tags/:tagID", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info/:id/segment", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info/:id/segment/:segID", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info/:id/segment/:segID/value", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/info/:id/segment/:segID/value/:valID", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/favorite/info/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/thumbUp/info/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/thumbDown/info/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/anon/content/search", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/auth/content/favorite/user", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/auth/content/thumbUp/user", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "content:read", v1: "/v1/auth/content/thumbDown/user", v2: "GET"});
+
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id", v2: "DELETE"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment/:segID", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment/:segID", v2: "DELETE"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment/:segID/value", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment/:segID/value/:valID", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/info/:id/segment/:segID/value/:valID", v2: "DELETE"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/favorite/info/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/favorite/info/:id", v2: "DELETE"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/thumbUp/info/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/thumbUp/info/:id", v2: "DELETE"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/thumbDown/info/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "content:write", v1: "/v1/auth/content/thumbDown/info/:id", v2: "DELETE"});
+
+db.casbin_rule.insert({ptype: "p", v0: "profile:read", v1: "/v1/anon/base/profile/:id", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "profile:read", v1: "/v1/anon/base/profiles", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "profile:read", v1: "/v1/auth/uaa/userinfo", v2: "GET"});
+
+db.casbin_rule.insert({ptype: "p", v0: "profile:write", v1: "/v1/auth/base/profile/:id", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "profile:write", v1: "/v1/auth/image/avatar", v2: "POST"});
+
+// for oidc client scopes, tokens clients get for users only reach userinfo
+db.casbin_rule.insert({ptype: "p", v0: "openid", v1: "/v1/auth/uaa/userinfo", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "openid", v1: "/v1/auth/uaa/userinfo", v2: "POST"});
+
 // for admin group
+db.casbin_rule.insert({ptype: "g", v0: "admin", v1: "user"});
+
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/accounts", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/lock", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/unlock", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/expireCredentials", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/renewCredentials", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/logout", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/roles", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/roles", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/deletion", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/account/:uid/delete", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/audit", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/clients", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/client/add", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/client/:id/delete", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/policies", v2: "GET"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/policy/add", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/policy/update", v2: "POST"});
+db.casbin_rule.insert({ptype: "p", v0: "admin", v1: "/v1/auth/uaa/admin/policy/remove", v2: "POST"});
@@ -0,0 +1,66 @@
+package clients
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+)
+
+var clientIPKey = "__teddy_client_ip_key__"
+
+// ClientIPNew takes the ip of requests from header, which only the proxy in
+// front may set, or from the connection when header is empty. Of a list like
+// X-Forwarded-For the last entry is taken, the one the proxy added.
+func ClientIPNew(header string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ip := ""
+		if header != "" {
+			values := strings.Split(ctx.GetHeader(header), ",")
+			ip = strings.TrimSpace(values[len(values)-1])
+		}
+		if ip == "" {
+			ip = remoteIP(ctx.Request)
+		}
+		ctx.Set(clientIPKey, ip)
+	}
+}
+
+// ClientIPFromContext retrieves the ip of the request. Unlike ctx.ClientIP()
+// it never trusts headers clients can send.
+func ClientIPFromContext(ctx *gin.Context) string {
+	if ip, ok := ctx.Value(clientIPKey).(string); ok {
+		return ip
+	}
+	return remoteIP(ctx.Request)
+}
+
+func remoteIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(strings.TrimSpace(req.RemoteAddr))
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return ip
+}
+
+// setClientIP keeps the ip of the request for actorInterceptor, which only
+// gets the context of the call.
+func setClientIP(ctx *gin.Context) {
+	ctx.Set(clientIPKey, ClientIPFromContext(ctx))
+}
+
+// actorInterceptor tells services who makes a call and from where, taken
+// from the request the call is made in, so they can be audited.
+func actorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	actor := ""
+	if token, ok := ctx.Value(gin_jwt.DefaultContextKey).(map[string]interface{}); ok {
+		actor, _ = token["sub"].(string)
+	}
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return invoker(components.WithActor(ctx, actor, ip), method, req, reply, cc, opts...)
+}
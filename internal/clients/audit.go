@@ -0,0 +1,35 @@
+package clients
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"sync"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/audit"
+)
+
+var auditKey = "__teddy_audit_client_key__"
+
+// FromContext retrieves the client from the Context
+func AuditFromContext(ctx *gin.Context) audit.AuditClient {
+	return ctx.Value(auditKey).(audit.AuditClient)
+}
+
+// Client returns a wrapper for the AuditClient
+func AuditNew(addr string) gin.HandlerFunc {
+	var client audit.AuditClient = nil
+	lock := sync.Mutex{}
+	return func(ctx *gin.Context) {
+		if client == nil {
+			lock.Lock()
+			defer lock.Unlock()
+			conn, err := grpc.Dial(addr, grpc.WithInsecure())
+			if err != nil {
+				errors.AbortWithErrorJSON(ctx, errors.ErrGRPCDial)
+				return
+			}
+			client = audit.NewAuditClient(conn)
+		}
+		ctx.Set(auditKey, client)
+	}
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// UaaPersonalTokenFunc asks uaa service to verify personal tokens,
+// use it with gin_jwt.CachedPersonalTokenFunc.
+func UaaPersonalTokenFunc(addr string) (gin_jwt.PersonalTokenFunc, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	client := uaa.NewUAAClient(conn)
+	return func(value string) (*gin_jwt.PersonalToken, error) {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, err := client.VerifyPersonalToken(timeoutCtx, &uaa.VerifyPersonalTokenReq{
+			Value: value,
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.Unauthenticated, codes.NotFound, codes.PermissionDenied:
+				return nil, gin_jwt.ErrTokenInvalid
+			}
+			log.Errorf("verify personal token error: %v", err)
+			return nil, err
+		}
+
+		expireTime, err := ptypes.Timestamp(resp.ExpireTime)
+		if err != nil {
+			return nil, gin_jwt.ErrTokenInvalid
+		}
+		return &gin_jwt.PersonalToken{
+			ID:         resp.Id,
+			UID:        resp.Uid,
+			Scopes:     resp.Scopes,
+			ExpireTime: expireTime,
+		}, nil
+	}, nil
+}
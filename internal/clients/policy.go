@@ -0,0 +1,36 @@
+package clients
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"sync"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/pkg/grpcadapter"
+)
+
+var policyAdapterKey = "__teddy_policy_adapter_client_key__"
+
+// FromContext retrieves the client from the Context
+func PolicyAdapterFromContext(ctx *gin.Context) grpcadapter.PolicyAdapterClient {
+	return ctx.Value(policyAdapterKey).(grpcadapter.PolicyAdapterClient)
+}
+
+// Client returns a wrapper for the PolicyAdapterClient
+func PolicyAdapterNew(addr string) gin.HandlerFunc {
+	var client grpcadapter.PolicyAdapterClient = nil
+	lock := sync.Mutex{}
+	return func(ctx *gin.Context) {
+		if client == nil {
+			lock.Lock()
+			defer lock.Unlock()
+			conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(actorInterceptor))
+			if err != nil {
+				errors.AbortWithErrorJSON(ctx, errors.ErrGRPCDial)
+				return
+			}
+			client = grpcadapter.NewPolicyAdapterClient(conn)
+		}
+		ctx.Set(policyAdapterKey, client)
+		setClientIP(ctx)
+	}
+}
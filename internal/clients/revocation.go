@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// UaaRevokedFunc asks uaa service whether a token has been revoked,
+// use it with gin_jwt.CachedRevokedFunc.
+func UaaRevokedFunc(addr string) (gin_jwt.RevokedFunc, error) {
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	client := uaa.NewUAAClient(conn)
+	return func(jti, sub, sid string, iat time.Time) (bool, error) {
+		issuedAt, err := ptypes.TimestampProto(iat)
+		if err != nil {
+			return false, err
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, err := client.IsTokenRevoked(timeoutCtx, &uaa.IsTokenRevokedReq{
+			Jti:       jti,
+			Uid:       sub,
+			IssuedAt:  issuedAt,
+			SessionId: sid,
+		})
+		if err != nil {
+			log.Errorf("check token revocation error: %v", err)
+			return false, err
+		}
+		return resp.Revoked, nil
+	}, nil
+}
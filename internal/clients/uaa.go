@@ -23,7 +23,7 @@ func UaaNew(addr string) gin.HandlerFunc {
 		if client == nil {
 			lock.Lock()
 			defer lock.Unlock()
-			conn, err := grpc.Dial(addr, grpc.WithInsecure())
+			conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(actorInterceptor))
 			if err != nil {
 				errors.AbortWithErrorJSON(ctx, errors.ErrGRPCDial)
 				return
@@ -31,5 +31,6 @@ func UaaNew(addr string) gin.HandlerFunc {
 			client = uaa.NewUAAClient(conn)
 		}
 		ctx.Set(uaaKey, client)
+		setClientIP(ctx)
 	}
 }
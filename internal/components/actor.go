@@ -0,0 +1,44 @@
+package components
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata telling services who makes a call through an api and from where,
+// recorded in audit events.
+const (
+	actorMetadataKey = "x-teddy-actor"
+	ipMetadataKey    = "x-teddy-ip"
+)
+
+// WithActor attaches actor and ip to the outgoing metadata of ctx.
+func WithActor(ctx context.Context, actor, ip string) context.Context {
+	var kv []string
+	if actor != "" {
+		kv = append(kv, actorMetadataKey, actor)
+	}
+	if ip != "" {
+		kv = append(kv, ipMetadataKey, ip)
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// ActorFromContext reads actor and ip from the incoming metadata of ctx, both
+// are empty for calls not made on behalf of anyone.
+func ActorFromContext(ctx context.Context) (actor, ip string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	if v := md.Get(actorMetadataKey); len(v) != 0 {
+		actor = v[0]
+	}
+	if v := md.Get(ipMetadataKey); len(v) != 0 {
+		ip = v[0]
+	}
+	return actor, ip
+}
@@ -0,0 +1,59 @@
+package components
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter allows at most limit calls per key in a fixed window.
+type RateLimiter interface {
+	Allow(key string) bool
+}
+
+func NewRateLimiter(limit int, window time.Duration) RateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*limiterWindow),
+	}
+}
+
+type limiterWindow struct {
+	start time.Time
+	count int
+}
+
+type rateLimiter struct {
+	limit     int
+	window    time.Duration
+	lock      sync.Mutex
+	windows   map[string]*limiterWindow
+	lastClean time.Time
+}
+
+func (l *rateLimiter) Allow(key string) bool {
+	now := time.Now()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.lastClean) > l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) > l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.lastClean = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) > l.window {
+		w = &limiterWindow{start: now}
+		l.windows[key] = w
+	}
+	if w.count >= l.limit {
+		return false
+	}
+	w.count++
+	return true
+}
@@ -0,0 +1,11 @@
+package components
+
+// ContainsString tells whether s is one of list.
+func ContainsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
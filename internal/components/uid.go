@@ -1,28 +1,88 @@
 package components
 
 import (
-	"math/rand"
-	"teddy-backend/internal/repositories"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
 )
 
+// UID layout, from high to low bits: 41 bits milliseconds since uidEpoch,
+// 10 bits worker id and 12 bits sequence within the millisecond.
+const (
+	uidWorkerBits   = 10
+	uidSequenceBits = 12
+
+	MaxUidWorkerID = 1<<uidWorkerBits - 1
+	maxUidSequence = 1<<uidSequenceBits - 1
+
+	// Backward clock steps up to it are waited out, larger ones fail
+	MaxUidClockSkew = 5 * time.Millisecond
+)
+
+// 2019-01-01T00:00:00Z
+var uidEpoch = time.Unix(1546300800, 0)
+
+var ErrUidWorkerIDInvalid = errors.New("uid worker id out of range")
+var ErrUidClockMovedBackwards = errors.New("clock moved backwards, refuse to generate uid")
+
 type UidGenerator interface {
 	NexID() (string, error)
 }
 
-func NewUidGenerator(repo repositories.AccountRepository) (UidGenerator, error) {
+// NewUidGenerator returns a snowflake style generator, every running instance
+// must have its own worker id in [0, MaxUidWorkerID].
+func NewUidGenerator(workerID int64) (UidGenerator, error) {
+	if workerID < 0 || workerID > MaxUidWorkerID {
+		return nil, ErrUidWorkerIDInvalid
+	}
 	return &uidGenerator{
-		repo: repo,
+		workerID: workerID,
+		nowFunc:  time.Now,
 	}, nil
 }
 
 type uidGenerator struct {
-	repo repositories.AccountRepository
+	lock     sync.Mutex
+	workerID int64
+	nowFunc  func() time.Time
+	// Millisecond of the last id and sequence used in it
+	lastMillis int64
+	sequence   int64
+}
+
+func (t *uidGenerator) millis() int64 {
+	return int64(t.nowFunc().Sub(uidEpoch) / time.Millisecond)
 }
 
 func (t *uidGenerator) NexID() (string, error) {
-	s := ""
-	for i := 0; i < 10; i++ {
-		s += (string)(rand.Intn(10) + 48)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.millis()
+	if now < t.lastMillis {
+		if time.Duration(t.lastMillis-now)*time.Millisecond > MaxUidClockSkew {
+			return "", ErrUidClockMovedBackwards
+		}
+		for now < t.lastMillis {
+			time.Sleep(time.Duration(t.lastMillis-now) * time.Millisecond)
+			now = t.millis()
+		}
 	}
-	return s, nil
+
+	if now == t.lastMillis {
+		t.sequence = (t.sequence + 1) & maxUidSequence
+		if t.sequence == 0 {
+			// Sequence exhausted, spin until the next millisecond
+			for now <= t.lastMillis {
+				now = t.millis()
+			}
+		}
+	} else {
+		t.sequence = 0
+	}
+	t.lastMillis = now
+
+	id := now<<(uidWorkerBits+uidSequenceBits) | t.workerID<<uidSequenceBits | t.sequence
+	return strconv.FormatInt(id, 10), nil
 }
@@ -0,0 +1,173 @@
+package components
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock advances by step on every reading
+type fakeClock struct {
+	lock sync.Mutex
+	now  time.Time
+	step time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+func (c *fakeClock) Set(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = now
+}
+
+func newTestUidGenerator(t *testing.T, workerID int64, clock *fakeClock) *uidGenerator {
+	gen, err := NewUidGenerator(workerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := gen.(*uidGenerator)
+	if clock != nil {
+		g.nowFunc = clock.Now
+	}
+	return g
+}
+
+func TestNewUidGeneratorWorkerID(t *testing.T) {
+	for _, id := range []int64{-1, MaxUidWorkerID + 1} {
+		if _, err := NewUidGenerator(id); err != ErrUidWorkerIDInvalid {
+			t.Errorf("worker id %d: got %v, want %v", id, err, ErrUidWorkerIDInvalid)
+		}
+	}
+	for _, id := range []int64{0, MaxUidWorkerID} {
+		if _, err := NewUidGenerator(id); err != nil {
+			t.Errorf("worker id %d: %v", id, err)
+		}
+	}
+}
+
+func TestUidGeneratorConcurrentUnique(t *testing.T) {
+	const goroutines = 16
+	const perGoroutine = 10000
+
+	gen := newTestUidGenerator(t, 1, nil)
+	results := make(chan []string, goroutines)
+	errs := make(chan error, goroutines)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids := make([]string, 0, perGoroutine)
+			for j := 0; j < perGoroutine; j++ {
+				id, err := gen.NexID()
+				if err != nil {
+					errs <- err
+					return
+				}
+				ids = append(ids, id)
+			}
+			results <- ids
+		}()
+	}
+	wg.Wait()
+	close(results)
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+	seen := make(map[string]bool, goroutines*perGoroutine)
+	for ids := range results {
+		for _, id := range ids {
+			if seen[id] {
+				t.Fatalf("duplicated uid %s", id)
+			}
+			seen[id] = true
+		}
+	}
+	if len(seen) != goroutines*perGoroutine {
+		t.Fatalf("got %d uids, want %d", len(seen), goroutines*perGoroutine)
+	}
+}
+
+func TestUidGeneratorWorkersUnique(t *testing.T) {
+	// Both workers read the very same instants
+	start := time.Now()
+	clock1 := &fakeClock{now: start, step: 10 * time.Microsecond}
+	clock2 := &fakeClock{now: start, step: 10 * time.Microsecond}
+	gen1 := newTestUidGenerator(t, 1, clock1)
+	gen2 := newTestUidGenerator(t, 2, clock2)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 10000; i++ {
+		for _, gen := range []*uidGenerator{gen1, gen2} {
+			id, err := gen.NexID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seen[id] {
+				t.Fatalf("duplicated uid %s", id)
+			}
+			seen[id] = true
+		}
+	}
+}
+
+func TestUidGeneratorSequenceOverflow(t *testing.T) {
+	// Far more than maxUidSequence readings within one millisecond
+	clock := &fakeClock{now: time.Now(), step: 100 * time.Nanosecond}
+	gen := newTestUidGenerator(t, 3, clock)
+
+	var last int64
+	for i := 0; i < 3*(maxUidSequence+1); i++ {
+		idStr, err := gen.NexID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id <= last {
+			t.Fatalf("uid %d not greater than previous %d", id, last)
+		}
+		last = id
+	}
+}
+
+func TestUidGeneratorClockSkew(t *testing.T) {
+	start := time.Now()
+	clock := &fakeClock{now: start, step: 100 * time.Microsecond}
+	gen := newTestUidGenerator(t, 4, clock)
+
+	first, err := gen.NexID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A small step back is waited out
+	clock.Set(start.Add(-2 * time.Millisecond))
+	second, err := gen.NexID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstID, _ := strconv.ParseInt(first, 10, 64)
+	secondID, _ := strconv.ParseInt(second, 10, 64)
+	if secondID <= firstID {
+		t.Fatalf("uid %d after clock skew not greater than previous %d", secondID, firstID)
+	}
+
+	// A large one is refused
+	clock.Set(start.Add(-time.Second))
+	if _, err := gen.NexID(); err != ErrUidClockMovedBackwards {
+		t.Fatalf("got %v, want %v", err, ErrUidClockMovedBackwards)
+	}
+}
@@ -20,4 +20,10 @@ var (
 	ErrTokenInvalid = errors.New("token is invalid")
 
 	ErrInvalidAuthHeader = errors.New("auth header is invalid")
+
+	ErrRevocationUnavailable = errors.New("can't check token revocation")
+
+	ErrJwksUnavailable = errors.New("can't fetch jwks")
+
+	ErrPersonalTokenUnavailable = errors.New("can't verify personal token")
 )
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 )
 
@@ -25,15 +26,16 @@ func _fetch(rawUrl string) (*jose.JSONWebKeySet, error) {
 			return nil, err
 		}
 
+		defer res.Body.Close()
+
 		if res.StatusCode != http.StatusOK {
-			return nil, err
+			return nil, ErrJwksUnavailable
 		}
 
 		buf, err := ioutil.ReadAll(res.Body)
 		if err != nil {
 			return nil, err
 		}
-		defer res.Body.Close()
 		src = buf
 	case "file":
 		f, err := os.Open(u.Path)
@@ -48,7 +50,7 @@ func _fetch(rawUrl string) (*jose.JSONWebKeySet, error) {
 		}
 		src = buf
 	default:
-		return nil, err
+		return nil, ErrJwksUnavailable
 	}
 	jwks := jose.JSONWebKeySet{}
 	err = json.Unmarshal(src, &jwks)
@@ -58,19 +60,57 @@ func _fetch(rawUrl string) (*jose.JSONWebKeySet, error) {
 	return &jwks, nil
 }
 
-func RemoteFetchFunc(rawUrl string, cacheTimeout time.Duration) func() interface{} {
+// Unknown kids refetch the jwks at most this often, tokens with made up kids
+// shouldn't flood the issuer
+const jwksRefetchInterval = 1 * time.Minute
+
+// RemoteFetchFunc returns the public key of kid from the jwks at rawUrl, which
+// is cached for cacheTimeout. A kid not in the cache refetches the jwks, since
+// keys may have rotated. Tokens without kid were signed before kids were
+// stamped, by a key that may be retiring now, so all keys of the jwks are
+// returned for them to be tried.
+func RemoteFetchFunc(rawUrl string, cacheTimeout time.Duration) func(kid string) interface{} {
 	var jwks *jose.JSONWebKeySet
-	var err error
 	expTime := time.Time{}
-	return func() interface{} {
-		if expTime.Before(time.Now()) || jwks == nil {
-			expTime = time.Now().Add(cacheTimeout)
-			jwks, err = _fetch(rawUrl)
-			if err != nil {
-				log.Errorf("fetch remote jwks error: %v", err)
-				return nil
+	fetchTime := time.Time{}
+	lock := sync.Mutex{}
+
+	lookup := func(kid string) interface{} {
+		if jwks == nil || len(jwks.Keys) == 0 {
+			return nil
+		}
+		if kid == "" {
+			keys := make([]interface{}, 0, len(jwks.Keys))
+			for _, v := range jwks.Keys {
+				keys = append(keys, v.Public().Key)
 			}
+			return keys
+		}
+		if keys := jwks.Key(kid); len(keys) != 0 {
+			return keys[0].Public().Key
+		}
+		return nil
+	}
+
+	return func(kid string) interface{} {
+		lock.Lock()
+		defer lock.Unlock()
+
+		now := time.Now()
+		key := lookup(kid)
+		if expTime.After(now) && (key != nil || fetchTime.Add(jwksRefetchInterval).After(now)) {
+			return key
+		}
+
+		fetchTime = now
+		fetched, err := _fetch(rawUrl)
+		if err != nil {
+			// Keep the keys fetched last time until the issuer is back
+			log.Errorf("fetch remote jwks error: %v", err)
+			return key
 		}
-		return jwks.Keys[0].Public().Key
+		jwks = fetched
+		expTime = now.Add(cacheTimeout)
+		return lookup(kid)
 	}
 }
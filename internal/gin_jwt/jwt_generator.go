@@ -15,15 +15,24 @@ type GeneratorConfig struct {
 
 	SigningAlgorithm jose.SignatureAlgorithm
 
+	// Returns the key tokens are signed with
 	KeyFunc func() interface{}
 
+	// Optional, keys replaced by the one of KeyFunc. They no longer sign but are
+	// still published, so tokens signed before rotation verify until they expire
+	RetiringKeys []interface{}
+
 	NowFunc func() time.Time
 }
 
 type JwtGenerator struct {
 	config GeneratorConfig
 	jwks   []byte
+	keys   map[string]interface{}
 	signer jose.Signer
+
+	// Active key first, tokens without kid may be signed by any of them
+	allKeys []interface{}
 }
 
 func NewGinJwtGenerator(config GeneratorConfig) (*JwtGenerator, error) {
@@ -39,23 +48,33 @@ func NewGinJwtGenerator(config GeneratorConfig) (*JwtGenerator, error) {
 		config.NowFunc = time.Now
 	}
 
-	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: config.SigningAlgorithm, Key: config.KeyFunc()}, nil)
+	active, err := newJwk(config.KeyFunc())
 	if err != nil {
 		return nil, err
 	}
 
-	jwk := jose.JSONWebKey{
-		Key: config.KeyFunc(),
-	}
-
-	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	// kid of the active key is stamped on tokens by the signer
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: config.SigningAlgorithm, Key: active}, nil)
 	if err != nil {
 		return nil, err
 	}
-	jwk.KeyID = base64.URLEncoding.EncodeToString(thumbprint)
 
 	jwks := jose.JSONWebKeySet{
-		Keys: []jose.JSONWebKey{jwk.Public()},
+		Keys: []jose.JSONWebKey{active.Public()},
+	}
+	for _, key := range config.RetiringKeys {
+		jwk, err := newJwk(key)
+		if err != nil {
+			return nil, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk.Public())
+	}
+
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	allKeys := make([]interface{}, 0, len(jwks.Keys))
+	for _, v := range jwks.Keys {
+		keys[v.KeyID] = v.Key
+		allKeys = append(allKeys, v.Key)
 	}
 
 	jwksResult, err := json.Marshal(jwks)
@@ -66,15 +85,65 @@ func NewGinJwtGenerator(config GeneratorConfig) (*JwtGenerator, error) {
 	return &JwtGenerator{
 		config: config,
 		jwks:   jwksResult,
+		keys:   keys,
 		signer: signer,
+
+		allKeys: allKeys,
 	}, nil
 }
 
+// newJwk wraps key with its thumbprint as kid, so a key keeps its kid
+// whichever position it is loaded at.
+func newJwk(key interface{}) (*jose.JSONWebKey, error) {
+	jwk := jose.JSONWebKey{
+		Key: key,
+	}
+
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	jwk.KeyID = base64.URLEncoding.EncodeToString(thumbprint)
+	return &jwk, nil
+}
+
+// GetJwks returns public keys of the active and retiring keys, the active
+// one first.
 func (g *JwtGenerator) GetJwks() []byte {
 	return g.jwks
 }
 
+// KeyFunc verifies tokens with the public keys of the generator, without
+// fetching the jwks it publishes. Tokens without kid were signed before kids
+// were stamped, by a key that may be retiring now, so all keys are tried.
+func (g *JwtGenerator) KeyFunc() func(kid string) interface{} {
+	return func(kid string) interface{} {
+		if kid == "" {
+			return g.allKeys
+		}
+		return g.keys[kid]
+	}
+}
+
+// SigningAlgorithm is published for clients to know how tokens are signed.
+func (g *JwtGenerator) SigningAlgorithm() jose.SignatureAlgorithm {
+	return g.config.SigningAlgorithm
+}
+
 func (g *JwtGenerator) GenerateJwt(timeout time.Duration, subject string, audience []string,
+	claims map[string]interface{}) (string, error) {
+	return g.generate(g.config.Issuer, timeout, subject, audience, claims)
+}
+
+// GenerateIDToken generates an OpenID Connect ID token, issued by issuer
+// instead of the configured Issuer, since OIDC clients compare it with the
+// url they discovered the provider from.
+func (g *JwtGenerator) GenerateIDToken(issuer string, timeout time.Duration, subject string, audience []string,
+	claims map[string]interface{}) (string, error) {
+	return g.generate(issuer, timeout, subject, audience, claims)
+}
+
+func (g *JwtGenerator) generate(issuer string, timeout time.Duration, subject string, audience []string,
 	claims map[string]interface{}) (string, error) {
 	now := g.config.NowFunc()
 	expire := now.Add(timeout)
@@ -82,7 +151,7 @@ func (g *JwtGenerator) GenerateJwt(timeout time.Duration, subject string, audien
 		Claims(jwt.Claims{
 			ID:        uuid.Must(uuid.NewRandom()).String(),
 			Subject:   subject,
-			Issuer:    g.config.Issuer,
+			Issuer:    issuer,
 			Audience:  audience,
 			Expiry:    jwt.NewNumericDate(expire),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -14,9 +14,15 @@ import (
 const DefaultContextKey = "_JWT_TOKEN_KEY_"
 const DefaultLeeway = 1.0 * time.Minute
 
+// ClientSubjectPrefix tells tokens of clients from tokens of accounts, it is
+// also the subject of the client role bindings in the policy store.
+const ClientSubjectPrefix = "client:"
+
 type MiddlewareConfig struct {
-	Realm        string
-	KeyFunc      func() interface{}
+	Realm string
+	// Returns the public key of kid in token header. For an empty kid it may
+	// return a []interface{} of keys, the token verifies with any of them
+	KeyFunc      func(kid string) interface{}
 	NowFunc      func() time.Time
 	ErrorHandler func(ctx *gin.Context, err error)
 	TokenLookup  string
@@ -25,18 +31,32 @@ type MiddlewareConfig struct {
 	Issuer       string
 	Subject      string
 	ID           string
+	// Optional, see CachedRevokedFunc
+	RevokedFunc func(jti, sub, sid string, iat, exp time.Time) (bool, error)
+	// Optional, personal tokens are rejected without it, see
+	// CachedPersonalTokenFunc
+	PersonalTokenFunc PersonalTokenFunc
+	// Personal tokens are refused on paths with these prefixes, e.g. account
+	// management, but for the read only paths allowed
+	PersonalTokenDenyPrefixes []string
+	PersonalTokenAllowPaths   []string
+	// Paths taking other schemes in the token header, e.g. basic auth of
+	// clients at the token endpoint, the request stays anonymous there
+	OtherSchemePaths []string
 }
 
 type JwtMiddleware struct {
-	config   MiddlewareConfig
-	keyFunc  func() interface{}
-	nowFunc  func() time.Time
-	audience []string
-	issuer   string
-	subject  string
-	id       string
-	adapter  persist.Adapter
-	enforcer *casbin.SyncedEnforcer
+	config        MiddlewareConfig
+	keyFunc       func(kid string) interface{}
+	nowFunc       func() time.Time
+	audience      []string
+	issuer        string
+	subject       string
+	id            string
+	revoked       func(jti, sub, sid string, iat, exp time.Time) (bool, error)
+	personalToken PersonalTokenFunc
+	adapter       persist.Adapter
+	enforcer      *casbin.SyncedEnforcer
 }
 
 func NewGinJwtMiddleware(config MiddlewareConfig, adapter persist.Adapter) (*JwtMiddleware, error) {
@@ -81,15 +101,17 @@ func NewGinJwtMiddleware(config MiddlewareConfig, adapter persist.Adapter) (*Jwt
 	enforcer.StartAutoLoadPolicy(10 * time.Second)
 
 	return &JwtMiddleware{
-		config:   config,
-		keyFunc:  config.KeyFunc,
-		nowFunc:  config.NowFunc,
-		audience: config.Audience,
-		issuer:   config.Issuer,
-		subject:  config.Subject,
-		id:       config.ID,
-		adapter:  adapter,
-		enforcer: enforcer,
+		config:        config,
+		keyFunc:       config.KeyFunc,
+		nowFunc:       config.NowFunc,
+		audience:      config.Audience,
+		issuer:        config.Issuer,
+		subject:       config.Subject,
+		id:            config.ID,
+		revoked:       config.RevokedFunc,
+		personalToken: config.PersonalTokenFunc,
+		adapter:       adapter,
+		enforcer:      enforcer,
 	}, nil
 }
 
@@ -103,8 +125,13 @@ func (m *JwtMiddleware) Handler() gin.HandlerFunc {
 		sub := ""
 		if token != nil {
 			sub = token["sub"].(string)
+			if token["pat"] != nil && m.personalTokenDenied(ctx.Request.URL.Path, ctx.Request.Method) {
+				m.config.ErrorHandler(ctx, ErrForbidden)
+				return
+			}
 		}
-		if !m.enforcer.Enforce(sub, ctx.Request.URL.Path, ctx.Request.Method) {
+		if !m.enforcer.Enforce(sub, ctx.Request.URL.Path, ctx.Request.Method) ||
+			!m.enforceScope(token, ctx.Request.URL.Path, ctx.Request.Method) {
 			m.config.ErrorHandler(ctx, ErrForbidden)
 			return
 		}
@@ -113,9 +140,25 @@ func (m *JwtMiddleware) Handler() gin.HandlerFunc {
 	}
 }
 
-func (m *JwtMiddleware) AddUser(uid string) error {
-	m.enforcer.AddRoleForUser(uid, "user")
-	return nil
+// enforceScope narrows a token with scope, e.g. of a client, to what its
+// scopes may do, scopes are roles. Tokens without scope aren't narrowed.
+func (m *JwtMiddleware) enforceScope(token map[string]interface{}, obj, act string) bool {
+	scope, ok := token["scope"].(string)
+	if !ok {
+		return true
+	}
+	for _, v := range strings.Fields(scope) {
+		if m.enforcer.Enforce(v, obj, act) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadPolicy reloads policies changed in storage without waiting for the
+// periodic reload.
+func (m *JwtMiddleware) LoadPolicy() error {
+	return m.enforcer.LoadPolicy()
 }
 
 func (m *JwtMiddleware) ExtractClaims(ctx *gin.Context, key string) interface{} {
@@ -154,6 +197,15 @@ func (m *JwtMiddleware) ExtractJTI(ctx *gin.Context) string {
 	return ""
 }
 
+func (m *JwtMiddleware) ExtractSID(ctx *gin.Context) string {
+	if token, ok := ctx.Get(m.config.ContextKey); ok {
+		if token.(map[string]interface{})["sid"] != nil {
+			return token.(map[string]interface{})["sid"].(string)
+		}
+	}
+	return ""
+}
+
 func (m *JwtMiddleware) ExtractNBF(ctx *gin.Context) time.Time {
 	if token, ok := ctx.Get(m.config.ContextKey); ok {
 		if token.(map[string]interface{})["nbf"] != nil {
@@ -192,7 +244,14 @@ func (m *JwtMiddleware) extractToken(ctx *gin.Context) (map[string]interface{},
 			return nil, nil
 		}
 		tmpParts := strings.SplitN(originToken, " ", 2)
-		if !(len(tmpParts) == 2 && tmpParts[0] == parts[2]) {
+		if len(tmpParts) != 2 {
+			return nil, ErrInvalidAuthHeader
+		} else if tmpParts[0] != parts[2] {
+			for _, v := range m.config.OtherSchemePaths {
+				if ctx.Request.URL.Path == v {
+					return nil, nil
+				}
+			}
 			return nil, ErrInvalidAuthHeader
 		}
 		token = tmpParts[1]
@@ -208,12 +267,65 @@ func (m *JwtMiddleware) extractToken(ctx *gin.Context) (map[string]interface{},
 		}
 	}
 
+	// Personal tokens are revoked by uaa service, not by jti
+	if strings.HasPrefix(token, PersonalTokenPrefix) {
+		return m.personalTokenClaims(token)
+	}
+
+	c, err := m.parseToken(token, m.audience)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.revoked != nil {
+		jti, _ := c["jti"].(string)
+		sub, _ := c["sub"].(string)
+		iat, _ := c["iat"].(float64)
+		exp, _ := c["exp"].(float64)
+		sid, _ := c["sid"].(string)
+		if jti == "" || sub == "" {
+			return nil, ErrTokenInvalid
+		}
+		revoked, err := m.revoked(jti, sub, sid, time.Unix(int64(iat), 0), time.Unix(int64(exp), 0).Add(DefaultLeeway))
+		if err != nil {
+			return nil, ErrRevocationUnavailable
+		} else if revoked {
+			return nil, ErrTokenInvalid
+		}
+	}
+
+	return c, nil
+}
+
+// ParseToken verifies a token not taken from request, e.g. a token issued for
+// other purpose than access with its own audience. Revocation isn't checked.
+func (m *JwtMiddleware) ParseToken(token string, audience ...string) (map[string]interface{}, error) {
+	return m.parseToken(token, audience)
+}
+
+func (m *JwtMiddleware) parseToken(token string, audience []string) (map[string]interface{}, error) {
 	parsedToken, err := jwt.ParseSigned(token)
 	if err != nil {
 		return nil, ErrTokenInvalid
 	}
+	if len(parsedToken.Headers) == 0 {
+		return nil, ErrTokenInvalid
+	}
+	key := m.keyFunc(parsedToken.Headers[0].KeyID)
+	if key == nil {
+		return nil, ErrTokenInvalid
+	}
+	keys, ok := key.([]interface{})
+	if !ok {
+		keys = []interface{}{key}
+	}
 	c := make(map[string]interface{})
-	err = parsedToken.Claims(m.keyFunc(), &c)
+	err = ErrTokenInvalid
+	for _, k := range keys {
+		if err = parsedToken.Claims(k, &c); err == nil {
+			break
+		}
+	}
 	if err != nil {
 		if err == jose.ErrUnsupportedKeyType {
 			return nil, ErrInvalidKey
@@ -233,7 +345,7 @@ func (m *JwtMiddleware) extractToken(ctx *gin.Context) (map[string]interface{},
 		return nil, ErrTokenInvalid
 	}
 
-	if len(m.audience) != 0 {
+	if len(audience) != 0 {
 		if tmp, ok := c["aud"].([]interface{}); ok {
 			aud := make([]string, len(tmp))
 			for i, v := range tmp {
@@ -241,7 +353,7 @@ func (m *JwtMiddleware) extractToken(ctx *gin.Context) (map[string]interface{},
 					return nil, ErrTokenInvalid
 				}
 			}
-			for _, v := range m.audience {
+			for _, v := range audience {
 				find := false
 				for _, a := range aud {
 					if a == v {
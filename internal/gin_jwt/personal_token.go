@@ -0,0 +1,125 @@
+package gin_jwt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PersonalTokenPrefix tells personal tokens from jwt in the same header.
+const PersonalTokenPrefix = "tpat_"
+
+// PersonalTokenScopes are what personal tokens are narrowed to, each is a
+// subject of its own policies. They are never roles, a token with the role of
+// its account could do all the account does.
+var PersonalTokenScopes = []string{"content:read", "content:write", "profile:read", "profile:write"}
+
+// PersonalToken is what a personal token value resolves to.
+type PersonalToken struct {
+	ID         string
+	UID        string
+	Scopes     []string
+	ExpireTime time.Time
+}
+
+// PersonalTokenFunc resolves a personal token value, ErrTokenInvalid is
+// returned for tokens not valid, e.g. revoked or expired.
+type PersonalTokenFunc func(value string) (*PersonalToken, error)
+
+type personalTokenEntry struct {
+	token  *PersonalToken
+	expire time.Time
+}
+
+// CachedPersonalTokenFunc wraps fetch with a cache keyed by the hash of the
+// value, so revoked tokens keep working for cacheTimeout at most. Invalid
+// tokens are cached too, errors of fetch aren't.
+func CachedPersonalTokenFunc(fetch PersonalTokenFunc, cacheTimeout time.Duration) PersonalTokenFunc {
+	cache := make(map[string]personalTokenEntry)
+	lock := sync.Mutex{}
+	nextClean := time.Now().Add(cacheTimeout)
+	return func(value string) (*PersonalToken, error) {
+		now := time.Now()
+		sum := sha256.Sum256([]byte(value))
+		key := hex.EncodeToString(sum[:])
+
+		lock.Lock()
+		if now.After(nextClean) {
+			for k, v := range cache {
+				if now.After(v.expire) {
+					delete(cache, k)
+				}
+			}
+			nextClean = now.Add(cacheTimeout)
+		}
+		entry, ok := cache[key]
+		lock.Unlock()
+		if ok && now.Before(entry.expire) {
+			if entry.token == nil {
+				return nil, ErrTokenInvalid
+			}
+			return entry.token, nil
+		}
+
+		token, err := fetch(value)
+		if err != nil && err != ErrTokenInvalid {
+			return nil, err
+		}
+
+		entry = personalTokenEntry{
+			token:  token,
+			expire: now.Add(cacheTimeout),
+		}
+		if token != nil && token.ExpireTime.Before(entry.expire) {
+			entry.expire = token.ExpireTime
+		}
+		lock.Lock()
+		cache[key] = entry
+		lock.Unlock()
+		return token, err
+	}
+}
+
+// personalTokenClaims resolves a personal token to claims like those of a jwt,
+// scopes narrow it like scopes of clients.
+func (m *JwtMiddleware) personalTokenClaims(value string) (map[string]interface{}, error) {
+	if m.personalToken == nil {
+		return nil, ErrTokenInvalid
+	}
+	token, err := m.personalToken(value)
+	if err == ErrTokenInvalid {
+		return nil, err
+	} else if err != nil {
+		return nil, ErrPersonalTokenUnavailable
+	}
+	if token.UID == "" || len(token.Scopes) == 0 || m.nowFunc().After(token.ExpireTime) {
+		return nil, ErrTokenInvalid
+	}
+	return map[string]interface{}{
+		"sub":   token.UID,
+		"scope": strings.Join(token.Scopes, " "),
+		"pat":   token.ID,
+		"exp":   float64(token.ExpireTime.Unix()),
+	}, nil
+}
+
+// personalTokenDenied tells whether personal tokens are refused on path,
+// whatever their scopes allow.
+func (m *JwtMiddleware) personalTokenDenied(path, method string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		for _, v := range m.config.PersonalTokenAllowPaths {
+			if path == v {
+				return false
+			}
+		}
+	}
+	for _, v := range m.config.PersonalTokenDenyPrefixes {
+		if strings.HasPrefix(path, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package gin_jwt
+
+import (
+	"sync"
+	"time"
+)
+
+// RevokedFunc tells whether the token with jti, issued to sub at iat in
+// session sid, has been revoked. sid is empty for tokens without session.
+type RevokedFunc func(jti, sub, sid string, iat time.Time) (bool, error)
+
+type revokedEntry struct {
+	revoked bool
+	expire  time.Time
+}
+
+// CachedRevokedFunc wraps fetch with a cache keyed by jti. A revoked token
+// stays revoked, so it is cached until the token expires, a token not revoked
+// yet is only cached for cacheTimeout.
+func CachedRevokedFunc(fetch RevokedFunc, cacheTimeout time.Duration) func(jti, sub, sid string, iat, exp time.Time) (bool, error) {
+	cache := make(map[string]revokedEntry)
+	lock := sync.Mutex{}
+	nextClean := time.Now().Add(cacheTimeout)
+	return func(jti, sub, sid string, iat, exp time.Time) (bool, error) {
+		now := time.Now()
+
+		lock.Lock()
+		if now.After(nextClean) {
+			for k, v := range cache {
+				if now.After(v.expire) {
+					delete(cache, k)
+				}
+			}
+			nextClean = now.Add(cacheTimeout)
+		}
+		entry, ok := cache[jti]
+		lock.Unlock()
+		if ok && now.Before(entry.expire) {
+			return entry.revoked, nil
+		}
+
+		revoked, err := fetch(jti, sub, sid, iat)
+		if err != nil {
+			return false, err
+		}
+
+		entry = revokedEntry{
+			revoked: revoked,
+			expire:  now.Add(cacheTimeout),
+		}
+		if revoked {
+			entry.expire = exp
+		}
+		lock.Lock()
+		cache[jti] = entry
+		lock.Unlock()
+		return revoked, nil
+	}
+}
@@ -0,0 +1,160 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go"
+	log "github.com/sirupsen/logrus"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/imaging"
+	"time"
+)
+
+const (
+	maxAvatarBytes     = 5 << 20
+	maxAvatarDimension = 4096
+	minAvatarDimension = 32
+	imageURLPrefix     = "/v1/anon/image/"
+)
+
+// Square sizes avatars are stored in, the last one is the profile avatar url.
+var avatarSizes = []int{64, 128, 256}
+
+func avatarPrefix(uid string) string {
+	return "avatar_" + uid + "_"
+}
+
+func avatarObjectName(uid, version string, size int) string {
+	return fmt.Sprintf("%s%s_%d.png", avatarPrefix(uid), version, size)
+}
+
+func (h *Image) UploadAvatar(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	uid := h.middleware.ExtractSub(ctx)
+
+	file, _, err := ctx.Request.FormFile("avatar")
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(file, maxAvatarBytes+1))
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	if len(data) > maxAvatarBytes {
+		errors.AbortWithErrorJSON(ctx, errors.ErrImageTooLarge)
+		return
+	}
+
+	// check dimensions before decoding the whole image
+	imgConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrImageInvalid)
+		return
+	}
+	if imgConfig.Width > maxAvatarDimension || imgConfig.Height > maxAvatarDimension {
+		errors.AbortWithErrorJSON(ctx, errors.ErrImageTooLarge)
+		return
+	}
+	if imgConfig.Width < minAvatarDimension || imgConfig.Height < minAvatarDimension {
+		errors.AbortWithErrorJSON(ctx, errors.ErrImageInvalid)
+		return
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrImageInvalid)
+		return
+	}
+
+	versionBytes := make([]byte, 8)
+	if _, err := rand.Read(versionBytes); err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	version := hex.EncodeToString(versionBytes)
+
+	urls := make(map[string]string, len(avatarSizes))
+	for _, size := range avatarSizes {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, imaging.Square(img, size)); err != nil {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+			return
+		}
+		name := avatarObjectName(uid, version, size)
+		_, err := h.minioClient.PutObject(h.minioBucket, name, &buf, int64(buf.Len()), minio.PutObjectOptions{
+			ContentType: "image/png",
+		})
+		if err != nil {
+			log.Error(err)
+			h.removeAvatars(uid, version, true)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+			return
+		}
+		urls[fmt.Sprint(size)] = imageURLPrefix + name
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.UpdateProfile(timeoutCtx, &uaa.UpdateProfileReq{
+		Profile: &uaa.Profile{
+			Uid:       uid,
+			AvatarUrl: imageURLPrefix + avatarObjectName(uid, version, avatarSizes[len(avatarSizes)-1]),
+		},
+		Fields: []string{"avatar_url"},
+	})
+	if err != nil {
+		log.Error(err)
+		h.removeAvatars(uid, version, true)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	// the new avatar is in use, old ones can go
+	h.removeAvatars(uid, version, false)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"avatar_url": urls[fmt.Sprint(avatarSizes[len(avatarSizes)-1])],
+		"sizes":      urls,
+	})
+}
+
+// removeAvatars removes objects of the given avatar version of uid, or
+// every other version when matched is false. Failures are only logged.
+func (h *Image) removeAvatars(uid, version string, matched bool) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	prefix := avatarPrefix(uid)
+	for obj := range h.minioClient.ListObjects(h.minioBucket, prefix, false, doneCh) {
+		if obj.Err != nil {
+			log.Error(obj.Err)
+			return
+		}
+		if strings.HasPrefix(obj.Key, prefix+version+"_") != matched {
+			continue
+		}
+		if err := h.minioClient.RemoveObject(h.minioBucket, obj.Key); err != nil {
+			log.Error(err)
+		}
+	}
+}
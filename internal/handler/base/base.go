@@ -27,11 +27,12 @@ func (h *Base) HandlerNormal(root gin.IRoutes) {
 	root.GET("/captcha", h.GetCaptchaId)
 	root.GET("/captcha/:id", h.GetCaptchaData)
 
-	root.GET("/profile/:id")
+	root.GET("/profile/:id", h.GetProfile)
+	root.GET("/profiles", h.GetProfiles)
 }
 
 func (h *Base) HandlerAuth(root gin.IRoutes) {
-	root.POST("/profile/:id")
+	root.POST("/profile/:id", h.UpdateProfile)
 }
 
 func (h *Base) HandlerHealth(root gin.IRoutes) {
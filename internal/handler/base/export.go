@@ -0,0 +1,265 @@
+package base
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/minio/minio-go"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"regexp"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/content"
+	"teddy-backend/internal/proto/message"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+const (
+	// Download links stop working after this, the export is removed then
+	exportExpiration = 24 * time.Hour
+	exportCleanTick  = time.Hour
+	exportTimeout    = 5 * time.Minute
+	exportLimit      = 1
+	exportWindow     = time.Hour
+	exportPrefix     = "export_"
+	exportURLPrefix  = "/v1/anon/base/export/"
+)
+
+// The random part is the only secret of a download link
+var exportIDPattern = regexp.MustCompile(`^[0-9a-f]{32}\.zip$`)
+
+type Export struct {
+	middleware  *gin_jwt.JwtMiddleware
+	minioClient *minio.Client
+	minioBucket string
+	marshaler   *jsonpb.Marshaler
+	limiter     components.RateLimiter
+}
+
+func NewExportHandler(middleware *gin_jwt.JwtMiddleware, minioClient *minio.Client, bucket string) (*Export, error) {
+	instance := &Export{
+		middleware:  middleware,
+		minioClient: minioClient,
+		minioBucket: bucket,
+		marshaler: &jsonpb.Marshaler{
+			EnumsAsInts:  false,
+			EmitDefaults: true,
+			Indent:       "  ",
+		},
+		limiter: components.NewRateLimiter(exportLimit, exportWindow),
+	}
+	go instance.cleanTask()
+	return instance, nil
+}
+
+func (h *Export) HandlerNormal(root gin.IRoutes) {
+	root.GET("/export/:id", h.GetExport)
+}
+
+func (h *Export) HandlerAuth(root gin.IRoutes) {
+	root.POST("/export", h.StartExport)
+}
+
+// StartExport collects data of current account in background, the user gets
+// an inbox message with the download link when it is ready.
+func (h *Export) StartExport(ctx *gin.Context) {
+	uid := h.middleware.ExtractSub(ctx)
+
+	if !h.limiter.Allow(uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	go h.runExport(uid, clients.UaaFromContext(ctx), clients.ContentFromContext(ctx),
+		clients.MessageFromContext(ctx))
+
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"expire_in": int64(exportExpiration / time.Second),
+	})
+}
+
+func (h *Export) GetExport(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !exportIDPattern.MatchString(id) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrExportNotFound)
+		return
+	}
+
+	obj, err := h.minioClient.GetObject(h.minioBucket, exportPrefix+id, minio.GetObjectOptions{})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	objStat, err := obj.Stat()
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			errors.AbortWithErrorJSON(ctx, errors.ErrExportNotFound)
+		} else {
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+	// Removed by the clean task later
+	if objStat.LastModified.Add(exportExpiration).Before(time.Now()) {
+		obj.Close()
+		errors.AbortWithErrorJSON(ctx, errors.ErrExportNotFound)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+	ctx.Header("Pragma", "no-cache")
+	ctx.Header("Expires", "0")
+
+	extraHeader := map[string]string{
+		"Content-Disposition": `attachment; filename="teddy-export.zip"`,
+	}
+	ctx.DataFromReader(http.StatusOK, objStat.Size, "application/zip", obj, extraHeader)
+}
+
+func (h *Export) runExport(uid string, uaaClient uaa.UAAClient, contentClient content.ContentClient,
+	messageClient message.MessageClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	topic := "Your data export is ready"
+	text := ""
+	url, err := h.export(ctx, uid, uaaClient, contentClient, messageClient)
+	if err != nil {
+		log.Errorf("export data of %s error: %v", uid, err)
+		topic = "Your data export failed"
+		text = "Something went wrong while exporting your data, please try again later."
+	} else {
+		text = "Download your data from " + url + " within " + exportExpiration.String() + "."
+	}
+
+	_, err = messageClient.SendInBox(ctx, &message.SendInBoxReq{
+		Uid:      uid,
+		Topic:    topic,
+		Content:  text,
+		SendTime: ptypes.TimestampNow(),
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	_, err = messageClient.SendNotify(ctx, &message.SendNotifyReq{
+		Uid:    uid,
+		Topic:  topic,
+		Detail: text,
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// export stores the zip of user data and returns the link to download it.
+func (h *Export) export(ctx context.Context, uid string, uaaClient uaa.UAAClient,
+	contentClient content.ContentClient, messageClient message.MessageClient) (string, error) {
+	account, err := uaaClient.GetOne(ctx, &uaa.GetOneReq{
+		Principal: uid,
+	})
+	if err != nil {
+		return "", err
+	}
+	account.Password = nil
+
+	profile, err := uaaClient.GetProfile(ctx, &uaa.GetProfileReq{
+		Uid:       uid,
+		ViewerUid: uid,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	inbox, err := messageClient.ExportUserData(ctx, &message.UIDReq{
+		Uid: uid,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	userData, err := contentClient.ExportUserData(ctx, &content.UIDReq{
+		Uid: uid,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	files := []struct {
+		name string
+		data proto.Message
+	}{
+		{"account.json", account},
+		{"profile.json", profile},
+		{"inbox.json", inbox},
+		{"infos.json", &content.InfosResp{TotalCount: uint64(len(userData.Infos)), Items: userData.Infos}},
+		{"thumb_ups.json", &content.InfoIDsResp{Items: userData.ThumbUps}},
+		{"thumb_downs.json", &content.InfoIDsResp{Items: userData.ThumbDowns}},
+		{"favorites.json", &content.InfoIDsResp{Items: userData.Favorites}},
+	}
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := zipWriter.Create(file.name)
+		if err != nil {
+			return "", err
+		}
+		if err := h.marshaler.Marshal(w, file.data); err != nil {
+			return "", err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return "", err
+	}
+
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(idBytes) + ".zip"
+
+	_, err = h.minioClient.PutObject(h.minioBucket, exportPrefix+id, &buf, int64(buf.Len()), minio.PutObjectOptions{
+		ContentType: "application/zip",
+	})
+	if err != nil {
+		return "", err
+	}
+	return exportURLPrefix + id, nil
+}
+
+// cleanTask removes expired exports, the links may have been shared.
+func (h *Export) cleanTask() {
+	for {
+		<-time.After(exportCleanTick)
+		h.removeExpired()
+	}
+}
+
+func (h *Export) removeExpired() {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	for obj := range h.minioClient.ListObjects(h.minioBucket, exportPrefix, false, doneCh) {
+		if obj.Err != nil {
+			log.Error(obj.Err)
+			return
+		}
+		if obj.LastModified.Add(exportExpiration).After(time.Now()) {
+			continue
+		}
+		if err := h.minioClient.RemoveObject(h.minioBucket, obj.Key); err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/minio/minio-go"
 	"net/http"
+	"strings"
 	"teddy-backend/internal/gin_jwt"
 	"teddy-backend/internal/handler/errors"
 )
@@ -27,6 +28,7 @@ func (h *Image) HandlerNormal(root gin.IRoutes) {
 }
 
 func (h *Image) HandlerAuth(root gin.IRoutes) {
+	root.POST("/avatar", h.UploadAvatar)
 }
 
 func (h *Image) HandlerHealth(root gin.IRoutes) {
@@ -41,6 +43,11 @@ func (h *Image) ReturnOK(ctx *gin.Context) {
 
 func (h *Image) GetImage(ctx *gin.Context) {
 	idStr := ctx.Param("id")
+	// Exports share the bucket but are only served by their own link
+	if strings.HasPrefix(idStr, exportPrefix) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrForbidden)
+		return
+	}
 
 	ctx.Header("Cache-Control", "no-cache, no-store, must-revalidate")
 	ctx.Header("Pragma", "no-cache")
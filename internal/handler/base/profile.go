@@ -0,0 +1,205 @@
+package base
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+const maxBatchProfiles = 100
+
+type profileResp struct {
+	UID           string     `json:"uid"`
+	Firstname     string     `json:"firstname"`
+	Lastname      string     `json:"lastname"`
+	AvatarUrl     string     `json:"avatar_url"`
+	Bio           string     `json:"bio"`
+	Birthday      *time.Time `json:"birthday,omitempty"`
+	Gender        string     `json:"gender"`
+	Locale        string     `json:"locale"`
+	UpdateDate    *time.Time `json:"update_date,omitempty"`
+	PrivateFields []string   `json:"private_fields,omitempty"`
+}
+
+func newProfileResp(profile *uaa.Profile) *profileResp {
+	resp := &profileResp{
+		UID:           profile.Uid,
+		Firstname:     profile.Firstname,
+		Lastname:      profile.Lastname,
+		AvatarUrl:     profile.AvatarUrl,
+		Bio:           profile.Bio,
+		Gender:        strings.ToLower(profile.Gender.String()),
+		Locale:        profile.Locale,
+		PrivateFields: profile.PrivateFields,
+	}
+	if profile.Birthday != nil {
+		if birthday, err := ptypes.Timestamp(profile.Birthday); err == nil && !birthday.IsZero() {
+			resp.Birthday = &birthday
+		}
+	}
+	if profile.UpdateDate != nil {
+		if updateDate, err := ptypes.Timestamp(profile.UpdateDate); err == nil && !updateDate.IsZero() {
+			resp.UpdateDate = &updateDate
+		}
+	}
+	return resp
+}
+
+func (h *Base) GetProfile(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	profile, err := uaaClient.GetProfile(timeoutCtx, &uaa.GetProfileReq{
+		Uid:       ctx.Param("id"),
+		ViewerUid: h.middleware.ExtractSub(ctx),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			errors.AbortWithErrorJSON(ctx, errors.ErrAccountNotFound)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newProfileResp(profile))
+}
+
+// GetProfiles looks up profiles of comma separated uids, unknown ones are left out.
+func (h *Base) GetProfiles(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	var uids []string
+	for _, v := range strings.Split(ctx.Query("uids"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			uids = append(uids, v)
+		}
+	}
+	if len(uids) == 0 || len(uids) > maxBatchProfiles {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.BatchGetProfiles(timeoutCtx, &uaa.BatchGetProfilesReq{
+		Uids:      uids,
+		ViewerUid: h.middleware.ExtractSub(ctx),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	profiles := make([]*profileResp, 0, len(resp.Profiles))
+	for _, v := range resp.Profiles {
+		profiles = append(profiles, newProfileResp(v))
+	}
+	ctx.JSON(http.StatusOK, profiles)
+}
+
+// UpdateProfile only touches the fields present in the body.
+func (h *Base) UpdateProfile(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	uid := ctx.Param("id")
+	if uid != h.middleware.ExtractSub(ctx) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrForbidden)
+		return
+	}
+
+	// parse body
+	type updateProfileReq struct {
+		Firstname     *string    `json:"firstname"`
+		Lastname      *string    `json:"lastname"`
+		Bio           *string    `json:"bio"`
+		Birthday      *time.Time `json:"birthday"`
+		Gender        *string    `json:"gender"`
+		Locale        *string    `json:"locale"`
+		PrivateFields *[]string  `json:"private_fields"`
+	}
+	var body updateProfileReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	profile := &uaa.Profile{Uid: uid}
+	var fields []string
+	if body.Firstname != nil {
+		profile.Firstname = *body.Firstname
+		fields = append(fields, "firstname")
+	}
+	if body.Lastname != nil {
+		profile.Lastname = *body.Lastname
+		fields = append(fields, "lastname")
+	}
+	if body.Bio != nil {
+		profile.Bio = *body.Bio
+		fields = append(fields, "bio")
+	}
+	if body.Birthday != nil {
+		profile.Birthday, err = ptypes.TimestampProto(*body.Birthday)
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+		fields = append(fields, "birthday")
+	}
+	if body.Gender != nil {
+		gender, ok := uaa.Gender_value[strings.ToUpper(*body.Gender)]
+		if !ok {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+		profile.Gender = uaa.Gender(gender)
+		fields = append(fields, "gender")
+	}
+	if body.Locale != nil {
+		profile.Locale = *body.Locale
+		fields = append(fields, "locale")
+	}
+	if body.PrivateFields != nil {
+		profile.PrivateFields = *body.PrivateFields
+		fields = append(fields, "private_fields")
+	}
+	if len(fields) == 0 {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	// make request
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.UpdateProfile(timeoutCtx, &uaa.UpdateProfileReq{
+		Profile: profile,
+		Fields:  fields,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			errors.AbortWithErrorJSON(ctx, errors.ErrAccountNotFound)
+		} else if status.Code(err) == codes.Internal {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		} else {
+			// rejected by validation, e.g. unknown private field
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newProfileResp(resp))
+}
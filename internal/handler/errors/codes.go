@@ -12,4 +12,56 @@ const (
 	ErrCodeCaptchaNotCorrect
 	ErrCodeRegisterTypeNotSupport
 	ErrCodeAccountExists
+	ErrCodeOAuthProviderNotSupport
+	ErrCodeOAuthStateNotCorrect
+	ErrCodeOAuthExchangeFailed
+	ErrCodeAccountLocked
+	ErrCodeCredentialsExpired
+	ErrCodeMustChangePassword
+	ErrCodeAccountNotFound
+	ErrCodeRefreshTokenInvalid
+	ErrCodeTooManyRequests
+	ErrCodePhoneInvalid
+	ErrCodeTwoFactorChallengeInvalid
+	ErrCodeTwoFactorCodeInvalid
+	ErrCodeTwoFactorNotEnabled
+	ErrCodeTwoFactorAlreadyEnabled
+	ErrCodeCaptchaRequired
+	ErrCodeImageInvalid
+	ErrCodeImageTooLarge
+	ErrCodeSessionNotFound
+	ErrCodeRoleInvalid
+	ErrCodePolicyInvalid
+	ErrCodePolicyNotFound
+	ErrCodeEmailInvalid
+	ErrCodeEmailNotSet
+	ErrCodeEmailAlreadyVerified
+	ErrCodeEmailTaken
+	ErrCodeEmailChangeNotFound
+	ErrCodeDeletionNotFound
+	ErrCodeDeletionNotPending
+	ErrCodeExportNotFound
+	ErrCodePasswordTooShort
+	ErrCodePasswordTooLong
+	ErrCodePasswordMissingClasses
+	ErrCodePasswordTooCommon
+	ErrCodePasswordReused
+	ErrCodeClientInvalid
+	ErrCodeClientNotFound
+	ErrCodeGrantTypeNotSupport
+	ErrCodeScopeInvalid
+	ErrCodePersonalTokenNotFound
+	ErrCodePersonalTokenExpireInvalid
+	ErrCodeTooManyPersonalTokens
+	ErrCodePersonalTokenScopeInvalid
+	ErrCodeOAuthIdentityTaken
+	ErrCodeOAuthProviderLinked
+	ErrCodeOAuthNotLinked
+	ErrCodeLastLoginMethod
+	ErrCodeMergeNotAllowed
+	ErrCodeMergeTokenInvalid
+	ErrCodeReauthRequired
+	ErrCodeReauthTokenInvalid
+	ErrCodeRedirectURIInvalid
+	ErrCodeAuthorizationCodeInvalid
 )
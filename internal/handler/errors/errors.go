@@ -36,3 +36,159 @@ var ErrRegisterTypeNotSupport = DefineCodeError(http.StatusNotFound, ErrCodeRegi
 
 var ErrAccountExists = DefineCodeError(http.StatusBadRequest, ErrCodeAccountExists,
 	"account has been register, please check your request")
+
+var ErrOAuthProviderNotSupport = DefineCodeError(http.StatusNotFound, ErrCodeOAuthProviderNotSupport,
+	"oauth provider not support, please check your request")
+
+var ErrOAuthStateNotCorrect = DefineCodeError(http.StatusBadRequest, ErrCodeOAuthStateNotCorrect,
+	"oauth state not correct, please login again")
+
+var ErrOAuthExchangeFailed = DefineCodeError(http.StatusUnauthorized, ErrCodeOAuthExchangeFailed,
+	"oauth authorization failed, please login again")
+
+var ErrAccountLocked = DefineCodeError(http.StatusForbidden, ErrCodeAccountLocked,
+	"account has been locked, please contact administrator")
+
+var ErrCredentialsExpired = DefineCodeError(http.StatusForbidden, ErrCodeCredentialsExpired,
+	"password expired, please change your password")
+
+var ErrMustChangePassword = DefineCodeError(http.StatusForbidden, ErrCodeMustChangePassword,
+	"password must be changed before login")
+
+var ErrAccountNotFound = DefineCodeError(http.StatusNotFound, ErrCodeAccountNotFound,
+	"account not found, please check your request")
+
+var ErrRefreshTokenInvalid = DefineCodeError(http.StatusUnauthorized, ErrCodeRefreshTokenInvalid,
+	"refresh token invalid, please login again")
+
+var ErrTooManyRequests = DefineCodeError(http.StatusTooManyRequests, ErrCodeTooManyRequests,
+	"too many requests, please try again later")
+
+var ErrPhoneInvalid = DefineCodeError(http.StatusBadRequest, ErrCodePhoneInvalid,
+	"phone number is invalid, please check your phone")
+
+var ErrTwoFactorChallengeInvalid = DefineCodeError(http.StatusUnauthorized, ErrCodeTwoFactorChallengeInvalid,
+	"two factor challenge invalid or expired, please login again")
+
+var ErrTwoFactorCodeInvalid = DefineCodeError(http.StatusUnauthorized, ErrCodeTwoFactorCodeInvalid,
+	"two factor code not correct")
+
+var ErrTwoFactorNotEnabled = DefineCodeError(http.StatusBadRequest, ErrCodeTwoFactorNotEnabled,
+	"two factor not enabled, please enroll first")
+
+var ErrTwoFactorAlreadyEnabled = DefineCodeError(http.StatusBadRequest, ErrCodeTwoFactorAlreadyEnabled,
+	"two factor already enabled")
+
+var ErrCaptchaRequired = DefineCodeError(http.StatusUnauthorized, ErrCodeCaptchaRequired,
+	"too many failures, please solve the image captcha")
+
+var ErrImageInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeImageInvalid,
+	"image invalid, only jpeg, png and gif are supported")
+
+var ErrImageTooLarge = DefineCodeError(http.StatusRequestEntityTooLarge, ErrCodeImageTooLarge,
+	"image too large, please upload a smaller one")
+
+var ErrSessionNotFound = DefineCodeError(http.StatusNotFound, ErrCodeSessionNotFound,
+	"session not found, it may have expired")
+
+var ErrRoleInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeRoleInvalid,
+	"role invalid, use lower case letters, digits, '-' and '_' starting with a letter")
+
+var ErrPolicyInvalid = DefineCodeError(http.StatusBadRequest, ErrCodePolicyInvalid,
+	"policy invalid, subject, object and action are required")
+
+var ErrPolicyNotFound = DefineCodeError(http.StatusNotFound, ErrCodePolicyNotFound,
+	"policy not found, please check your request")
+
+var ErrEmailInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeEmailInvalid,
+	"email invalid or unchanged, please check your email")
+
+var ErrEmailNotSet = DefineCodeError(http.StatusBadRequest, ErrCodeEmailNotSet,
+	"account has no email, please add one first")
+
+var ErrEmailAlreadyVerified = DefineCodeError(http.StatusBadRequest, ErrCodeEmailAlreadyVerified,
+	"email already verified")
+
+var ErrEmailTaken = DefineCodeError(http.StatusConflict, ErrCodeEmailTaken,
+	"email used by another account")
+
+var ErrEmailChangeNotFound = DefineCodeError(http.StatusNotFound, ErrCodeEmailChangeNotFound,
+	"email change not found or expired, please start again")
+
+var ErrDeletionNotFound = DefineCodeError(http.StatusNotFound, ErrCodeDeletionNotFound,
+	"account deletion not found")
+
+var ErrDeletionNotPending = DefineCodeError(http.StatusConflict, ErrCodeDeletionNotPending,
+	"account deletion has started and can't be canceled")
+
+var ErrExportNotFound = DefineCodeError(http.StatusNotFound, ErrCodeExportNotFound,
+	"export not found or expired, please export again")
+
+var ErrPasswordTooShort = DefineCodeError(http.StatusBadRequest, ErrCodePasswordTooShort,
+	"password too short, please choose a longer one")
+
+var ErrPasswordTooLong = DefineCodeError(http.StatusBadRequest, ErrCodePasswordTooLong,
+	"password too long, please choose a shorter one")
+
+var ErrPasswordMissingClasses = DefineCodeError(http.StatusBadRequest, ErrCodePasswordMissingClasses,
+	"password must mix the required kinds of characters")
+
+var ErrPasswordTooCommon = DefineCodeError(http.StatusBadRequest, ErrCodePasswordTooCommon,
+	"password too common, please choose another one")
+
+var ErrPasswordReused = DefineCodeError(http.StatusBadRequest, ErrCodePasswordReused,
+	"password used recently, please choose another one")
+
+var ErrClientInvalid = DefineCodeError(http.StatusUnauthorized, ErrCodeClientInvalid,
+	"client id or secret not correct")
+
+var ErrClientNotFound = DefineCodeError(http.StatusNotFound, ErrCodeClientNotFound,
+	"client not found")
+
+var ErrGrantTypeNotSupport = DefineCodeError(http.StatusBadRequest, ErrCodeGrantTypeNotSupport,
+	"grant type not support")
+
+var ErrScopeInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeScopeInvalid,
+	"scope not allowed for the client")
+
+var ErrPersonalTokenNotFound = DefineCodeError(http.StatusNotFound, ErrCodePersonalTokenNotFound,
+	"personal token not found")
+
+var ErrPersonalTokenExpireInvalid = DefineCodeError(http.StatusBadRequest, ErrCodePersonalTokenExpireInvalid,
+	"personal token must expire within a year")
+
+var ErrTooManyPersonalTokens = DefineCodeError(http.StatusBadRequest, ErrCodeTooManyPersonalTokens,
+	"too many personal tokens, please revoke unused ones")
+
+var ErrPersonalTokenScopeInvalid = DefineCodeError(http.StatusBadRequest, ErrCodePersonalTokenScopeInvalid,
+	"personal token scope invalid")
+
+var ErrOAuthIdentityTaken = DefineCodeError(http.StatusConflict, ErrCodeOAuthIdentityTaken,
+	"oauth identity belongs to another account")
+
+var ErrOAuthProviderLinked = DefineCodeError(http.StatusConflict, ErrCodeOAuthProviderLinked,
+	"another identity of the provider is linked, please unlink it first")
+
+var ErrOAuthNotLinked = DefineCodeError(http.StatusNotFound, ErrCodeOAuthNotLinked,
+	"oauth provider not linked")
+
+var ErrLastLoginMethod = DefineCodeError(http.StatusBadRequest, ErrCodeLastLoginMethod,
+	"last login method can't be unlinked, please set a password or link another provider first")
+
+var ErrMergeNotAllowed = DefineCodeError(http.StatusForbidden, ErrCodeMergeNotAllowed,
+	"the account of the identity has other login methods and can't be merged")
+
+var ErrMergeTokenInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeMergeTokenInvalid,
+	"merge token invalid or expired")
+
+var ErrReauthRequired = DefineCodeError(http.StatusForbidden, ErrCodeReauthRequired,
+	"please sign in again with a linked provider first")
+
+var ErrReauthTokenInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeReauthTokenInvalid,
+	"reauth token invalid or expired")
+
+var ErrRedirectURIInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeRedirectURIInvalid,
+	"client or redirect uri invalid")
+
+var ErrAuthorizationCodeInvalid = DefineCodeError(http.StatusBadRequest, ErrCodeAuthorizationCodeInvalid,
+	"authorization code invalid, expired or used")
@@ -0,0 +1,301 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// accountView is the account as shown to admins, without password or two
+// factor secrets.
+type accountView struct {
+	UID                string            `json:"uid"`
+	Username           string            `json:"username"`
+	Email              string            `json:"email"`
+	EmailVerified      bool              `json:"email_verified"`
+	Phone              string            `json:"phone"`
+	Roles              []string          `json:"roles"`
+	OAuthUIDs          map[string]string `json:"oauth_uids"`
+	Locked             bool              `json:"locked"`
+	LockedUntil        time.Time         `json:"locked_until"`
+	CredentialsExpired bool              `json:"credentials_expired"`
+	MustChangePassword bool              `json:"must_change_password"`
+	TwoFactorEnabled   bool              `json:"two_factor_enabled"`
+	CreateDate         time.Time         `json:"create_date"`
+	UpdateDate         time.Time         `json:"update_date"`
+	LastSignInIP       string            `json:"last_sign_in_ip"`
+	LastSignInTime     time.Time         `json:"last_sign_in_time"`
+}
+
+func newAccountView(acc *uaa.Account) *accountView {
+	view := &accountView{
+		UID:                acc.Uid,
+		Username:           acc.Username,
+		Email:              acc.Email,
+		EmailVerified:      acc.EmailVerified,
+		Phone:              acc.Phone,
+		Roles:              acc.Roles,
+		OAuthUIDs:          acc.OauthUIDs,
+		Locked:             acc.Locked,
+		CredentialsExpired: acc.CredentialsExpired,
+		MustChangePassword: acc.MustChangePassword,
+		TwoFactorEnabled:   acc.TwoFactorEnabled,
+		LastSignInIP:       acc.LastSignInIP,
+	}
+	view.LockedUntil, _ = ptypes.Timestamp(acc.LockedUntil)
+	view.CreateDate, _ = ptypes.Timestamp(acc.CreateDate)
+	view.UpdateDate, _ = ptypes.Timestamp(acc.UpdateDate)
+	view.LastSignInTime, _ = ptypes.Timestamp(acc.LastSignInTime)
+	return view
+}
+
+func parseBoolFilter(value string) (uaa.BoolFilter, bool) {
+	switch value {
+	case "":
+		return uaa.BoolFilter_ANY, true
+	case "true":
+		return uaa.BoolFilter_YES, true
+	case "false":
+		return uaa.BoolFilter_NO, true
+	}
+	return uaa.BoolFilter_ANY, false
+}
+
+// timeQueryProto keeps an absent time query absent.
+func timeQueryProto(t time.Time) (*timestamp.Timestamp, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(t)
+}
+
+// buildAccountSorts parses sorts as "name:asc,name:desc", order defaults to
+// desc.
+func buildAccountSorts(raw string) ([]*uaa.Sort, bool) {
+	if raw == "" {
+		return nil, true
+	}
+	var sorts []*uaa.Sort
+	for _, item := range strings.Split(raw, ",") {
+		nameAndOrder := strings.Split(item, ":")
+		sort := &uaa.Sort{Name: nameAndOrder[0]}
+		if len(nameAndOrder) == 2 {
+			switch strings.ToUpper(nameAndOrder[1]) {
+			case "ASC":
+				sort.Asc = true
+			case "DESC":
+			default:
+				return nil, false
+			}
+		} else if len(nameAndOrder) > 2 {
+			return nil, false
+		}
+		sorts = append(sorts, sort)
+	}
+	return sorts, true
+}
+
+// ListAccounts pages through accounts, filters are combined, dates are
+// RFC3339 and ranges exclude their end.
+func (h *Uaa) ListAccounts(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	type queryReq struct {
+		Page               uint32    `form:"page"`
+		Size               uint32    `form:"size"`
+		Sorts              string    `form:"sorts"`
+		Role               string    `form:"role"`
+		Locked             string    `form:"locked"`
+		CredentialsExpired string    `form:"credentials_expired"`
+		CreatedFrom        time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+		CreatedTo          time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+		LastSignInFrom     time.Time `form:"last_sign_in_from" time_format:"2006-01-02T15:04:05Z07:00"`
+		LastSignInTo       time.Time `form:"last_sign_in_to" time_format:"2006-01-02T15:04:05Z07:00"`
+		Prefix             string    `form:"prefix"`
+	}
+	var query queryReq
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	req := &uaa.GetAllReq{
+		Page:   query.Page,
+		Size:   query.Size,
+		Role:   query.Role,
+		Prefix: strings.TrimSpace(query.Prefix),
+	}
+	var ok, lockedOK, expiredOK bool
+	req.Sorts, ok = buildAccountSorts(query.Sorts)
+	req.Locked, lockedOK = parseBoolFilter(query.Locked)
+	req.CredentialsExpired, expiredOK = parseBoolFilter(query.CredentialsExpired)
+	if !ok || !lockedOK || !expiredOK {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	var err error
+	for _, v := range []struct {
+		dst **timestamp.Timestamp
+		src time.Time
+	}{
+		{&req.CreatedFrom, query.CreatedFrom},
+		{&req.CreatedTo, query.CreatedTo},
+		{&req.LastSignInFrom, query.LastSignInFrom},
+		{&req.LastSignInTo, query.LastSignInTo},
+	} {
+		if *v.dst, err = timeQueryProto(v.src); err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.GetAll(timeoutCtx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	items := make([]*accountView, 0, len(resp.Accounts))
+	for _, v := range resp.Accounts {
+		items = append(items, newAccountView(v))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"totalCount": resp.TotalCount,
+		"items":      items,
+	})
+}
+
+func (h *Uaa) LockAccount(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body, lock forever without until
+	type lockAccountReq struct {
+		Until *time.Time `json:"until"`
+	}
+	var body lockAccountReq
+	err := ctx.ShouldBindJSON(&body)
+	if err != nil && err != io.EOF {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	var lockedUntil *timestamp.Timestamp
+	if body.Until != nil {
+		if body.Until.Before(time.Now()) {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+		lockedUntil, err = ptypes.TimestampProto(*body.Until)
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.DoLockAccount(timeoutCtx, &uaa.LockAccountReq{
+		Uid:         ctx.Param("uid"),
+		LockedUntil: lockedUntil,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) UnlockAccount(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.DoUnlockAccount(timeoutCtx, &uaa.UIDReq{
+		Uid: ctx.Param("uid"),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) ExpireCredentials(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type expireCredentialsReq struct {
+		MustChangePassword bool `json:"must_change_password"`
+	}
+	var body expireCredentialsReq
+	err := ctx.ShouldBindJSON(&body)
+	if err != nil && err != io.EOF {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.DoCredentialsExpired(timeoutCtx, &uaa.CredentialsExpiredReq{
+		Uid:                ctx.Param("uid"),
+		MustChangePassword: body.MustChangePassword,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) RenewCredentials(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.DoRenewCredentials(timeoutCtx, &uaa.UIDReq{
+		Uid: ctx.Param("uid"),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) LogoutAccount(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.RevokeAllTokens(timeoutCtx, &uaa.UIDReq{
+		Uid: ctx.Param("uid"),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
@@ -0,0 +1,103 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/audit"
+	"time"
+)
+
+type auditEventView struct {
+	ID     string            `json:"id"`
+	Type   string            `json:"type"`
+	Actor  string            `json:"actor"`
+	Target string            `json:"target"`
+	IP     string            `json:"ip"`
+	Time   time.Time         `json:"time"`
+	Detail map[string]string `json:"detail"`
+}
+
+func newAuditEventView(event *audit.Event) *auditEventView {
+	view := &auditEventView{
+		ID:     event.Id,
+		Type:   event.Type,
+		Actor:  event.Actor,
+		Target: event.Target,
+		IP:     event.Ip,
+		Detail: event.Detail,
+	}
+	view.Time, _ = ptypes.Timestamp(event.Time)
+	return view
+}
+
+// ListAuditEvents pages through audit events most recent first, filters are
+// combined, dates are RFC3339 and the range excludes its end.
+func (h *Uaa) ListAuditEvents(ctx *gin.Context) {
+	auditClient := clients.AuditFromContext(ctx)
+
+	type queryReq struct {
+		Page   uint32    `form:"page"`
+		Size   uint32    `form:"size"`
+		Actor  string    `form:"actor"`
+		Target string    `form:"target"`
+		Type   string    `form:"type"`
+		From   time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+		To     time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	}
+	var query queryReq
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	req := &audit.QueryReq{
+		Page:   query.Page,
+		Size:   query.Size,
+		Actor:  query.Actor,
+		Target: query.Target,
+		Type:   query.Type,
+	}
+	var err error
+	for _, v := range []struct {
+		dst **timestamp.Timestamp
+		src time.Time
+	}{
+		{&req.From, query.From},
+		{&req.To, query.To},
+	} {
+		if *v.dst, err = timeQueryProto(v.src); err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := auditClient.Query(timeoutCtx, req)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	items := make([]*auditEventView, 0, len(resp.Items))
+	for _, v := range resp.Items {
+		items = append(items, newAuditEventView(v))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"totalCount": resp.TotalCount,
+		"items":      items,
+	})
+}
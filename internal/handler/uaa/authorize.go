@@ -0,0 +1,270 @@
+package uaa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/url"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// Authorization codes are exchanged by the client right after the redirect
+const authorizationCodeAudience = "uaa-oidc-code"
+const authorizationCodeExpiration = time.Minute
+
+const grantTypeAuthorizationCode = "authorization_code"
+const responseTypeCode = "code"
+const codeChallengeMethodS256 = "S256"
+
+// Tokens issued to clients for users only reach userinfo, by the openid scope
+// in the policy store
+var oidcAccessTokenAudience = []string{"uaa"}
+
+// authorizationReq is an authorization request of OpenID Connect, taken from
+// the query by Authorize and from the body by ConfirmAuthorization.
+type authorizationReq struct {
+	ResponseType        string `form:"response_type" json:"response_type"`
+	ClientID            string `form:"client_id" json:"client_id"`
+	RedirectURI         string `form:"redirect_uri" json:"redirect_uri"`
+	Scope               string `form:"scope" json:"scope"`
+	State               string `form:"state" json:"state"`
+	Nonce               string `form:"nonce" json:"nonce"`
+	CodeChallenge       string `form:"code_challenge" json:"code_challenge"`
+	CodeChallengeMethod string `form:"code_challenge_method" json:"code_challenge_method"`
+}
+
+// checkAuthorizationClient aborts unless the redirect uri is registered by
+// the client, errors are never sent to an unchecked redirect uri.
+func (h *Uaa) checkAuthorizationClient(ctx *gin.Context, req *authorizationReq) bool {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	if req.ClientID == "" || req.RedirectURI == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrRedirectURIInvalid)
+		return false
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	client, err := uaaClient.GetClient(timeoutCtx, &uaa.ClientIDReq{
+		ClientId: req.ClientID,
+	})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Error(err)
+		}
+		errors.AbortWithErrorJSON(ctx, errors.ErrRedirectURIInvalid)
+		return false
+	}
+	if !components.ContainsString(client.RedirectUris, req.RedirectURI) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrRedirectURIInvalid)
+		return false
+	}
+	return true
+}
+
+// authorizationReqError is the error code of OAuth sent back to the client,
+// empty when the request is fine.
+func authorizationReqError(req *authorizationReq) string {
+	if req.ResponseType != responseTypeCode {
+		return "unsupported_response_type"
+	}
+	if !components.ContainsString(strings.Fields(req.Scope), "openid") {
+		return "invalid_scope"
+	}
+	// Only S256, plain would give the verifier to whoever sees the request
+	if (req.CodeChallenge != "" || req.CodeChallengeMethod != "") &&
+		(req.CodeChallenge == "" || req.CodeChallengeMethod != codeChallengeMethodS256) {
+		return "invalid_request"
+	}
+	return ""
+}
+
+// redirectWith adds values to the query of rawURL, keeping its own query.
+func redirectWith(rawURL string, values url.Values) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := u.Query()
+	for k, v := range values {
+		if len(v) != 0 && v[0] != "" {
+			query[k] = v
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// Authorize is the authorization endpoint of OpenID Connect. Users sign in on
+// the login page of the web app, which gets the request in its query and
+// confirms it by ConfirmAuthorization.
+func (h *Uaa) Authorize(ctx *gin.Context) {
+	var req authorizationReq
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	if !h.checkAuthorizationClient(ctx, &req) {
+		return
+	}
+	if code := authorizationReqError(&req); code != "" {
+		ctx.Redirect(http.StatusFound, redirectWith(req.RedirectURI, url.Values{
+			"error": {code},
+			"state": {req.State},
+		}))
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, redirectWith(h.oidcLoginURL, ctx.Request.URL.Query()))
+}
+
+// ConfirmAuthorization issues an authorization code of current account, the
+// web app sends the user to the returned redirect uri.
+func (h *Uaa) ConfirmAuthorization(ctx *gin.Context) {
+	var req authorizationReq
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	if !h.checkAuthorizationClient(ctx, &req) {
+		return
+	}
+	if code := authorizationReqError(&req); code != "" {
+		ctx.JSON(http.StatusOK, gin.H{
+			"redirect_uri": redirectWith(req.RedirectURI, url.Values{
+				"error": {code},
+				"state": {req.State},
+			}),
+		})
+		return
+	}
+
+	// Unknown scopes are left out
+	var scopes []string
+	for _, scope := range strings.Fields(req.Scope) {
+		if components.ContainsString(oidcScopes, scope) && !components.ContainsString(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	code, err := h.generator.GenerateJwt(authorizationCodeExpiration, h.middle.ExtractSub(ctx),
+		[]string{authorizationCodeAudience}, jwt.MapClaims{
+			"client_id":      req.ClientID,
+			"redirect_uri":   req.RedirectURI,
+			"scope":          strings.Join(scopes, " "),
+			"nonce":          req.Nonce,
+			"code_challenge": req.CodeChallenge,
+		})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"redirect_uri": redirectWith(req.RedirectURI, url.Values{
+			"code":  {code},
+			"state": {req.State},
+		}),
+	})
+}
+
+// authorizationCodeToken exchanges a code issued to client for an ID token and
+// an access token of the user, a code is taken once.
+func (h *Uaa) authorizationCodeToken(ctx *gin.Context, client *uaa.OAuthClient, code, redirectURI,
+	codeVerifier string) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	claims, err := h.middle.ParseToken(code, authorizationCodeAudience)
+	if err != nil || claims["client_id"] != client.ClientId || claims["redirect_uri"] != redirectURI {
+		errors.AbortWithErrorJSON(ctx, errors.ErrAuthorizationCodeInvalid)
+		return
+	}
+	if challenge, _ := claims["code_challenge"].(string); challenge != "" {
+		sum := sha256.Sum256([]byte(codeVerifier))
+		if codeVerifier == "" || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			errors.AbortWithErrorJSON(ctx, errors.ErrAuthorizationCodeInvalid)
+			return
+		}
+	}
+	uid, _ := claims["sub"].(string)
+	jti, _ := claims["jti"].(string)
+	exp, _ := claims["exp"].(float64)
+	expireTime, err := ptypes.TimestampProto(time.Unix(int64(exp), 0).Add(gin_jwt.DefaultLeeway))
+	if err != nil || uid == "" || jti == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrAuthorizationCodeInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.UseToken(timeoutCtx, &uaa.UseTokenReq{
+		Jti:        jti,
+		Uid:        uid,
+		ExpireTime: expireTime,
+	})
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			errors.AbortWithErrorJSON(ctx, errors.ErrAuthorizationCodeInvalid)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: uid,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrAuthorizationCodeInvalid)
+		return
+	}
+
+	scope, _ := claims["scope"].(string)
+	accessToken, err := h.generator.GenerateJwt(accessTokenExpiration, uid, oidcAccessTokenAudience,
+		jwt.MapClaims{
+			"client_id": client.ClientId,
+			"scope":     scope,
+		})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	idClaims := oidcScopedClaims(acc, strings.Fields(scope))
+	idClaims["azp"] = client.ClientId
+	if nonce, _ := claims["nonce"].(string); nonce != "" {
+		idClaims["nonce"] = nonce
+	}
+	idToken, err := h.generator.GenerateIDToken(h.oidcIssuer(), idTokenExpiration, uid,
+		[]string{client.ClientId}, idClaims)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+	ctx.JSON(http.StatusOK, gin.H{
+		"access_token": accessToken,
+		"token_type":   "bearer",
+		"expires_in":   int64(accessTokenExpiration / time.Second),
+		"scope":        scope,
+		"id_token":     idToken,
+	})
+}
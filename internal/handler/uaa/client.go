@@ -0,0 +1,229 @@
+package uaa
+
+import (
+	"context"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// Clients have no refresh token, they ask again with their secret
+const clientTokenExpiration = time.Hour
+
+const grantTypeClientCredentials = "client_credentials"
+
+type clientView struct {
+	ClientID     string    `json:"client_id"`
+	Name         string    `json:"name"`
+	Scopes       []string  `json:"scopes"`
+	RedirectURIs []string  `json:"redirect_uris"`
+	CreateDate   time.Time `json:"create_date"`
+	UpdateDate   time.Time `json:"update_date"`
+}
+
+func newClientView(client *uaa.OAuthClient) *clientView {
+	view := &clientView{
+		ClientID:     client.ClientId,
+		Name:         client.Name,
+		Scopes:       client.Scopes,
+		RedirectURIs: client.RedirectUris,
+	}
+	view.CreateDate, _ = ptypes.Timestamp(client.CreateDate)
+	view.UpdateDate, _ = ptypes.Timestamp(client.UpdateDate)
+	return view
+}
+
+// Token is the token endpoint of the authorization_code and client_credentials
+// grants, clients authenticate by HTTP basic auth or by client_id and
+// client_secret in form. The form is only taken from the body, secrets in urls
+// end up in logs.
+func (h *Uaa) Token(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse form
+	type tokenReq struct {
+		GrantType    string `form:"grant_type" binding:"required"`
+		ClientID     string `form:"client_id"`
+		ClientSecret string `form:"client_secret"`
+		Scope        string `form:"scope"`
+		Code         string `form:"code"`
+		RedirectURI  string `form:"redirect_uri"`
+		CodeVerifier string `form:"code_verifier"`
+	}
+	var body tokenReq
+	err := ctx.ShouldBindWith(&body, binding.FormPost)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	if body.GrantType != grantTypeClientCredentials && body.GrantType != grantTypeAuthorizationCode {
+		errors.AbortWithErrorJSON(ctx, errors.ErrGrantTypeNotSupport)
+		return
+	}
+	if clientID, clientSecret, ok := ctx.Request.BasicAuth(); ok {
+		body.ClientID, body.ClientSecret = clientID, clientSecret
+	}
+	if body.ClientID == "" || body.ClientSecret == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrClientInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	client, err := uaaClient.VerifyClient(timeoutCtx, &uaa.VerifyClientReq{
+		ClientId: body.ClientID,
+		Secret:   body.ClientSecret,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			errors.AbortWithErrorJSON(ctx, errors.ErrClientInvalid)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	if body.GrantType == grantTypeAuthorizationCode {
+		h.authorizationCodeToken(ctx, client, body.Code, body.RedirectURI, body.CodeVerifier)
+	} else {
+		h.clientCredentialsToken(ctx, client, body.Scope)
+	}
+}
+
+// clientCredentialsToken issues an access token of client itself, without
+// scope all scopes of the client are granted.
+func (h *Uaa) clientCredentialsToken(ctx *gin.Context, client *uaa.OAuthClient, requestedScope string) {
+	scopes := client.Scopes
+	if requested := strings.Fields(requestedScope); len(requested) != 0 {
+		for _, scope := range requested {
+			if !components.ContainsString(client.Scopes, scope) {
+				errors.AbortWithErrorJSON(ctx, errors.ErrScopeInvalid)
+				return
+			}
+		}
+		scopes = requested
+	}
+	scope := strings.Join(scopes, " ")
+
+	token, err := h.generator.GenerateJwt(clientTokenExpiration, gin_jwt.ClientSubjectPrefix+client.ClientId,
+		accessTokenAudience, jwt.MapClaims{
+			"client_id": client.ClientId,
+			"scope":     scope,
+		})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+	ctx.JSON(http.StatusOK, gin.H{
+		"access_token": token,
+		"token_type":   "bearer",
+		"expires_in":   int64(clientTokenExpiration / time.Second),
+		"scope":        scope,
+	})
+}
+
+func (h *Uaa) ListClients(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.GetClients(timeoutCtx, &empty.Empty{})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	items := make([]*clientView, 0, len(resp.Items))
+	for _, v := range resp.Items {
+		items = append(items, newClientView(v))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"items": items,
+	})
+}
+
+// AddClient registers a client, its secret is only returned here.
+func (h *Uaa) AddClient(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body, scopes are roles, redirect uris are only needed by clients
+	// signing users in
+	type addClientReq struct {
+		Name         string   `json:"name" binding:"required"`
+		Scopes       []string `json:"scopes" binding:"required"`
+		RedirectURIs []string `json:"redirect_uris"`
+	}
+	var body addClientReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.CreateClient(timeoutCtx, &uaa.CreateClientReq{
+		Name:         body.Name,
+		Scopes:       body.Scopes,
+		RedirectUris: body.RedirectURIs,
+	})
+	if err != nil {
+		if reasonFromError(err) == uaa.ErrorReason_REDIRECT_URI_INVALID {
+			errors.AbortWithErrorJSON(ctx, errors.ErrRedirectURIInvalid)
+		} else if status.Code(err) == codes.InvalidArgument {
+			errors.AbortWithErrorJSON(ctx, errors.ErrRoleInvalid)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+	h.loadPolicy()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"client":        newClientView(resp.Client),
+		"client_secret": resp.Secret,
+	})
+}
+
+// DeleteClient removes the client, tokens issued to it stop working.
+func (h *Uaa) DeleteClient(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.DeleteClient(timeoutCtx, &uaa.ClientIDReq{
+		ClientId: ctx.Param("id"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			errors.AbortWithErrorJSON(ctx, errors.ErrClientNotFound)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+	h.loadPolicy()
+
+	ctx.Status(http.StatusOK)
+}
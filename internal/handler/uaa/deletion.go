@@ -0,0 +1,241 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/captcha"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+type deletionStepView struct {
+	Name      string    `json:"name"`
+	Done      bool      `json:"done"`
+	DoneDate  time.Time `json:"done_date"`
+	Attempts  int64     `json:"attempts"`
+	LastError string    `json:"last_error"`
+}
+
+type deletionView struct {
+	UID           string              `json:"uid"`
+	RequestDate   time.Time           `json:"request_date"`
+	ScheduledDate time.Time           `json:"scheduled_date"`
+	Done          bool                `json:"done"`
+	DoneDate      time.Time           `json:"done_date"`
+	Steps         []*deletionStepView `json:"steps"`
+}
+
+func newDeletionView(deletion *uaa.AccountDeletion) *deletionView {
+	view := &deletionView{
+		UID:   deletion.Uid,
+		Done:  deletion.Done,
+		Steps: make([]*deletionStepView, 0, len(deletion.Steps)),
+	}
+	view.RequestDate, _ = ptypes.Timestamp(deletion.RequestDate)
+	view.ScheduledDate, _ = ptypes.Timestamp(deletion.ScheduledDate)
+	view.DoneDate, _ = ptypes.Timestamp(deletion.DoneDate)
+	for _, v := range deletion.Steps {
+		step := &deletionStepView{
+			Name:      v.Name,
+			Done:      v.Done,
+			Attempts:  v.Attempts,
+			LastError: v.LastError,
+		}
+		step.DoneDate, _ = ptypes.Timestamp(v.DoneDate)
+		view.Steps = append(view.Steps, step)
+	}
+	return view
+}
+
+func deletionCaptchaId(uid string) string {
+	return "deletion:" + uid
+}
+
+func deletionError(err error) *errors.Error {
+	if reasonFromError(err) == uaa.ErrorReason_REAUTH_REQUIRED {
+		return errors.ErrReauthRequired
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return errors.ErrUsernameOrPasswordNotCorrect
+	case codes.NotFound:
+		return errors.ErrDeletionNotFound
+	case codes.FailedPrecondition:
+		return errors.ErrDeletionNotPending
+	}
+	return errors.ErrUnknown
+}
+
+// RequestDeletion schedules deletion of current account with all its data
+// after the grace period, asking again returns the deletion requested.
+// Accounts without password confirm by a reauth token of a linked provider,
+// or by a code sent to their email when asking without either.
+func (h *Uaa) RequestDeletion(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+	uid := h.middle.ExtractSub(ctx)
+
+	// parse body, accounts from oauth have no password
+	type requestDeletionReq struct {
+		Password    string `json:"password"`
+		Code        string `json:"code"`
+		ReauthToken string `json:"reauth_token"`
+	}
+	var body requestDeletionReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	reauthenticated := false
+	if body.Password == "" {
+		var ok bool
+		if reauthenticated, ok = h.deletionReauth(ctx, uid, body.Code, body.ReauthToken); !ok {
+			return
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.RequestDeletion(timeoutCtx, &uaa.RequestDeletionReq{
+		Uid:             uid,
+		Password:        body.Password,
+		Reauthenticated: reauthenticated,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, deletionError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newDeletionView(resp))
+}
+
+// deletionReauth checks the reauth token or the code given instead of a
+// password. Given neither, a code is sent to the email of the account and the
+// request ends, as it does on failure.
+func (h *Uaa) deletionReauth(ctx *gin.Context, uid, code, reauthToken string) (bool, bool) {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	if reauthToken != "" {
+		if !h.checkReauthToken(reauthToken, uid) {
+			errors.AbortWithErrorJSON(ctx, errors.ErrReauthTokenInvalid)
+			return false, false
+		}
+		return true, true
+	}
+
+	if code != "" {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+			Type: captcha.CaptchaType_RANDOM_BY_ID,
+			Id:   deletionCaptchaId(uid),
+			Code: code,
+		})
+		if err != nil || !rsp.Correct {
+			errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+			return false, false
+		}
+		return true, true
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: uid,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return false, false
+	}
+	// Accounts with password give it, the rest is checked by uaa
+	if len(acc.Password) != 0 {
+		return false, true
+	}
+	if acc.Email == "" || !acc.EmailVerified {
+		errors.AbortWithErrorJSON(ctx, errors.ErrReauthRequired)
+		return false, false
+	}
+
+	if !h.emailLimiter.Allow("deletion:" + uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return false, false
+	}
+	err = h.sendEmailCode(ctx, deletionCaptchaId(uid), acc.Email,
+		"Confirm account deletion", "Your account is being deleted, ignore this if it wasn't you. Your code to confirm: ", nil)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return false, false
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{
+		"code_sent": true,
+	})
+	return false, false
+}
+
+// CancelDeletion keeps current account, only before the grace period ends.
+func (h *Uaa) CancelDeletion(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.CancelDeletion(timeoutCtx, &uaa.UIDReq{
+		Uid: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, deletionError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) GetDeletion(ctx *gin.Context) {
+	h.getDeletion(ctx, h.middle.ExtractSub(ctx))
+}
+
+func (h *Uaa) GetAccountDeletion(ctx *gin.Context) {
+	h.getDeletion(ctx, ctx.Param("uid"))
+}
+
+func (h *Uaa) getDeletion(ctx *gin.Context, uid string) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.GetDeletion(timeoutCtx, &uaa.UIDReq{
+		Uid: uid,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, deletionError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newDeletionView(resp))
+}
+
+// DeleteAccount deletes the account right away, without grace period.
+func (h *Uaa) DeleteAccount(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.DeleteOne(timeoutCtx, &uaa.UIDReq{
+		Uid: ctx.Param("uid"),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
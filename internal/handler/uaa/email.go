@@ -0,0 +1,296 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/url"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/captcha"
+	"teddy-backend/internal/proto/message"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// Bound to the email, so a code can't verify the address changed later
+func verifyEmailCaptchaId(uid, email string) string {
+	return "verify_email:" + uid + ":" + email
+}
+
+func changeEmailCaptchaId(uid string, oldAddress bool) string {
+	if oldAddress {
+		return "change_email_old:" + uid
+	}
+	return "change_email_new:" + uid
+}
+
+// sendEmailCode sends a new code by id, with a link to use it if given.
+func (h *Uaa) sendEmailCode(ctx *gin.Context, id, email, topic, content string,
+	link func(code string) string) error {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	messageClient := clients.MessageFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	random, err := captchaClient.GetRandomById(timeoutCtx, &captcha.GetRandomReq{
+		Len: 6,
+		Id:  id,
+	})
+	if err != nil {
+		return err
+	}
+
+	content += random.Code
+	if link != nil {
+		content += "\nOr open " + link(random.Code)
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = messageClient.SendEmail(timeoutCtx, &message.SendEmailReq{
+		Email:    email,
+		Topic:    topic,
+		Content:  content,
+		SendTime: ptypes.TimestampNow(),
+	})
+	return err
+}
+
+// verifyEmailLink makes the link to verify without typing the code.
+func (h *Uaa) verifyEmailLink(uid, email string) func(code string) string {
+	return func(code string) string {
+		query := url.Values{}
+		query.Set("uid", uid)
+		query.Set("email", email)
+		query.Set("code", code)
+		return h.publicURL + "/v1/anon/uaa/email/verify?" + query.Encode()
+	}
+}
+
+// SendEmailVerification sends a code and a link to the email of current
+// account.
+func (h *Uaa) SendEmailVerification(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+	uid := h.middle.ExtractSub(ctx)
+
+	if !h.emailLimiter.Allow("send:" + uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	account, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: uid,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	} else if account.Email == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrEmailNotSet)
+		return
+	} else if account.EmailVerified {
+		errors.AbortWithErrorJSON(ctx, errors.ErrEmailAlreadyVerified)
+		return
+	}
+
+	err = h.sendEmailCode(ctx, verifyEmailCaptchaId(uid, account.Email), account.Email,
+		"Verify email", "Your email verification code:", h.verifyEmailLink(uid, account.Email))
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// VerifyEmail takes the code by json body, or by query from the link in
+// email, login isn't needed.
+func (h *Uaa) VerifyEmail(ctx *gin.Context) {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type verifyEmailReq struct {
+		Uid   string `json:"uid" form:"uid" binding:"required"`
+		Email string `json:"email" form:"email" binding:"required"`
+		Code  string `json:"code" form:"code" binding:"required"`
+	}
+	var body verifyEmailReq
+	err := ctx.ShouldBind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	if !h.emailLimiter.Allow("verify:" + body.Uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_RANDOM_BY_ID,
+		Id:   verifyEmailCaptchaId(body.Uid, body.Email),
+		Code: body.Code,
+	})
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.VerifyEmail(timeoutCtx, &uaa.VerifyEmailReq{
+		Uid:   body.Uid,
+		Email: body.Email,
+	})
+	if err != nil {
+		// Email has been changed since the code was sent
+		if status.Code(err) == codes.FailedPrecondition {
+			errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"email":          body.Email,
+		"email_verified": true,
+	})
+}
+
+func emailChangeError(err error) *errors.Error {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return errors.ErrUsernameOrPasswordNotCorrect
+	case codes.InvalidArgument:
+		return errors.ErrEmailInvalid
+	case codes.AlreadyExists:
+		return errors.ErrEmailTaken
+	case codes.NotFound:
+		return errors.ErrEmailChangeNotFound
+	}
+	return accountStateError(err, errors.ErrUnknown)
+}
+
+// ChangeEmail starts a change by the password, codes are sent to the current
+// address, if any, and to the new one. Both must be confirmed.
+func (h *Uaa) ChangeEmail(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+	uid := h.middle.ExtractSub(ctx)
+
+	// parse body
+	type changeEmailReq struct {
+		Password string `json:"password" binding:"required"`
+		Email    string `json:"email" binding:"required"`
+	}
+	var body changeEmailReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	if !h.emailLimiter.Allow("change:" + uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	account, err := uaaClient.StartEmailChange(timeoutCtx, &uaa.StartEmailChangeReq{
+		Uid:      uid,
+		Password: body.Password,
+		Email:    body.Email,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, emailChangeError(err))
+		return
+	}
+
+	err = h.sendEmailCode(ctx, changeEmailCaptchaId(uid, false), body.Email,
+		"Confirm new email", "Your code to confirm this new email:", nil)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	if account.Email != "" {
+		err = h.sendEmailCode(ctx, changeEmailCaptchaId(uid, true), account.Email,
+			"Confirm email change", "Your email is being changed to "+body.Email+
+				", change your password if it wasn't you. Your code to confirm:", nil)
+		if err != nil {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"confirm_old": account.Email != "",
+	})
+}
+
+// ConfirmEmailChange confirms one address by its code, address is "old" or
+// "new". Done tells whether the email has been switched.
+func (h *Uaa) ConfirmEmailChange(ctx *gin.Context) {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+	uid := h.middle.ExtractSub(ctx)
+
+	// parse body
+	type confirmEmailChangeReq struct {
+		Address string `json:"address" binding:"required"`
+		Code    string `json:"code" binding:"required"`
+	}
+	var body confirmEmailChangeReq
+	err := ctx.Bind(&body)
+	if err != nil || (body.Address != "old" && body.Address != "new") {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	oldAddress := body.Address == "old"
+
+	if !h.emailLimiter.Allow("confirm:" + uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_RANDOM_BY_ID,
+		Id:   changeEmailCaptchaId(uid, oldAddress),
+		Code: body.Code,
+	})
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.ConfirmEmailChange(timeoutCtx, &uaa.ConfirmEmailChangeReq{
+		Uid:        uid,
+		OldAddress: oldAddress,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, emailChangeError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"done":  resp.Done,
+		"email": resp.Account.Email,
+	})
+}
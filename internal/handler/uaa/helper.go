@@ -0,0 +1,72 @@
+package uaa
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/sms"
+)
+
+func reasonFromError(err error) uaa.ErrorReason {
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*uaa.ErrorDetail); ok {
+			return d.Reason
+		}
+	}
+	return uaa.ErrorReason_UNKNOWN_REASON
+}
+
+// accountStateError maps account state failures from uaa service,
+// anything else falls back to the given error.
+func accountStateError(err error, fallback *errors.Error) *errors.Error {
+	switch reasonFromError(err) {
+	case uaa.ErrorReason_ACCOUNT_LOCKED:
+		return errors.ErrAccountLocked
+	case uaa.ErrorReason_CREDENTIALS_EXPIRED:
+		return errors.ErrCredentialsExpired
+	case uaa.ErrorReason_MUST_CHANGE_PASSWORD:
+		return errors.ErrMustChangePassword
+	case uaa.ErrorReason_CAPTCHA_REQUIRED:
+		return errors.ErrCaptchaRequired
+	}
+	return fallback
+}
+
+func twoFactorError(err error) *errors.Error {
+	if reasonFromError(err) == uaa.ErrorReason_CHALLENGE_USED {
+		return errors.ErrTwoFactorChallengeInvalid
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return errors.ErrTwoFactorCodeInvalid
+	case codes.FailedPrecondition:
+		if reasonFromError(err) == uaa.ErrorReason_UNKNOWN_REASON {
+			return errors.ErrTwoFactorNotEnabled
+		}
+	case codes.AlreadyExists:
+		return errors.ErrTwoFactorAlreadyEnabled
+	}
+	return accountStateError(err, errors.ErrUnknown)
+}
+
+func accountAdminError(err error) *errors.Error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return errors.ErrAccountNotFound
+	}
+	return errors.ErrUnknown
+}
+
+// normalizePrincipal turns principal given as international phone number
+// to E.164 as stored, others are kept as is.
+func normalizePrincipal(principal string) string {
+	principal = strings.TrimSpace(principal)
+	if strings.HasPrefix(principal, "+") || strings.HasPrefix(principal, "00") {
+		if phone, err := sms.NormalizePhone(principal, ""); err == nil {
+			return phone
+		}
+	}
+	return principal
+}
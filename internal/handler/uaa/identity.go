@@ -0,0 +1,303 @@
+package uaa
+
+import (
+	"context"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sort"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/oauth"
+	"time"
+)
+
+// Carries the account linking an identity through the provider redirects
+const oauthLinkCookie = "_teddy_oauth_link"
+const oauthLinkAudience = "uaa-oauth-link"
+
+// Proves the identity was just signed in with when asking to merge
+const oauthMergeAudience = "uaa-oauth-merge"
+const oauthMergeExpiration = 10 * time.Minute
+
+// Proves a linked identity was just signed in with, for accounts without
+// password to confirm sensitive actions
+const oauthReauthAudience = "uaa-oauth-reauth"
+const oauthReauthExpiration = 5 * time.Minute
+
+// What the callback does for a signed in account
+const (
+	oauthPurposeLink   = "link"
+	oauthPurposeReauth = "reauth"
+)
+
+type identityView struct {
+	Provider string `json:"provider"`
+	OAuthUID string `json:"oauth_uid,omitempty"`
+	Linked   bool   `json:"linked"`
+}
+
+// newIdentitiesView lists providers configured and linked, providers no
+// longer configured are kept so they can still be unlinked.
+func (h *Uaa) newIdentitiesView(acc *uaa.Account) gin.H {
+	names := make([]string, 0, len(h.providers)+len(acc.OauthUIDs))
+	for name := range h.providers {
+		names = append(names, name)
+	}
+	for name := range acc.OauthUIDs {
+		if _, ok := h.providers[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	identities := make([]*identityView, 0, len(names))
+	for _, name := range names {
+		oauthUID, linked := acc.OauthUIDs[name]
+		identities = append(identities, &identityView{
+			Provider: name,
+			OAuthUID: oauthUID,
+			Linked:   linked,
+		})
+	}
+	return gin.H{
+		"has_password": len(acc.Password) != 0,
+		"identities":   identities,
+	}
+}
+
+func identityError(err error) *errors.Error {
+	switch reasonFromError(err) {
+	case uaa.ErrorReason_OAUTH_PROVIDER_LINKED:
+		return errors.ErrOAuthProviderLinked
+	case uaa.ErrorReason_LAST_LOGIN_METHOD:
+		return errors.ErrLastLoginMethod
+	case uaa.ErrorReason_MERGE_NOT_ALLOWED:
+		return errors.ErrMergeNotAllowed
+	case uaa.ErrorReason_OAUTH_IDENTITY_TAKEN:
+		return errors.ErrOAuthIdentityTaken
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return errors.ErrOAuthNotLinked
+	}
+	log.Error(err)
+	return accountStateError(err, errors.ErrUnknown)
+}
+
+func (h *Uaa) ListIdentities(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.newIdentitiesView(acc))
+}
+
+// LinkOAuthAuthorize starts linking a provider to current account, the client
+// follows the returned url and the callback links instead of signing in.
+func (h *Uaa) LinkOAuthAuthorize(ctx *gin.Context) {
+	h.startAccountOAuth(ctx, oauthPurposeLink)
+}
+
+// ReauthOAuthAuthorize starts signing in again with a linked provider, the
+// callback returns a reauth token instead of signing in.
+func (h *Uaa) ReauthOAuthAuthorize(ctx *gin.Context) {
+	h.startAccountOAuth(ctx, oauthPurposeReauth)
+}
+
+// startAccountOAuth starts a provider flow for current account, the link
+// cookie tells the callback whose flow it is and what for.
+func (h *Uaa) startAccountOAuth(ctx *gin.Context, purpose string) {
+	provider, ok := h.oauthProvider(ctx)
+	if !ok {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthProviderNotSupport)
+		return
+	}
+
+	state, err := newOAuthState()
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	linkToken, err := h.generator.GenerateJwt(oauthStateMaxAge*time.Second, h.middle.ExtractSub(ctx),
+		[]string{oauthLinkAudience}, jwt.MapClaims{
+			"state":   state,
+			"purpose": purpose,
+		})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.SetCookie(oauthStateCookie, state, oauthStateMaxAge, "/", "", false, true)
+	ctx.SetCookie(oauthLinkCookie, linkToken, oauthStateMaxAge, "/", "", false, true)
+	ctx.JSON(http.StatusOK, gin.H{
+		"authorize_url": provider.AuthCodeURL(state),
+	})
+}
+
+// accountOAuth finishes in the callback a flow started by startAccountOAuth.
+func (h *Uaa) accountOAuth(ctx *gin.Context, linkToken, state string, provider oauth.Provider,
+	userInfo *oauth.UserInfo) {
+	claims, err := h.middle.ParseToken(linkToken, oauthLinkAudience)
+	if err != nil || claims["state"] != state {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthStateNotCorrect)
+		return
+	}
+	uid, _ := claims["sub"].(string)
+
+	if claims["purpose"] == oauthPurposeReauth {
+		h.reauthOAuth(ctx, uid, provider, userInfo)
+	} else {
+		h.linkOAuth(ctx, uid, provider, userInfo)
+	}
+}
+
+// reauthOAuth returns a reauth token when the identity is linked to uid.
+func (h *Uaa) reauthOAuth(ctx *gin.Context, uid string, provider oauth.Provider, userInfo *oauth.UserInfo) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: uid,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+	if oauthUID, ok := acc.OauthUIDs[provider.Name()]; !ok || oauthUID != userInfo.ID {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthNotLinked)
+		return
+	}
+
+	reauthToken, err := h.generator.GenerateJwt(oauthReauthExpiration, uid, []string{oauthReauthAudience}, nil)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"reauth_token": reauthToken,
+		"expires_in":   int64(oauthReauthExpiration / time.Second),
+	})
+}
+
+// checkReauthToken tells whether token proves uid signed in again just now.
+func (h *Uaa) checkReauthToken(token, uid string) bool {
+	claims, err := h.middle.ParseToken(token, oauthReauthAudience)
+	return err == nil && claims["sub"] == uid
+}
+
+// linkOAuth links the identity to uid. An identity of another account isn't
+// linked, a merge token is returned to ask for the merge instead.
+func (h *Uaa) linkOAuth(ctx *gin.Context, uid string, provider oauth.Provider, userInfo *oauth.UserInfo) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.LinkOAuth(timeoutCtx, &uaa.LinkOAuthReq{
+		Uid:           uid,
+		OauthProvider: provider.Name(),
+		OauthUID:      userInfo.ID,
+	})
+	if reasonFromError(err) == uaa.ErrorReason_OAUTH_IDENTITY_TAKEN {
+		mergeToken, err := h.generator.GenerateJwt(oauthMergeExpiration, uid, []string{oauthMergeAudience},
+			jwt.MapClaims{
+				"provider":  provider.Name(),
+				"oauth_uid": userInfo.ID,
+			})
+		if err != nil {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"merge_required": true,
+			"merge_token":    mergeToken,
+			"expires_in":     int64(oauthMergeExpiration / time.Second),
+		})
+		return
+	} else if err != nil {
+		errors.AbortWithErrorJSON(ctx, identityError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.newIdentitiesView(acc))
+}
+
+// MergeOAuth links an identity of another account to current account, that
+// account is deleted. uaa refuses unless the identity is its only login
+// method, so nothing but the identity just signed in with is taken over.
+func (h *Uaa) MergeOAuth(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type mergeOAuthReq struct {
+		MergeToken string `json:"merge_token" binding:"required"`
+	}
+	var body mergeOAuthReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	uid := h.middle.ExtractSub(ctx)
+	claims, err := h.middle.ParseToken(body.MergeToken, oauthMergeAudience)
+	if err != nil || claims["sub"] != uid {
+		errors.AbortWithErrorJSON(ctx, errors.ErrMergeTokenInvalid)
+		return
+	}
+	provider, _ := claims["provider"].(string)
+	oauthUID, _ := claims["oauth_uid"].(string)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.LinkOAuth(timeoutCtx, &uaa.LinkOAuthReq{
+		Uid:           uid,
+		OauthProvider: provider,
+		OauthUID:      oauthUID,
+		Merge:         true,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, identityError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.newIdentitiesView(acc))
+}
+
+// UnlinkOAuth removes a provider from current account, unless it is the last
+// way to sign in.
+func (h *Uaa) UnlinkOAuth(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.UnlinkOAuth(timeoutCtx, &uaa.UnlinkOAuthReq{
+		Uid:           h.middle.ExtractSub(ctx),
+		OauthProvider: strings.ToUpper(ctx.Param("provider")),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, identityError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.newIdentitiesView(acc))
+}
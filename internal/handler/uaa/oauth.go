@@ -0,0 +1,148 @@
+package uaa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/oauth"
+	"time"
+)
+
+const oauthStateCookie = "_teddy_oauth_state"
+const oauthStateMaxAge = 10 * 60
+
+func (h *Uaa) oauthProvider(ctx *gin.Context) (oauth.Provider, bool) {
+	provider, ok := h.providers[strings.ToUpper(ctx.Param("provider"))]
+	return provider, ok
+}
+
+func newOAuthState() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func (h *Uaa) OAuthAuthorize(ctx *gin.Context) {
+	provider, ok := h.oauthProvider(ctx)
+	if !ok {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthProviderNotSupport)
+		return
+	}
+
+	state, err := newOAuthState()
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	// Bind state to this browser, callback must carry back the same one. A
+	// link not finished is dropped, this is a sign in.
+	ctx.SetCookie(oauthStateCookie, state, oauthStateMaxAge, "/", "", false, true)
+	ctx.SetCookie(oauthLinkCookie, "", -1, "/", "", false, true)
+	ctx.Redirect(http.StatusFound, provider.AuthCodeURL(state))
+}
+
+func (h *Uaa) OAuthCallback(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	provider, ok := h.oauthProvider(ctx)
+	if !ok {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthProviderNotSupport)
+		return
+	}
+
+	state, err := ctx.Cookie(oauthStateCookie)
+	if err != nil || state == "" || state != ctx.Query("state") {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthStateNotCorrect)
+		return
+	}
+	ctx.SetCookie(oauthStateCookie, "", -1, "/", "", false, true)
+
+	userInfo, ok := h.oauthUserInfo(ctx, provider)
+	if !ok {
+		return
+	}
+
+	// Started by a signed in account, e.g. to link the identity, not to sign in
+	if linkToken, err := ctx.Cookie(oauthLinkCookie); err == nil && linkToken != "" {
+		ctx.SetCookie(oauthLinkCookie, "", -1, "/", "", false, true)
+		h.accountOAuth(ctx, linkToken, state, provider, userInfo)
+		return
+	}
+
+	// find or create account
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	response, err := uaaClient.RegisterByOAuth(timeoutCtx, &uaa.RegisterOAuthReq{
+		OauthProvider: provider.Name(),
+		OauthUID:      userInfo.ID,
+		Username:      userInfo.Username,
+		Email:         userInfo.Email,
+		EmailVerified: userInfo.EmailVerified,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		errors.AbortWithErrorJSON(ctx, errors.ErrEmailTaken)
+		return
+	} else if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, accountStateError(err, errors.ErrUnknown))
+		return
+	}
+
+	h.loadPolicy()
+
+	if response.TwoFactorEnabled {
+		h.twoFactorChallenge(ctx, response)
+		return
+	}
+
+	tokens, err := h.loginTokens(ctx, response)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokens)
+}
+
+// oauthUserInfo exchanges the code of the callback for the user of provider,
+// the request is aborted on failure.
+func (h *Uaa) oauthUserInfo(ctx *gin.Context, provider oauth.Provider) (*oauth.UserInfo, bool) {
+	code := ctx.Query("code")
+	if ctx.Query("error") != "" || code == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthExchangeFailed)
+		return nil, false
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	oauthToken, err := provider.Exchange(timeoutCtx, code)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthExchangeFailed)
+		return nil, false
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	userInfo, err := provider.UserInfo(timeoutCtx, oauthToken)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrOAuthExchangeFailed)
+		return nil, false
+	}
+	return userInfo, true
+}
@@ -0,0 +1,122 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"net/http"
+	"strings"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// ID tokens only tell who logged in, they are not refreshed
+const idTokenExpiration = accessTokenExpiration
+
+const oidcIssuerPath = "/v1/anon/uaa"
+
+// oidcIssuer is where OIDC clients discover the provider from. It is fixed by
+// the public url, never taken from request headers clients control.
+func (h *Uaa) oidcIssuer() string {
+	return h.publicURL + oidcIssuerPath
+}
+
+// oidcClaims are the standard claims of acc, shared by ID tokens and userinfo.
+func oidcClaims(acc *uaa.Account) map[string]interface{} {
+	claims := map[string]interface{}{
+		"preferred_username": acc.Username,
+		"email":              acc.Email,
+		"email_verified":     acc.EmailVerified,
+	}
+	if acc.Phone != "" {
+		claims["phone_number"] = acc.Phone
+	}
+	if updateDate, err := ptypes.Timestamp(acc.UpdateDate); err == nil {
+		claims["updated_at"] = updateDate.Unix()
+	}
+	return claims
+}
+
+// oidcScopes are the scopes OIDC clients may ask for, beside openid the
+// claims each of them releases
+var oidcScopes = []string{"openid", "profile", "email", "phone"}
+
+var oidcScopeClaims = map[string][]string{
+	"profile": {"preferred_username", "updated_at"},
+	"email":   {"email", "email_verified"},
+	"phone":   {"phone_number"},
+}
+
+// oidcScopedClaims are the claims of acc released by scopes granted to a client.
+func oidcScopedClaims(acc *uaa.Account, scopes []string) map[string]interface{} {
+	all := oidcClaims(acc)
+	claims := make(map[string]interface{})
+	for _, scope := range scopes {
+		for _, name := range oidcScopeClaims[scope] {
+			if v, ok := all[name]; ok {
+				claims[name] = v
+			}
+		}
+	}
+	return claims
+}
+
+func (h *Uaa) generateIDToken(acc *uaa.Account) (string, error) {
+	return h.generator.GenerateIDToken(h.oidcIssuer(), idTokenExpiration, acc.Uid, []string{h.oidcClientID},
+		oidcClaims(acc))
+}
+
+// OpenIDConfiguration lets OIDC clients sign users in by the authorization code
+// flow, verify ID tokens and fetch userinfo.
+func (h *Uaa) OpenIDConfiguration(ctx *gin.Context) {
+	issuer := h.oidcIssuer()
+	ctx.JSON(http.StatusOK, gin.H{
+		"issuer":                                issuer,
+		"jwks_uri":                              issuer + "/jwks.json",
+		"authorization_endpoint":                issuer + "/authorize",
+		"token_endpoint":                        issuer + "/token",
+		"userinfo_endpoint":                     h.oidcAuthURL(issuer) + "/userinfo",
+		"response_types_supported":              []string{responseTypeCode},
+		"response_modes_supported":              []string{"query"},
+		"grant_types_supported":                 []string{grantTypeAuthorizationCode, grantTypeClientCredentials},
+		"code_challenge_methods_supported":      []string{codeChallengeMethodS256},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(h.generator.SigningAlgorithm())},
+		"scopes_supported":                      oidcScopes,
+		"claims_supported": []string{"iss", "sub", "aud", "exp", "iat", "nonce", "azp", "preferred_username",
+			"email", "email_verified", "phone_number", "updated_at"},
+	})
+}
+
+// oidcAuthURL is the url of endpoints requiring an access token, beside the
+// anonymous ones of issuer.
+func (h *Uaa) oidcAuthURL(issuer string) string {
+	return issuer[:len(issuer)-len(oidcIssuerPath)] + "/v1/auth/uaa"
+}
+
+// UserInfo returns the standard claims of the account the access token is of,
+// only those of granted scopes when the token is issued to a client.
+func (h *Uaa) UserInfo(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	acc, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	claims := oidcClaims(acc)
+	if h.middle.ExtractClaims(ctx, "client_id") != nil {
+		scope, _ := h.middle.ExtractClaims(ctx, "scope").(string)
+		claims = oidcScopedClaims(acc, strings.Fields(scope))
+	}
+	claims["sub"] = acc.Uid
+	ctx.JSON(http.StatusOK, claims)
+}
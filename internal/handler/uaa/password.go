@@ -0,0 +1,49 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// passwordError maps password policy violations from uaa service, anything
+// else is mapped as account state failures.
+func passwordError(err error, fallback *errors.Error) *errors.Error {
+	switch reasonFromError(err) {
+	case uaa.ErrorReason_PASSWORD_TOO_SHORT:
+		return errors.ErrPasswordTooShort
+	case uaa.ErrorReason_PASSWORD_TOO_LONG:
+		return errors.ErrPasswordTooLong
+	case uaa.ErrorReason_PASSWORD_MISSING_CLASSES:
+		return errors.ErrPasswordMissingClasses
+	case uaa.ErrorReason_PASSWORD_TOO_COMMON:
+		return errors.ErrPasswordTooCommon
+	case uaa.ErrorReason_PASSWORD_REUSED:
+		return errors.ErrPasswordReused
+	}
+	return accountStateError(err, fallback)
+}
+
+// checkPassword checks a new password before a one-time code is spent on
+// it, the request is aborted when it fails. uid is empty to check the policy
+// only, for new accounts or callers not proven to own the account yet.
+func (h *Uaa) checkPassword(ctx *gin.Context, uid, password string) bool {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.CheckPassword(timeoutCtx, &uaa.CheckPasswordReq{
+		Uid:      uid,
+		Password: password,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrBadRequest))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+type personalTokenView struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Scopes       []string  `json:"scopes"`
+	CreateDate   time.Time `json:"create_date"`
+	ExpireTime   time.Time `json:"expire_time"`
+	LastUsedTime time.Time `json:"last_used_time"`
+}
+
+func newPersonalTokenView(token *uaa.PersonalToken) *personalTokenView {
+	view := &personalTokenView{
+		ID:     token.Id,
+		Name:   token.Name,
+		Scopes: token.Scopes,
+	}
+	view.CreateDate, _ = ptypes.Timestamp(token.CreateDate)
+	view.ExpireTime, _ = ptypes.Timestamp(token.ExpireTime)
+	if token.LastUsedTime != nil {
+		view.LastUsedTime, _ = ptypes.Timestamp(token.LastUsedTime)
+	}
+	return view
+}
+
+func personalTokenError(err error) *errors.Error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return errors.ErrPersonalTokenScopeInvalid
+	case codes.OutOfRange:
+		return errors.ErrPersonalTokenExpireInvalid
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyPersonalTokens
+	case codes.NotFound:
+		return errors.ErrPersonalTokenNotFound
+	}
+	log.Error(err)
+	return errors.ErrUnknown
+}
+
+func (h *Uaa) ListPersonalTokens(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.ListPersonalTokens(timeoutCtx, &uaa.UIDReq{
+		Uid: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, personalTokenError(err))
+		return
+	}
+
+	items := make([]*personalTokenView, 0, len(resp.Items))
+	for _, v := range resp.Items {
+		items = append(items, newPersonalTokenView(v))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"items": items,
+	})
+}
+
+// CreatePersonalToken makes a token of current account, its value is only
+// returned here. Scopes are personal token scopes, not roles.
+func (h *Uaa) CreatePersonalToken(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body, expires in 30 days without expire time
+	type createPersonalTokenReq struct {
+		Name       string     `json:"name" binding:"required"`
+		Scopes     []string   `json:"scopes" binding:"required"`
+		ExpireTime *time.Time `json:"expire_time"`
+	}
+	var body createPersonalTokenReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+	var expireTime *timestamp.Timestamp
+	if body.ExpireTime != nil {
+		expireTime, err = ptypes.TimestampProto(*body.ExpireTime)
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrPersonalTokenExpireInvalid)
+			return
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.CreatePersonalToken(timeoutCtx, &uaa.CreatePersonalTokenReq{
+		Uid:        h.middle.ExtractSub(ctx),
+		Name:       body.Name,
+		Scopes:     body.Scopes,
+		ExpireTime: expireTime,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, personalTokenError(err))
+		return
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+	ctx.JSON(http.StatusOK, gin.H{
+		"token": newPersonalTokenView(resp.Token),
+		"value": resp.Value,
+	})
+}
+
+func (h *Uaa) RevokePersonalToken(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type revokePersonalTokenReq struct {
+		ID string `json:"id" binding:"required"`
+	}
+	var body revokePersonalTokenReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.RevokePersonalToken(timeoutCtx, &uaa.PersonalTokenReq{
+		Uid: h.middle.ExtractSub(ctx),
+		Id:  body.ID,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, personalTokenError(err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
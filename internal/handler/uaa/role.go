@@ -0,0 +1,209 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/grpcadapter"
+	"time"
+)
+
+type policyBody struct {
+	Subject string `json:"subject" binding:"required"`
+	Object  string `json:"object" binding:"required"`
+	Action  string `json:"action" binding:"required"`
+}
+
+func (p *policyBody) rule() []string {
+	return []string{p.Subject, p.Object, p.Action}
+}
+
+func (h *Uaa) GetAccountRoles(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.GetRoles(timeoutCtx, &uaa.UIDReq{
+		Uid: ctx.Param("uid"),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"roles": resp.Roles,
+	})
+}
+
+// SetAccountRoles replaces all roles of the account.
+func (h *Uaa) SetAccountRoles(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type setRolesReq struct {
+		Roles []string `json:"roles" binding:"required"`
+	}
+	var body setRolesReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.SetRoles(timeoutCtx, &uaa.SetRolesReq{
+		Uid:   ctx.Param("uid"),
+		Roles: body.Roles,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			errors.AbortWithErrorJSON(ctx, errors.ErrRoleInvalid)
+		} else {
+			errors.AbortWithErrorJSON(ctx, accountAdminError(err))
+		}
+		return
+	}
+	h.loadPolicy()
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"roles": resp.Roles,
+	})
+}
+
+// ListPolicies filters by subject, object and action given in query.
+func (h *Uaa) ListPolicies(ctx *gin.Context) {
+	policyClient := clients.PolicyAdapterFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := policyClient.GetFilteredPolicy(timeoutCtx, &grpcadapter.GetFilteredPolicyReq{
+		Ptype:       "p",
+		FieldIndex:  0,
+		FieldValues: []string{ctx.Query("subject"), ctx.Query("object"), ctx.Query("action")},
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	policies := make([]*policyBody, 0, len(resp.Policies))
+	for _, v := range resp.Policies {
+		if len(v.Rule) < 3 {
+			continue
+		}
+		policies = append(policies, &policyBody{
+			Subject: v.Rule[0],
+			Object:  v.Rule[1],
+			Action:  v.Rule[2],
+		})
+	}
+	ctx.JSON(http.StatusOK, policies)
+}
+
+func (h *Uaa) AddPolicy(ctx *gin.Context) {
+	policyClient := clients.PolicyAdapterFromContext(ctx)
+
+	var body policyBody
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrPolicyInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = policyClient.AddPolicy(timeoutCtx, &grpcadapter.AddPolicyReq{
+		Sec:   "p",
+		Ptype: "p",
+		Rule:  body.rule(),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	h.loadPolicy()
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) UpdatePolicy(ctx *gin.Context) {
+	policyClient := clients.PolicyAdapterFromContext(ctx)
+
+	// parse body
+	type updatePolicyReq struct {
+		Old *policyBody `json:"old" binding:"required"`
+		New *policyBody `json:"new" binding:"required"`
+	}
+	var body updatePolicyReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrPolicyInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = policyClient.UpdatePolicy(timeoutCtx, &grpcadapter.UpdatePolicyReq{
+		Sec:     "p",
+		Ptype:   "p",
+		OldRule: body.Old.rule(),
+		NewRule: body.New.rule(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			errors.AbortWithErrorJSON(ctx, errors.ErrPolicyNotFound)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+	h.loadPolicy()
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Uaa) RemovePolicy(ctx *gin.Context) {
+	policyClient := clients.PolicyAdapterFromContext(ctx)
+
+	var body policyBody
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrPolicyInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = policyClient.RemovePolicy(timeoutCtx, &grpcadapter.RemovePolicyReq{
+		Sec:   "p",
+		Ptype: "p",
+		Rule:  body.rule(),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+	h.loadPolicy()
+
+	ctx.Status(http.StatusOK)
+}
+
+// loadPolicy applies changes to this api at once, others pick them up on
+// their periodic reload.
+func (h *Uaa) loadPolicy() {
+	if err := h.middle.LoadPolicy(); err != nil {
+		log.Error(err)
+	}
+}
@@ -0,0 +1,113 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+func (h *Uaa) ListSessions(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	resp, err := uaaClient.ListSessions(timeoutCtx, &uaa.UIDReq{
+		Uid: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	type sessionResp struct {
+		ID             string    `json:"id"`
+		IP             string    `json:"ip"`
+		UserAgent      string    `json:"user_agent"`
+		CreateDate     time.Time `json:"create_date"`
+		LastActiveDate time.Time `json:"last_active_date"`
+		ExpireTime     time.Time `json:"expire_time"`
+		Current        bool      `json:"current"`
+	}
+	current := h.middle.ExtractSID(ctx)
+	sessions := make([]*sessionResp, 0, len(resp.Sessions))
+	for _, v := range resp.Sessions {
+		session := &sessionResp{
+			ID:        v.Id,
+			IP:        v.Ip,
+			UserAgent: v.UserAgent,
+			Current:   v.Id == current,
+		}
+		session.CreateDate, _ = ptypes.Timestamp(v.CreateDate)
+		session.LastActiveDate, _ = ptypes.Timestamp(v.LastActiveDate)
+		session.ExpireTime, _ = ptypes.Timestamp(v.ExpireTime)
+		sessions = append(sessions, session)
+	}
+	ctx.JSON(http.StatusOK, sessions)
+}
+
+func (h *Uaa) RevokeSession(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type revokeSessionReq struct {
+		SessionId string `json:"session_id" binding:"required"`
+	}
+	var body revokeSessionReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.RevokeSession(timeoutCtx, &uaa.SessionReq{
+		Uid:       h.middle.ExtractSub(ctx),
+		SessionId: body.SessionId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			errors.AbortWithErrorJSON(ctx, errors.ErrSessionNotFound)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// RevokeOtherSessions logs out everywhere but the session of the request token.
+func (h *Uaa) RevokeOtherSessions(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	current := h.middle.ExtractSID(ctx)
+	if current == "" {
+		errors.AbortWithErrorJSON(ctx, errors.ErrSessionNotFound)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.RevokeOtherSessions(timeoutCtx, &uaa.SessionReq{
+		Uid:       h.middle.ExtractSub(ctx),
+		SessionId: current,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
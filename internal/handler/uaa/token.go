@@ -0,0 +1,129 @@
+package uaa
+
+import (
+	"context"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// Access token is short-lived, clients keep logged in by refresh token
+const accessTokenExpiration = 15 * time.Minute
+
+// APIs access tokens are accepted by
+var accessTokenAudience = []string{"uaa", "base", "content", "message"}
+
+// generateAccessToken binds the token to the session of refresh token by sid,
+// jti is decided beforehand to be recorded in the session.
+func (h *Uaa) generateAccessToken(acc *uaa.Account, jti, sid string) (string, error) {
+	return h.generator.GenerateJwt(accessTokenExpiration, acc.Uid, accessTokenAudience, jwt.MapClaims{
+		"jti":      jti,
+		"sid":      sid,
+		"username": acc.Username,
+	})
+}
+
+func (h *Uaa) tokenResponse(ctx *gin.Context, acc *uaa.Account, refreshToken *uaa.RefreshToken,
+	jti string) (gin.H, error) {
+	token, err := h.generateAccessToken(acc, jti, refreshToken.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := h.generateIDToken(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	return gin.H{
+		"access_token":  token,
+		"id_token":      idToken,
+		"refresh_token": refreshToken.Token,
+		"expires_in":    int64(accessTokenExpiration / time.Second),
+		"type":          "bearer",
+	}, nil
+}
+
+// loginTokens starts a new session with its refresh token family for a fresh login.
+func (h *Uaa) loginTokens(ctx *gin.Context, acc *uaa.Account) (gin.H, error) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	jti := uuid.New().String()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	refreshToken, err := uaaClient.IssueRefreshToken(timeoutCtx, &uaa.IssueRefreshTokenReq{
+		Uid:       acc.Uid,
+		Jti:       jti,
+		Ip:        clients.ClientIPFromContext(ctx),
+		UserAgent: ctx.Request.UserAgent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.UpdateSignIn(timeoutCtx, &uaa.UpdateSignInReq{
+		Principal: acc.Uid,
+		Ip:        clients.ClientIPFromContext(ctx),
+		Time:      ptypes.TimestampNow(),
+	})
+	if err != nil {
+		// Login still succeeds without the record
+		log.Error(err)
+	}
+
+	return h.tokenResponse(ctx, acc, refreshToken, jti)
+}
+
+func (h *Uaa) RefreshToken(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type refreshTokenReq struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+	var body refreshTokenReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	// make request
+	jti := uuid.New().String()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	response, err := uaaClient.RotateRefreshToken(timeoutCtx, &uaa.RefreshTokenReq{
+		Token:     body.RefreshToken,
+		Jti:       jti,
+		Ip:        clients.ClientIPFromContext(ctx),
+		UserAgent: ctx.Request.UserAgent(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			errors.AbortWithErrorJSON(ctx, errors.ErrRefreshTokenInvalid)
+		} else {
+			log.Error(err)
+			errors.AbortWithErrorJSON(ctx, accountStateError(err, errors.ErrUnknown))
+		}
+		return
+	}
+
+	tokens, err := h.tokenResponse(ctx, response.Account, response.RefreshToken, jti)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokens)
+}
@@ -0,0 +1,186 @@
+package uaa
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"teddy-backend/internal/clients"
+	"teddy-backend/internal/handler/errors"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+// Challenge token only proves the password, it's not accepted as access token
+const twoFactorAudience = "uaa-2fa"
+const twoFactorChallengeExpiration = 5 * time.Minute
+
+func (h *Uaa) twoFactorChallenge(ctx *gin.Context, acc *uaa.Account) {
+	token, err := h.generator.GenerateJwt(twoFactorChallengeExpiration, acc.Uid, []string{twoFactorAudience}, nil)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"two_factor_required": true,
+		"challenge_token":     token,
+		"expires_in":          int64(twoFactorChallengeExpiration / time.Second),
+	})
+}
+
+// LoginTwoFactor is the second step of login, code is a TOTP code or one of
+// the recovery codes.
+func (h *Uaa) LoginTwoFactor(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type loginTwoFactorReq struct {
+		ChallengeToken string `json:"challenge_token" binding:"required"`
+		Code           string `json:"code" binding:"required"`
+	}
+	var body loginTwoFactorReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	claims, err := h.middle.ParseToken(body.ChallengeToken, twoFactorAudience)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTwoFactorChallengeInvalid)
+		return
+	}
+	uid, _ := claims["sub"].(string)
+	jti, _ := claims["jti"].(string)
+	exp, _ := claims["exp"].(float64)
+	expireTime, err := ptypes.TimestampProto(time.Unix(int64(exp), 0))
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTwoFactorChallengeInvalid)
+		return
+	}
+
+	if !h.twoFALimiter.Allow(uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	// make request, uaa takes the challenge once and counts wrong codes
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	response, err := uaaClient.VerifyTOTP(timeoutCtx, &uaa.TOTPCodeReq{
+		Uid:                 uid,
+		Code:                body.Code,
+		ChallengeJti:        jti,
+		ChallengeExpireTime: expireTime,
+		Ip:                  clients.ClientIPFromContext(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, twoFactorError(err))
+		return
+	}
+
+	tokens, err := h.loginTokens(ctx, response)
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokens)
+}
+
+func (h *Uaa) EnrollTwoFactor(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	response, err := uaaClient.EnrollTOTP(timeoutCtx, &uaa.UIDReq{
+		Uid: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, twoFactorError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"secret": response.Secret,
+		"uri":    response.Uri,
+	})
+}
+
+// twoFactorCodeRequest calls fn with the code in body for current account.
+func (h *Uaa) twoFactorCodeRequest(ctx *gin.Context, fn func(context.Context, *uaa.TOTPCodeReq) error) {
+	// parse body
+	type twoFactorCodeReq struct {
+		Code string `json:"code" binding:"required"`
+	}
+	var body twoFactorCodeReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	uid := h.middle.ExtractSub(ctx)
+	if !h.twoFALimiter.Allow(uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	err = fn(timeoutCtx, &uaa.TOTPCodeReq{
+		Uid:  uid,
+		Code: body.Code,
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, twoFactorError(err))
+		return
+	}
+}
+
+func (h *Uaa) ConfirmTwoFactor(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	h.twoFactorCodeRequest(ctx, func(timeoutCtx context.Context, req *uaa.TOTPCodeReq) error {
+		response, err := uaaClient.ConfirmTOTP(timeoutCtx, req)
+		if err != nil {
+			return err
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"recovery_codes": response.Codes,
+		})
+		return nil
+	})
+}
+
+func (h *Uaa) DisableTwoFactor(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	h.twoFactorCodeRequest(ctx, func(timeoutCtx context.Context, req *uaa.TOTPCodeReq) error {
+		_, err := uaaClient.DisableTOTP(timeoutCtx, req)
+		if err != nil {
+			return err
+		}
+		ctx.Status(http.StatusOK)
+		return nil
+	})
+}
+
+func (h *Uaa) RegenerateRecoveryCodes(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	h.twoFactorCodeRequest(ctx, func(timeoutCtx context.Context, req *uaa.TOTPCodeReq) error {
+		response, err := uaaClient.RegenerateRecoveryCodes(timeoutCtx, req)
+		if err != nil {
+			return err
+		}
+		ctx.JSON(http.StatusOK, gin.H{
+			"recovery_codes": response.Codes,
+		})
+		return nil
+	})
+}
@@ -2,30 +2,64 @@ package uaa
 
 import (
 	"context"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	log "github.com/sirupsen/logrus"
+	"io"
 	"net/http"
+	"strings"
 	"teddy-backend/internal/clients"
+	"teddy-backend/internal/components"
 	"teddy-backend/internal/gin_jwt"
 	"teddy-backend/internal/handler/errors"
 	"teddy-backend/internal/proto/captcha"
 	"teddy-backend/internal/proto/message"
 	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/oauth"
+	"teddy-backend/pkg/sms"
 	"time"
 )
 
+// Limit reset password per principal, a code has 10^6 possibilities
+const resetPasswordLimit = 5
+const resetPasswordWindow = 10 * time.Minute
+
+// Same for second step of login, per account
+const twoFactorLimit = 5
+const twoFactorWindow = 5 * time.Minute
+
+// Email codes and change requests, per account
+const emailLimit = 5
+const emailWindow = 10 * time.Minute
+
 type Uaa struct {
-	generator *gin_jwt.JwtGenerator
-	middle    *gin_jwt.JwtMiddleware
+	generator    *gin_jwt.JwtGenerator
+	middle       *gin_jwt.JwtMiddleware
+	providers    map[string]oauth.Provider
+	resetLimiter components.RateLimiter
+	twoFALimiter components.RateLimiter
+	emailLimiter components.RateLimiter
+	callingCode  string
+	publicURL    string
+	oidcClientID string
+	oidcLoginURL string
 }
 
-func NewUaaHandler(middle *gin_jwt.JwtMiddleware, generator *gin_jwt.JwtGenerator) (*Uaa, error) {
+func NewUaaHandler(middle *gin_jwt.JwtMiddleware, generator *gin_jwt.JwtGenerator,
+	providers map[string]oauth.Provider, defaultCallingCode string, publicURL string,
+	oidcClientID string, oidcLoginURL string) (*Uaa, error) {
 	instance := &Uaa{
-		generator: generator,
-		middle:    middle,
+		generator:    generator,
+		middle:       middle,
+		providers:    providers,
+		resetLimiter: components.NewRateLimiter(resetPasswordLimit, resetPasswordWindow),
+		twoFALimiter: components.NewRateLimiter(twoFactorLimit, twoFactorWindow),
+		emailLimiter: components.NewRateLimiter(emailLimit, emailWindow),
+		callingCode:  defaultCallingCode,
+		publicURL:    strings.TrimSuffix(publicURL, "/"),
+		oidcClientID: oidcClientID,
+		oidcLoginURL: oidcLoginURL,
 	}
 	return instance, nil
 }
@@ -33,14 +67,70 @@ func NewUaaHandler(middle *gin_jwt.JwtMiddleware, generator *gin_jwt.JwtGenerato
 func (h *Uaa) HandlerNormal(root gin.IRoutes) {
 	root.POST("/register", h.Register)
 	root.POST("/login", h.Login)
+	root.POST("/login/twoFactor", h.LoginTwoFactor)
 	root.POST("/sendEmailCaptcha", h.SendEmailCaptcha)
+	root.POST("/sendPhoneCaptcha", h.SendPhoneCaptcha)
 	root.POST("/resetPassword", h.ResetPassword)
+	root.POST("/confirmResetPassword", h.ConfirmResetPassword)
+	root.POST("/changeExpiredPassword", h.ChangeExpiredPassword)
+	root.POST("/token/refresh", h.RefreshToken)
+	root.POST("/token", h.Token)
 	root.GET("/jwks.json", h.JWKsJSON)
+	root.GET("/.well-known/openid-configuration", h.OpenIDConfiguration)
+	root.GET("/authorize", h.Authorize)
+	root.GET("/oauth/:provider/authorize", h.OAuthAuthorize)
+	root.GET("/oauth/:provider/callback", h.OAuthCallback)
+	root.GET("/email/verify", h.VerifyEmail)
+	root.POST("/email/verify", h.VerifyEmail)
 }
 
 func (h *Uaa) HandlerAuth(root gin.IRoutes) {
 	root.POST("/logout", h.Logout)
+	root.POST("/logoutAll", h.LogoutAll)
 	root.POST("/changePassword", h.ChangePassword)
+	root.GET("/userinfo", h.UserInfo)
+	root.POST("/userinfo", h.UserInfo)
+	root.POST("/authorize", h.ConfirmAuthorization)
+	root.POST("/email/sendVerification", h.SendEmailVerification)
+	root.POST("/email/change", h.ChangeEmail)
+	root.POST("/email/change/confirm", h.ConfirmEmailChange)
+	root.GET("/sessions", h.ListSessions)
+	root.POST("/revokeSession", h.RevokeSession)
+	root.POST("/revokeOtherSessions", h.RevokeOtherSessions)
+	root.GET("/personalTokens", h.ListPersonalTokens)
+	root.POST("/personalTokens", h.CreatePersonalToken)
+	root.POST("/revokePersonalToken", h.RevokePersonalToken)
+	root.GET("/identities", h.ListIdentities)
+	root.POST("/identities/merge", h.MergeOAuth)
+	root.POST("/identity/:provider/link", h.LinkOAuthAuthorize)
+	root.POST("/identity/:provider/unlink", h.UnlinkOAuth)
+	root.POST("/identity/:provider/reauth", h.ReauthOAuthAuthorize)
+	root.POST("/twoFactor/enroll", h.EnrollTwoFactor)
+	root.POST("/twoFactor/confirm", h.ConfirmTwoFactor)
+	root.POST("/twoFactor/disable", h.DisableTwoFactor)
+	root.POST("/twoFactor/recoveryCodes", h.RegenerateRecoveryCodes)
+	root.GET("/deletion", h.GetDeletion)
+	root.POST("/deletion", h.RequestDeletion)
+	root.POST("/deletion/cancel", h.CancelDeletion)
+
+	root.GET("/admin/accounts", h.ListAccounts)
+	root.POST("/admin/account/:uid/lock", h.LockAccount)
+	root.POST("/admin/account/:uid/unlock", h.UnlockAccount)
+	root.POST("/admin/account/:uid/expireCredentials", h.ExpireCredentials)
+	root.POST("/admin/account/:uid/renewCredentials", h.RenewCredentials)
+	root.POST("/admin/account/:uid/logout", h.LogoutAccount)
+	root.GET("/admin/account/:uid/roles", h.GetAccountRoles)
+	root.POST("/admin/account/:uid/roles", h.SetAccountRoles)
+	root.GET("/admin/account/:uid/deletion", h.GetAccountDeletion)
+	root.POST("/admin/account/:uid/delete", h.DeleteAccount)
+	root.GET("/admin/audit", h.ListAuditEvents)
+	root.GET("/admin/clients", h.ListClients)
+	root.POST("/admin/client/add", h.AddClient)
+	root.POST("/admin/client/:id/delete", h.DeleteClient)
+	root.GET("/admin/policies", h.ListPolicies)
+	root.POST("/admin/policy/add", h.AddPolicy)
+	root.POST("/admin/policy/update", h.UpdatePolicy)
+	root.POST("/admin/policy/remove", h.RemovePolicy)
 }
 
 func (h *Uaa) HandlerHealth(root gin.IRoutes) {
@@ -61,11 +151,10 @@ func (h *Uaa) Register(ctx *gin.Context) {
 	if ctx.Query("type") == "email" {
 		// parse body
 		type registerReq struct {
-			Username string   `json:"username"`
-			Password string   `json:"password"`
-			Roles    []string `json:"roles"`
-			Email    string   `json:"email"`
-			Captcha  string   `json:"captcha"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Email    string `json:"email"`
+			Captcha  string `json:"captcha"`
 		}
 		var body registerReq
 		err := ctx.Bind(&body)
@@ -74,6 +163,10 @@ func (h *Uaa) Register(ctx *gin.Context) {
 			return
 		}
 
+		if !h.checkPassword(ctx, "", body.Password) {
+			return
+		}
+
 		// check email or phone
 		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 		defer cancel()
@@ -98,17 +191,19 @@ func (h *Uaa) Register(ctx *gin.Context) {
 		response, err := uaaClient.RegisterByNormal(timeoutCtx, &uaa.RegisterNormalReq{
 			Username: body.Username,
 			Password: body.Password,
-			Roles:    body.Roles,
 			Contact: &uaa.RegisterNormalReq_Email{
 				Email: body.Email,
 			},
+			// The captcha was sent to the email
+			EmailVerified: true,
 		})
 		if err != nil {
-			errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+			errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrUnknown))
 			return
 		}
 
-		h.middle.AddUser(response.Uid)
+		// Roles of the new account are bound by uaa, pick them up now
+		h.loadPolicy()
 
 		ctx.JSON(http.StatusOK, gin.H{
 			"uid":   response.Uid,
@@ -126,6 +221,82 @@ func (h *Uaa) Register(ctx *gin.Context) {
 			SendTime: ptypes.TimestampNow(),
 		})
 
+		// Send welcome inbox
+		timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		messageClient.SendInBox(timeoutCtx, &message.SendInBoxReq{
+			Uid:      response.Uid,
+			Topic:    "Welcome " + body.Username,
+			Content:  "Hi " + body.Username,
+			SendTime: ptypes.TimestampNow(),
+		})
+	} else if ctx.Query("type") == "phone" {
+		// parse body
+		type registerReq struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Phone    string `json:"phone"`
+			Captcha  string `json:"captcha"`
+		}
+		var body registerReq
+		err := ctx.Bind(&body)
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+
+		phone, err := sms.NormalizePhone(body.Phone, h.callingCode)
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, errors.ErrPhoneInvalid)
+			return
+		}
+
+		if !h.checkPassword(ctx, "", body.Password) {
+			return
+		}
+
+		// check phone
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		if body.Captcha != "" {
+			rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+				Type: captcha.CaptchaType_RANDOM_BY_ID,
+				Id:   phone,
+				Code: body.Captcha,
+			})
+			if err != nil || !rsp.Correct {
+				errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+				return
+			}
+		} else {
+			errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+			return
+		}
+
+		// make request
+		timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		response, err := uaaClient.RegisterByNormal(timeoutCtx, &uaa.RegisterNormalReq{
+			Username: body.Username,
+			Password: body.Password,
+			Contact: &uaa.RegisterNormalReq_Phone{
+				Phone: phone,
+			},
+		})
+		if err != nil {
+			errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrUnknown))
+			return
+		}
+
+		// Roles of the new account are bound by uaa, pick them up now
+		h.loadPolicy()
+
+		ctx.JSON(http.StatusOK, gin.H{
+			"uid":   response.Uid,
+			"roles": response.Roles,
+		})
+
+		// This step can happen error and will ignore
 		// Send welcome inbox
 		timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
 		defer cancel()
@@ -143,11 +314,13 @@ func (h *Uaa) Register(ctx *gin.Context) {
 
 func (h *Uaa) Login(ctx *gin.Context) {
 	uaaClient := clients.UaaFromContext(ctx)
+	captchaClient := clients.CaptchaFromContext(ctx)
 
 	// parse body
 	type loginReq struct {
 		Principal string `json:"principal"`
 		Password  string `json:"password"`
+		CaptchaId string `json:"captcha_id"`
 		Captcha   string `json:"captcha"`
 	}
 	var body loginReq
@@ -157,34 +330,106 @@ func (h *Uaa) Login(ctx *gin.Context) {
 		return
 	}
 
+	// Captcha is only required after failures, uaa tells when
+	captchaVerified := false
+	if body.CaptchaId != "" {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+			Type: captcha.CaptchaType_IMAGE,
+			Id:   body.CaptchaId,
+			Code: body.Captcha,
+		})
+		if err != nil || !rsp.Correct {
+			errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+			return
+		}
+		captchaVerified = true
+	}
+
 	// make request
 	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	response, err := uaaClient.VerifyPassword(timeoutCtx, &uaa.VerifyAccountReq{
-		Principal: body.Principal,
-		Password:  body.Password,
+		Principal:       normalizePrincipal(body.Principal),
+		Password:        body.Password,
+		Ip:              clients.ClientIPFromContext(ctx),
+		CaptchaVerified: captchaVerified,
 	})
 	if err != nil {
-		errors.AbortWithErrorJSON(ctx, errors.ErrUsernameOrPasswordNotCorrect)
+		errors.AbortWithErrorJSON(ctx, accountStateError(err, errors.ErrUsernameOrPasswordNotCorrect))
 		return
 	}
 
-	token, err := h.generator.GenerateJwt(24*time.Hour, response.Uid, []string{"uaa", "content", "message"}, jwt.MapClaims{
-		"username": response.Username,
-	})
+	if response.TwoFactorEnabled {
+		h.twoFactorChallenge(ctx, response)
+		return
+	}
+
+	tokens, err := h.loginTokens(ctx, response)
 	if err != nil {
+		log.Error(err)
 		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{
-		"access_token": token,
-		"type":         "bearer",
-	})
+	ctx.JSON(http.StatusOK, tokens)
 }
 
 func (h *Uaa) Logout(ctx *gin.Context) {
-	// TODO: may do something
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body, refresh token is optional
+	type logoutReq struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	var body logoutReq
+	err := ctx.ShouldBindJSON(&body)
+	if err != nil && err != io.EOF {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	expireTime, err := ptypes.TimestampProto(h.middle.ExtractEXP(ctx))
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	// make request
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.RevokeToken(timeoutCtx, &uaa.RevokeTokenReq{
+		Jti:          h.middle.ExtractJTI(ctx),
+		Uid:          h.middle.ExtractSub(ctx),
+		ExpireTime:   expireTime,
+		RefreshToken: body.RefreshToken,
+		SessionId:    h.middle.ExtractSID(ctx),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// LogoutAll logs out all sessions of current account, including this one.
+func (h *Uaa) LogoutAll(ctx *gin.Context) {
+	uaaClient := clients.UaaFromContext(ctx)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err := uaaClient.RevokeAllTokens(timeoutCtx, &uaa.UIDReq{
+		Uid: h.middle.ExtractSub(ctx),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
 	ctx.Status(http.StatusOK)
 }
 
@@ -231,7 +476,58 @@ func (h *Uaa) ChangePassword(ctx *gin.Context) {
 	})
 	if err != nil {
 		log.Error(err)
-		ctx.AbortWithError(http.StatusInternalServerError, errors.ErrUnknown)
+		errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrUnknown))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// ChangeExpiredPassword lets account with expired credentials, which can't
+// login any more, set a new password by the old one.
+func (h *Uaa) ChangeExpiredPassword(ctx *gin.Context) {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type changeExpiredPasswordReq struct {
+		Principal       string `json:"principal"`
+		OldPassword     string `json:"old_password"`
+		NewPassword     string `json:"new_password"`
+		CaptchaId       string `json:"captcha_id"`
+		CaptchaSolution string `json:"captcha_solution"`
+	}
+	var body changeExpiredPasswordReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_IMAGE,
+		Id:   body.CaptchaId,
+		Code: body.CaptchaSolution,
+	})
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	// make request
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.ChangePassword(timeoutCtx, &uaa.ChangePasswordReq{
+		Principal:   normalizePrincipal(body.Principal),
+		NewPassword: body.NewPassword,
+		OldPassword: body.OldPassword,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrUsernameOrPasswordNotCorrect))
+		return
 	}
 
 	ctx.Status(http.StatusOK)
@@ -314,10 +610,237 @@ func (h *Uaa) SendEmailCaptcha(ctx *gin.Context) {
 }
 
 func (h *Uaa) SendPhoneCaptcha(ctx *gin.Context) {
+	messageClient := clients.MessageFromContext(ctx)
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type sendPhoneCaptchaReq struct {
+		Phone           string `json:"phone"`
+		CaptchaId       string `json:"captcha_id"`
+		CaptchaSolution string `json:"captcha_solution"`
+	}
+	var body sendPhoneCaptchaReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	phone, err := sms.NormalizePhone(body.Phone, h.callingCode)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrPhoneInvalid)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	tmpAccount, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: phone,
+	})
+
+	if err == nil && tmpAccount != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrAccountExists)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_IMAGE,
+		Id:   body.CaptchaId,
+		Code: body.CaptchaSolution,
+	})
+
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	random, err := captchaClient.GetRandomById(timeoutCtx, &captcha.GetRandomReq{
+		Len: 6,
+		Id:  phone,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	// Send captcha sms
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = messageClient.SendSMS(timeoutCtx, &message.SendSMSReq{
+		PhoneNumber: phone,
+		Content:     "Your captcha:" + random.Code,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
 	ctx.Status(http.StatusOK)
 }
 
+func resetPasswordCaptchaId(uid string) string {
+	return "reset_password:" + uid
+}
+
+// ResetPassword sends a one-time code to email or phone of the account. It
+// always succeeds, so whether an account exists is not leaked.
 func (h *Uaa) ResetPassword(ctx *gin.Context) {
+	messageClient := clients.MessageFromContext(ctx)
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type resetPasswordReq struct {
+		Principal       string `json:"principal" binding:"required"`
+		CaptchaId       string `json:"captcha_id"`
+		CaptchaSolution string `json:"captcha_solution"`
+	}
+	var body resetPasswordReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	if !h.resetLimiter.Allow("request:" + strings.ToLower(normalizePrincipal(body.Principal))) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_IMAGE,
+		Id:   body.CaptchaId,
+		Code: body.CaptchaSolution,
+	})
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	account, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: normalizePrincipal(body.Principal),
+	})
+	if err != nil || (account.Email == "" && account.Phone == "") {
+		ctx.Status(http.StatusOK)
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	random, err := captchaClient.GetRandomById(timeoutCtx, &captcha.GetRandomReq{
+		Len: 6,
+		Id:  resetPasswordCaptchaId(account.Uid),
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	// Send to the contact user asked by, prefer email for username
+	content := "Your password reset code:" + random.Code
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if account.Phone != "" && (normalizePrincipal(body.Principal) == account.Phone || account.Email == "") {
+		_, err = messageClient.SendSMS(timeoutCtx, &message.SendSMSReq{
+			PhoneNumber: account.Phone,
+			Content:     content,
+		})
+	} else {
+		_, err = messageClient.SendEmail(timeoutCtx, &message.SendEmailReq{
+			Email:    account.Email,
+			Topic:    "Reset password",
+			Content:  content,
+			SendTime: ptypes.TimestampNow(),
+		})
+	}
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, errors.ErrUnknown)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// ConfirmResetPassword sets a new password by the code from ResetPassword,
+// all sessions of the account are logged out.
+func (h *Uaa) ConfirmResetPassword(ctx *gin.Context) {
+	captchaClient := clients.CaptchaFromContext(ctx)
+	uaaClient := clients.UaaFromContext(ctx)
+
+	// parse body
+	type confirmResetPasswordReq struct {
+		Principal   string `json:"principal" binding:"required"`
+		Code        string `json:"code" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	var body confirmResetPasswordReq
+	err := ctx.Bind(&body)
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrBadRequest)
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	account, err := uaaClient.GetOne(timeoutCtx, &uaa.GetOneReq{
+		Principal: normalizePrincipal(body.Principal),
+	})
+	if err != nil {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	// By uid, so guessing through username, email and phone share one limit
+	if !h.resetLimiter.Allow("confirm:" + account.Uid) {
+		errors.AbortWithErrorJSON(ctx, errors.ErrTooManyRequests)
+		return
+	}
+
+	// Only the policy before the code is verified, history would tell the
+	// current password to anyone knowing the principal. uaa checks history
+	// on reset.
+	if !h.checkPassword(ctx, "", body.NewPassword) {
+		return
+	}
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rsp, err := captchaClient.Verify(timeoutCtx, &captcha.VerifyReq{
+		Type: captcha.CaptchaType_RANDOM_BY_ID,
+		Id:   resetPasswordCaptchaId(account.Uid),
+		Code: body.Code,
+	})
+	if err != nil || !rsp.Correct {
+		errors.AbortWithErrorJSON(ctx, errors.ErrCaptchaNotCorrect)
+		return
+	}
+
+	// make request
+	timeoutCtx, cancel = context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	_, err = uaaClient.ResetPassword(timeoutCtx, &uaa.ResetPasswordReq{
+		Uid:         account.Uid,
+		NewPassword: body.NewPassword,
+	})
+	if err != nil {
+		log.Error(err)
+		errors.AbortWithErrorJSON(ctx, passwordError(err, errors.ErrUnknown))
+		return
+	}
+
 	ctx.Status(http.StatusOK)
 }
 
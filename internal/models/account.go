@@ -14,17 +14,39 @@ const (
 )
 
 type Account struct {
-	UID                string            `bson:"_id"`
-	Username           string            `bson:"username"`
-	Email              string            `bson:"email"`
-	Phone              string            `bson:"phone"`
-	OAuthUIds          map[string]string `bson:"oauth_uids"`
-	Roles              []string          `bson:"roles"`
-	Password           []byte            `bson:"password"`
-	Locked             bool              `bson:"locked"`
-	CredentialsExpired bool              `bson:"credentials_expired"`
-	CreateDate         time.Time         `bson:"create_date"`
-	UpdateDate         time.Time         `bson:"update_date"`
-	LastSignInIP       string            `bson:"last_sign_in_ip"`
-	LastSignInTime     time.Time         `bson:"last_sign_in_time"`
+	UID           string            `bson:"_id"`
+	Username      string            `bson:"username"`
+	Email         string            `bson:"email"`
+	EmailVerified bool              `bson:"email_verified"`
+	EmailChange   *EmailChange      `bson:"email_change,omitempty"`
+	Phone         string            `bson:"phone"`
+	OAuthUIds     map[string]string `bson:"oauth_uids"`
+	Roles         []string          `bson:"roles"`
+	Password      []byte            `bson:"password"`
+	PasswordDate  time.Time         `bson:"password_date"`
+	// Hashes of previous passwords, latest first
+	PasswordHistory    [][]byte  `bson:"password_history"`
+	Locked             bool      `bson:"locked"`
+	LockedUntil        time.Time `bson:"locked_until"`
+	CredentialsExpired bool      `bson:"credentials_expired"`
+	MustChangePassword bool      `bson:"must_change_password"`
+	CreateDate         time.Time `bson:"create_date"`
+	UpdateDate         time.Time `bson:"update_date"`
+	LastSignInIP       string    `bson:"last_sign_in_ip"`
+	LastSignInTime     time.Time `bson:"last_sign_in_time"`
+	TokensRevokedAt    time.Time `bson:"tokens_revoked_at"`
+	TwoFactorEnabled   bool      `bson:"two_factor_enabled"`
+	TOTPSecret         string    `bson:"totp_secret"`
+	PendingTOTPSecret  string    `bson:"pending_totp_secret"`
+	TOTPLastCounter    int64     `bson:"totp_last_counter"`
+	RecoveryCodes      []string  `bson:"recovery_codes"`
+}
+
+// EmailChange is a pending switch to Email, done once both the current and
+// the new address confirmed.
+type EmailChange struct {
+	Email        string    `bson:"email"`
+	OldConfirmed bool      `bson:"old_confirmed"`
+	NewConfirmed bool      `bson:"new_confirmed"`
+	ExpireTime   time.Time `bson:"expire_time"`
 }
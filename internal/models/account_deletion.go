@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Steps of an account deletion, run in this order. Account goes last, so it
+// can still be found while other steps are retried.
+const (
+	DeletionStepContent = "content"
+	DeletionStepMessage = "message"
+	DeletionStepPolicy  = "policy"
+	DeletionStepAccount = "account"
+)
+
+var DeletionSteps = []string{DeletionStepContent, DeletionStepMessage, DeletionStepPolicy, DeletionStepAccount}
+
+type DeletionStep struct {
+	Name      string    `bson:"name"`
+	Done      bool      `bson:"done"`
+	DoneDate  time.Time `bson:"done_date"`
+	Attempts  int64     `bson:"attempts"`
+	LastError string    `bson:"last_error"`
+}
+
+// AccountDeletion deletes data of an account across services once the grace
+// period ends, failed steps are retried from NextAttempt on.
+type AccountDeletion struct {
+	UID           string          `bson:"_id"`
+	RequestDate   time.Time       `bson:"request_date"`
+	ScheduledDate time.Time       `bson:"scheduled_date"`
+	Done          bool            `bson:"done"`
+	DoneDate      time.Time       `bson:"done_date"`
+	NextAttempt   time.Time       `bson:"next_attempt"`
+	LeaseUntil    time.Time       `bson:"lease_until"`
+	Steps         []*DeletionStep `bson:"steps"`
+}
@@ -20,4 +20,19 @@ type AccountProfile struct {
 	Gender     GenderType `bson:"gender" json:"gender"`
 	UpdateDate time.Time  `bson:"update_date" json:"update_date"`
 	Locale     string     `bson:"locale" json:"locale"`
+	// Fields only the owner can see
+	PrivateFields []string `bson:"private_fields" json:"private_fields"`
 }
+
+// Profile fields, named as their bson keys
+const (
+	ProfileFirstname = "firstname"
+	ProfileLastname  = "lastname"
+	ProfileAvatarUrl = "avatar_url"
+	ProfileBio       = "bio"
+	ProfileBirthday  = "birthday"
+	ProfileGender    = "gender"
+	ProfileLocale    = "locale"
+)
+
+var DefaultPrivateFields = []string{ProfileBirthday, ProfileGender}
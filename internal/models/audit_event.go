@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Types of audit events
+const (
+	AuditLoginSuccess        = "login.success"
+	AuditLoginFailure        = "login.failure"
+	AuditPasswordChange      = "password.change"
+	AuditPasswordReset       = "password.reset"
+	AuditAccountLock         = "account.lock"
+	AuditAccountUnlock       = "account.unlock"
+	AuditRoleSet             = "role.set"
+	AuditOAuthLink           = "oauth.link"
+	AuditOAuthUnlink         = "oauth.unlink"
+	AuditAccountMerge        = "account.merge"
+	AuditPersonalTokenCreate = "personal_token.create"
+	AuditPersonalTokenRevoke = "personal_token.revoke"
+	AuditPolicyAdd           = "policy.add"
+	AuditPolicyRemove        = "policy.remove"
+	AuditPolicyUpdate        = "policy.update"
+	AuditPolicySave          = "policy.save"
+)
+
+// AuditEvent records who did what to whom, events are never updated.
+type AuditEvent struct {
+	ID     string            `bson:"_id"`
+	Type   string            `bson:"type"`
+	Actor  string            `bson:"actor"`
+	Target string            `bson:"target"`
+	IP     string            `bson:"ip"`
+	Time   time.Time         `bson:"time"`
+	Detail map[string]string `bson:"detail"`
+}
@@ -6,4 +6,6 @@ type KeyValuePair struct {
 	Key        string    `json:"key" bson:"key"`
 	Value      string    `json:"value" bson:"value"`
 	ExpireTime time.Time `json:"expire_time" bson:"expire_time"`
+	// Wrong guesses of the value so far
+	Attempts int64 `json:"attempts" bson:"attempts"`
 }
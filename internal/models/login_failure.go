@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// LoginFailure counts failed logins of one key, a principal or an IP,
+// in the window ending at ExpireTime.
+type LoginFailure struct {
+	Key        string    `bson:"_id"`
+	Count      int       `bson:"count"`
+	ExpireTime time.Time `bson:"expire_time"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// OAuthClient authenticates services by the client_credentials grant, its
+// tokens have subject "client:<id>". Clients with redirect URIs also sign
+// users in by the authorization code grant of OpenID Connect.
+type OAuthClient struct {
+	ID           string    `bson:"_id"`
+	Name         string    `bson:"name"`
+	Secret       []byte    `bson:"secret"`
+	Scopes       []string  `bson:"scopes"`
+	RedirectURIs []string  `bson:"redirect_uris"`
+	CreateDate   time.Time `bson:"create_date"`
+	UpdateDate   time.Time `bson:"update_date"`
+}
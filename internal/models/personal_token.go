@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// PersonalToken is a long-lived bearer token of an account for scripts, only
+// the hash of its value is stored.
+type PersonalToken struct {
+	ID           string    `bson:"_id"`
+	Hash         string    `bson:"hash"`
+	UID          string    `bson:"uid"`
+	Name         string    `bson:"name"`
+	Scopes       []string  `bson:"scopes"`
+	CreateDate   time.Time `bson:"create_date"`
+	ExpireTime   time.Time `bson:"expire_time"`
+	LastUsedTime time.Time `bson:"last_used_time"`
+}
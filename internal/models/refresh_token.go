@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// RefreshToken only keeps the hash of the opaque token handed to client.
+// Tokens rotated from the same login share one family.
+type RefreshToken struct {
+	ID         string    `bson:"_id"`
+	UID        string    `bson:"uid"`
+	Family     string    `bson:"family"`
+	Used       bool      `bson:"used"`
+	Revoked    bool      `bson:"revoked"`
+	ExpireTime time.Time `bson:"expire_time"`
+	CreateDate time.Time `bson:"create_date"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// RevokedToken is kept until the revoked access token expires by itself.
+type RevokedToken struct {
+	JTI        string    `bson:"_id"`
+	UID        string    `bson:"uid"`
+	ExpireTime time.Time `bson:"expire_time"`
+	CreateDate time.Time `bson:"create_date"`
+}
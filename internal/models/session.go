@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Session is a login on one device, its ID is the family of the refresh
+// tokens rotated from that login.
+type Session struct {
+	ID             string    `bson:"_id"`
+	UID            string    `bson:"uid"`
+	JTI            string    `bson:"jti"`
+	IP             string    `bson:"ip"`
+	UserAgent      string    `bson:"user_agent"`
+	Revoked        bool      `bson:"revoked"`
+	CreateDate     time.Time `bson:"create_date"`
+	LastActiveDate time.Time `bson:"last_active_date"`
+	ExpireTime     time.Time `bson:"expire_time"`
+}
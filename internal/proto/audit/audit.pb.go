@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: teddy-backend/internal/proto/audit/audit.proto
+
+package audit
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Event struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// e.g. "login.success", "policy.add"
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Uid or client subject who did it, empty for the system itself
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Uid, principal or policy subject it was done to
+	Target               string               `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Ip                   string               `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Detail               map[string]string    `protobuf:"bytes,7,rep,name=detail,proto3" json:"detail,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_2bc1f6751f4e31ac, []int{0}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Event) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Event) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *Event) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Event) GetDetail() map[string]string {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+type QueryReq struct {
+	Page   uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size   uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Actor  string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Type   string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Range is [from, to), unset bounds are open
+	From                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamp.Timestamp `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QueryReq) Reset()         { *m = QueryReq{} }
+func (m *QueryReq) String() string { return proto.CompactTextString(m) }
+func (*QueryReq) ProtoMessage()    {}
+func (*QueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_2bc1f6751f4e31ac, []int{1}
+}
+func (m *QueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryReq.Unmarshal(m, b)
+}
+func (m *QueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryReq.Marshal(b, m, deterministic)
+}
+func (dst *QueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReq.Merge(dst, src)
+}
+func (m *QueryReq) XXX_Size() int {
+	return xxx_messageInfo_QueryReq.Size(m)
+}
+func (m *QueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReq proto.InternalMessageInfo
+
+func (m *QueryReq) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *QueryReq) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *QueryReq) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *QueryReq) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *QueryReq) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueryReq) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *QueryReq) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+type QueryResp struct {
+	TotalCount uint64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// Most recent first
+	Items                []*Event `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryResp) Reset()         { *m = QueryResp{} }
+func (m *QueryResp) String() string { return proto.CompactTextString(m) }
+func (*QueryResp) ProtoMessage()    {}
+func (*QueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_2bc1f6751f4e31ac, []int{2}
+}
+func (m *QueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResp.Unmarshal(m, b)
+}
+func (m *QueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResp.Marshal(b, m, deterministic)
+}
+func (dst *QueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResp.Merge(dst, src)
+}
+func (m *QueryResp) XXX_Size() int {
+	return xxx_messageInfo_QueryResp.Size(m)
+}
+func (m *QueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResp proto.InternalMessageInfo
+
+func (m *QueryResp) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *QueryResp) GetItems() []*Event {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "teddy.srv.audit.Event")
+	proto.RegisterMapType((map[string]string)(nil), "teddy.srv.audit.Event.DetailEntry")
+	proto.RegisterType((*QueryReq)(nil), "teddy.srv.audit.QueryReq")
+	proto.RegisterType((*QueryResp)(nil), "teddy.srv.audit.QueryResp")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditClient interface {
+	Record(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
+	Query(ctx context.Context, in *QueryReq, opts ...grpc.CallOption) (*QueryResp, error)
+}
+
+type auditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditClient(cc *grpc.ClientConn) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) Record(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.audit.Audit/Record", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditClient) Query(ctx context.Context, in *QueryReq, opts ...grpc.CallOption) (*QueryResp, error) {
+	out := new(QueryResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.audit.Audit/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+type AuditServer interface {
+	Record(context.Context, *Event) (*empty.Empty, error)
+	Query(context.Context, *QueryReq) (*QueryResp, error)
+}
+
+func RegisterAuditServer(s *grpc.Server, srv AuditServer) {
+	s.RegisterService(&_Audit_serviceDesc, srv)
+}
+
+func _Audit_Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Event)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).Record(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.audit.Audit/Record",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).Record(ctx, req.(*Event))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audit_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.audit.Audit/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).Query(ctx, req.(*QueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Audit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "teddy.srv.audit.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Record",
+			Handler:    _Audit_Record_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _Audit_Query_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "teddy-backend/internal/proto/audit/audit.proto",
+}
+
+func init() {
+	proto.RegisterFile("teddy-backend/internal/proto/audit/audit.proto", fileDescriptor_audit_2bc1f6751f4e31ac)
+}
+
+var fileDescriptor_audit_2bc1f6751f4e31ac = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x8f, 0xd3, 0x30,
+	0x10, 0xdd, 0x64, 0x93, 0x96, 0x9d, 0x6a, 0x01, 0x59, 0xa8, 0x0a, 0x39, 0xa0, 0x2a, 0xa7, 0x0a,
+	0x81, 0x23, 0x75, 0x2f, 0xb0, 0x5c, 0xf8, 0xea, 0x0f, 0x20, 0xe2, 0x02, 0x37, 0xb7, 0xf1, 0x06,
+	0x6b, 0x93, 0xd8, 0x38, 0x93, 0x4a, 0xe1, 0xce, 0x9d, 0x9f, 0xc6, 0x4f, 0x42, 0x1e, 0x27, 0xa2,
+	0x62, 0x59, 0x2d, 0x5c, 0xac, 0x99, 0x97, 0xf7, 0x26, 0xf3, 0x9e, 0x0d, 0x1c, 0x65, 0x59, 0x0e,
+	0xcf, 0x77, 0x62, 0x7f, 0x2d, 0xdb, 0x32, 0x57, 0x2d, 0x4a, 0xdb, 0x8a, 0x3a, 0x37, 0x56, 0xa3,
+	0xce, 0x45, 0x5f, 0x2a, 0xf4, 0x27, 0x27, 0x84, 0x3d, 0x20, 0x3e, 0xef, 0xec, 0x81, 0x13, 0x9c,
+	0x5e, 0x54, 0x0a, 0xbf, 0xf4, 0x3b, 0xbe, 0xd7, 0x4d, 0x5e, 0xe9, 0x5a, 0xb4, 0x95, 0xd7, 0xee,
+	0xfa, 0xab, 0xdc, 0xe0, 0x60, 0x64, 0x97, 0xcb, 0xc6, 0xe0, 0xe0, 0x4f, 0x3f, 0x25, 0x7d, 0x75,
+	0xb7, 0x08, 0x55, 0x23, 0x3b, 0x14, 0x8d, 0xf9, 0x5d, 0x79, 0x71, 0xf6, 0x23, 0x84, 0x78, 0x7b,
+	0x90, 0x2d, 0xb2, 0xfb, 0x10, 0xaa, 0x32, 0x09, 0x56, 0xc1, 0xfa, 0xac, 0x08, 0x55, 0xc9, 0x18,
+	0x44, 0x4e, 0x9c, 0x84, 0x84, 0x50, 0xcd, 0x1e, 0x41, 0x2c, 0xf6, 0xa8, 0x6d, 0x72, 0x4a, 0xa0,
+	0x6f, 0xd8, 0x12, 0x66, 0x28, 0x6c, 0x25, 0x31, 0x89, 0x08, 0x1e, 0x3b, 0x9a, 0x68, 0x92, 0x78,
+	0x9c, 0x68, 0x18, 0x87, 0xc8, 0xfd, 0x3e, 0x99, 0xad, 0x82, 0xf5, 0x62, 0x93, 0xf2, 0x4a, 0xeb,
+	0xaa, 0x96, 0x7c, 0x5a, 0x96, 0x7f, 0x9c, 0x76, 0x2b, 0x88, 0xc7, 0x2e, 0x61, 0x56, 0x4a, 0x14,
+	0xaa, 0x4e, 0xe6, 0xab, 0xd3, 0xf5, 0x62, 0x93, 0xf1, 0x3f, 0xf2, 0xe2, 0xb4, 0x39, 0x7f, 0x4f,
+	0xa4, 0x6d, 0x8b, 0x76, 0x28, 0x46, 0x45, 0xfa, 0x12, 0x16, 0x47, 0x30, 0x7b, 0x08, 0xa7, 0xd7,
+	0x72, 0x18, 0xdd, 0xb9, 0xd2, 0x59, 0x39, 0x88, 0xba, 0x9f, 0xfc, 0xf9, 0xe6, 0x32, 0x7c, 0x11,
+	0x64, 0x3f, 0x03, 0xb8, 0xf7, 0xa1, 0x97, 0x76, 0x28, 0xe4, 0x57, 0x97, 0x82, 0x11, 0x95, 0x24,
+	0xe5, 0x79, 0x41, 0xb5, 0xc3, 0x3a, 0xf5, 0xcd, 0x2b, 0xcf, 0x0b, 0xaa, 0xff, 0x33, 0x99, 0x29,
+	0xdb, 0xf8, 0x28, 0x5b, 0x0e, 0xd1, 0x95, 0xd5, 0xcd, 0xbf, 0xa4, 0xe3, 0x78, 0xec, 0x29, 0x84,
+	0xa8, 0x93, 0xf9, 0x9d, 0xec, 0x10, 0x75, 0xf6, 0x09, 0xce, 0x46, 0x47, 0x9d, 0x61, 0x4f, 0x00,
+	0x50, 0xa3, 0xa8, 0xdf, 0xe9, 0xbe, 0x45, 0x32, 0x16, 0x15, 0x47, 0x08, 0x7b, 0x06, 0xb1, 0x42,
+	0xd9, 0x74, 0x49, 0x48, 0xa9, 0x2f, 0xff, 0x9e, 0x7a, 0xe1, 0x49, 0x9b, 0xef, 0x01, 0xc4, 0x6f,
+	0x1c, 0xec, 0xae, 0xab, 0x90, 0x7b, 0x6d, 0x4b, 0x76, 0x8b, 0x24, 0x5d, 0xde, 0x58, 0x73, 0xeb,
+	0xde, 0x71, 0x76, 0xc2, 0x5e, 0x43, 0x4c, 0x0b, 0xb2, 0xc7, 0x37, 0xa4, 0xd3, 0x55, 0xa4, 0xe9,
+	0x6d, 0x9f, 0x3a, 0x93, 0x9d, 0xbc, 0x9d, 0x7f, 0x8e, 0x09, 0xdc, 0xcd, 0x68, 0xf8, 0xc5, 0xaf,
+	0x01, 0x00, 0x68, 0x89, 0x9a, 0x20, 0x8d, 0x03, 0x00, 0x00,
+}
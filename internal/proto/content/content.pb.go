@@ -37,7 +37,7 @@ func (m *Sort) Reset()         { *m = Sort{} }
 func (m *Sort) String() string { return proto.CompactTextString(m) }
 func (*Sort) ProtoMessage()    {}
 func (*Sort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{0}
+	return fileDescriptor_content_e1257a5c9f255750, []int{0}
 }
 func (m *Sort) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sort.Unmarshal(m, b)
@@ -86,7 +86,7 @@ func (m *TagResp) Reset()         { *m = TagResp{} }
 func (m *TagResp) String() string { return proto.CompactTextString(m) }
 func (*TagResp) ProtoMessage()    {}
 func (*TagResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{1}
+	return fileDescriptor_content_e1257a5c9f255750, []int{1}
 }
 func (m *TagResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagResp.Unmarshal(m, b)
@@ -153,7 +153,7 @@ func (m *TagsResp) Reset()         { *m = TagsResp{} }
 func (m *TagsResp) String() string { return proto.CompactTextString(m) }
 func (*TagsResp) ProtoMessage()    {}
 func (*TagsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{2}
+	return fileDescriptor_content_e1257a5c9f255750, []int{2}
 }
 func (m *TagsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagsResp.Unmarshal(m, b)
@@ -199,7 +199,7 @@ func (m *TagAndType) Reset()         { *m = TagAndType{} }
 func (m *TagAndType) String() string { return proto.CompactTextString(m) }
 func (*TagAndType) ProtoMessage()    {}
 func (*TagAndType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{3}
+	return fileDescriptor_content_e1257a5c9f255750, []int{3}
 }
 func (m *TagAndType) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagAndType.Unmarshal(m, b)
@@ -270,7 +270,7 @@ func (m *InfoResp) Reset()         { *m = InfoResp{} }
 func (m *InfoResp) String() string { return proto.CompactTextString(m) }
 func (*InfoResp) ProtoMessage()    {}
 func (*InfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{4}
+	return fileDescriptor_content_e1257a5c9f255750, []int{4}
 }
 func (m *InfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoResp.Unmarshal(m, b)
@@ -491,7 +491,7 @@ func (m *InfosResp) Reset()         { *m = InfosResp{} }
 func (m *InfosResp) String() string { return proto.CompactTextString(m) }
 func (*InfosResp) ProtoMessage()    {}
 func (*InfosResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{5}
+	return fileDescriptor_content_e1257a5c9f255750, []int{5}
 }
 func (m *InfosResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfosResp.Unmarshal(m, b)
@@ -540,7 +540,7 @@ func (m *SegmentResp) Reset()         { *m = SegmentResp{} }
 func (m *SegmentResp) String() string { return proto.CompactTextString(m) }
 func (*SegmentResp) ProtoMessage()    {}
 func (*SegmentResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{6}
+	return fileDescriptor_content_e1257a5c9f255750, []int{6}
 }
 func (m *SegmentResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentResp.Unmarshal(m, b)
@@ -607,7 +607,7 @@ func (m *SegmentsResp) Reset()         { *m = SegmentsResp{} }
 func (m *SegmentsResp) String() string { return proto.CompactTextString(m) }
 func (*SegmentsResp) ProtoMessage()    {}
 func (*SegmentsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{7}
+	return fileDescriptor_content_e1257a5c9f255750, []int{7}
 }
 func (m *SegmentsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentsResp.Unmarshal(m, b)
@@ -654,7 +654,7 @@ func (m *ValueResp) Reset()         { *m = ValueResp{} }
 func (m *ValueResp) String() string { return proto.CompactTextString(m) }
 func (*ValueResp) ProtoMessage()    {}
 func (*ValueResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{8}
+	return fileDescriptor_content_e1257a5c9f255750, []int{8}
 }
 func (m *ValueResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValueResp.Unmarshal(m, b)
@@ -707,7 +707,7 @@ func (m *ValuesResp) Reset()         { *m = ValuesResp{} }
 func (m *ValuesResp) String() string { return proto.CompactTextString(m) }
 func (*ValuesResp) ProtoMessage()    {}
 func (*ValuesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{9}
+	return fileDescriptor_content_e1257a5c9f255750, []int{9}
 }
 func (m *ValuesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValuesResp.Unmarshal(m, b)
@@ -755,7 +755,7 @@ func (m *GetTagsReq) Reset()         { *m = GetTagsReq{} }
 func (m *GetTagsReq) String() string { return proto.CompactTextString(m) }
 func (*GetTagsReq) ProtoMessage()    {}
 func (*GetTagsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{10}
+	return fileDescriptor_content_e1257a5c9f255750, []int{10}
 }
 func (m *GetTagsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTagsReq.Unmarshal(m, b)
@@ -814,7 +814,7 @@ func (m *GetTagReq) Reset()         { *m = GetTagReq{} }
 func (m *GetTagReq) String() string { return proto.CompactTextString(m) }
 func (*GetTagReq) ProtoMessage()    {}
 func (*GetTagReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{11}
+	return fileDescriptor_content_e1257a5c9f255750, []int{11}
 }
 func (m *GetTagReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTagReq.Unmarshal(m, b)
@@ -855,7 +855,7 @@ func (m *UIDPageReq) Reset()         { *m = UIDPageReq{} }
 func (m *UIDPageReq) String() string { return proto.CompactTextString(m) }
 func (*UIDPageReq) ProtoMessage()    {}
 func (*UIDPageReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{12}
+	return fileDescriptor_content_e1257a5c9f255750, []int{12}
 }
 func (m *UIDPageReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UIDPageReq.Unmarshal(m, b)
@@ -914,7 +914,7 @@ func (m *InfoOneReq) Reset()         { *m = InfoOneReq{} }
 func (m *InfoOneReq) String() string { return proto.CompactTextString(m) }
 func (*InfoOneReq) ProtoMessage()    {}
 func (*InfoOneReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{13}
+	return fileDescriptor_content_e1257a5c9f255750, []int{13}
 }
 func (m *InfoOneReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoOneReq.Unmarshal(m, b)
@@ -960,7 +960,7 @@ func (m *PublishInfoReq) Reset()         { *m = PublishInfoReq{} }
 func (m *PublishInfoReq) String() string { return proto.CompactTextString(m) }
 func (*PublishInfoReq) ProtoMessage()    {}
 func (*PublishInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{14}
+	return fileDescriptor_content_e1257a5c9f255750, []int{14}
 }
 func (m *PublishInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishInfoReq.Unmarshal(m, b)
@@ -1054,7 +1054,7 @@ func (m *PublishInfoResp) Reset()         { *m = PublishInfoResp{} }
 func (m *PublishInfoResp) String() string { return proto.CompactTextString(m) }
 func (*PublishInfoResp) ProtoMessage()    {}
 func (*PublishInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{15}
+	return fileDescriptor_content_e1257a5c9f255750, []int{15}
 }
 func (m *PublishInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishInfoResp.Unmarshal(m, b)
@@ -1101,7 +1101,7 @@ func (m *EditInfoReq) Reset()         { *m = EditInfoReq{} }
 func (m *EditInfoReq) String() string { return proto.CompactTextString(m) }
 func (*EditInfoReq) ProtoMessage()    {}
 func (*EditInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{16}
+	return fileDescriptor_content_e1257a5c9f255750, []int{16}
 }
 func (m *EditInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EditInfoReq.Unmarshal(m, b)
@@ -1206,7 +1206,7 @@ func (m *GetSegmentsReq) Reset()         { *m = GetSegmentsReq{} }
 func (m *GetSegmentsReq) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentsReq) ProtoMessage()    {}
 func (*GetSegmentsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{17}
+	return fileDescriptor_content_e1257a5c9f255750, []int{17}
 }
 func (m *GetSegmentsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSegmentsReq.Unmarshal(m, b)
@@ -1276,7 +1276,7 @@ func (m *PublishSegmentReq) Reset()         { *m = PublishSegmentReq{} }
 func (m *PublishSegmentReq) String() string { return proto.CompactTextString(m) }
 func (*PublishSegmentReq) ProtoMessage()    {}
 func (*PublishSegmentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{18}
+	return fileDescriptor_content_e1257a5c9f255750, []int{18}
 }
 func (m *PublishSegmentReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishSegmentReq.Unmarshal(m, b)
@@ -1342,7 +1342,7 @@ func (m *PublishSegmentResp) Reset()         { *m = PublishSegmentResp{} }
 func (m *PublishSegmentResp) String() string { return proto.CompactTextString(m) }
 func (*PublishSegmentResp) ProtoMessage()    {}
 func (*PublishSegmentResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{19}
+	return fileDescriptor_content_e1257a5c9f255750, []int{19}
 }
 func (m *PublishSegmentResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishSegmentResp.Unmarshal(m, b)
@@ -1385,7 +1385,7 @@ func (m *EditSegmentReq) Reset()         { *m = EditSegmentReq{} }
 func (m *EditSegmentReq) String() string { return proto.CompactTextString(m) }
 func (*EditSegmentReq) ProtoMessage()    {}
 func (*EditSegmentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{20}
+	return fileDescriptor_content_e1257a5c9f255750, []int{20}
 }
 func (m *EditSegmentReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EditSegmentReq.Unmarshal(m, b)
@@ -1459,7 +1459,7 @@ func (m *SegmentOneReq) Reset()         { *m = SegmentOneReq{} }
 func (m *SegmentOneReq) String() string { return proto.CompactTextString(m) }
 func (*SegmentOneReq) ProtoMessage()    {}
 func (*SegmentOneReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{21}
+	return fileDescriptor_content_e1257a5c9f255750, []int{21}
 }
 func (m *SegmentOneReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentOneReq.Unmarshal(m, b)
@@ -1508,7 +1508,7 @@ func (m *GetValuesReq) Reset()         { *m = GetValuesReq{} }
 func (m *GetValuesReq) String() string { return proto.CompactTextString(m) }
 func (*GetValuesReq) ProtoMessage()    {}
 func (*GetValuesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{22}
+	return fileDescriptor_content_e1257a5c9f255750, []int{22}
 }
 func (m *GetValuesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetValuesReq.Unmarshal(m, b)
@@ -1577,7 +1577,7 @@ func (m *InsertValueReq) Reset()         { *m = InsertValueReq{} }
 func (m *InsertValueReq) String() string { return proto.CompactTextString(m) }
 func (*InsertValueReq) ProtoMessage()    {}
 func (*InsertValueReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{23}
+	return fileDescriptor_content_e1257a5c9f255750, []int{23}
 }
 func (m *InsertValueReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertValueReq.Unmarshal(m, b)
@@ -1636,7 +1636,7 @@ func (m *InsertValueResp) Reset()         { *m = InsertValueResp{} }
 func (m *InsertValueResp) String() string { return proto.CompactTextString(m) }
 func (*InsertValueResp) ProtoMessage()    {}
 func (*InsertValueResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{24}
+	return fileDescriptor_content_e1257a5c9f255750, []int{24}
 }
 func (m *InsertValueResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InsertValueResp.Unmarshal(m, b)
@@ -1678,7 +1678,7 @@ func (m *EditValueReq) Reset()         { *m = EditValueReq{} }
 func (m *EditValueReq) String() string { return proto.CompactTextString(m) }
 func (*EditValueReq) ProtoMessage()    {}
 func (*EditValueReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{25}
+	return fileDescriptor_content_e1257a5c9f255750, []int{25}
 }
 func (m *EditValueReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EditValueReq.Unmarshal(m, b)
@@ -1746,7 +1746,7 @@ func (m *ValueOneReq) Reset()         { *m = ValueOneReq{} }
 func (m *ValueOneReq) String() string { return proto.CompactTextString(m) }
 func (*ValueOneReq) ProtoMessage()    {}
 func (*ValueOneReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{26}
+	return fileDescriptor_content_e1257a5c9f255750, []int{26}
 }
 func (m *ValueOneReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValueOneReq.Unmarshal(m, b)
@@ -1799,7 +1799,7 @@ func (m *GetInfoReq) Reset()         { *m = GetInfoReq{} }
 func (m *GetInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetInfoReq) ProtoMessage()    {}
 func (*GetInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{27}
+	return fileDescriptor_content_e1257a5c9f255750, []int{27}
 }
 func (m *GetInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoReq.Unmarshal(m, b)
@@ -1852,7 +1852,7 @@ func (m *GetInfosReq) Reset()         { *m = GetInfosReq{} }
 func (m *GetInfosReq) String() string { return proto.CompactTextString(m) }
 func (*GetInfosReq) ProtoMessage()    {}
 func (*GetInfosReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{28}
+	return fileDescriptor_content_e1257a5c9f255750, []int{28}
 }
 func (m *GetInfosReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfosReq.Unmarshal(m, b)
@@ -1947,7 +1947,7 @@ func (m *InfoIDWithUIDReq) Reset()         { *m = InfoIDWithUIDReq{} }
 func (m *InfoIDWithUIDReq) String() string { return proto.CompactTextString(m) }
 func (*InfoIDWithUIDReq) ProtoMessage()    {}
 func (*InfoIDWithUIDReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{29}
+	return fileDescriptor_content_e1257a5c9f255750, []int{29}
 }
 func (m *InfoIDWithUIDReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoIDWithUIDReq.Unmarshal(m, b)
@@ -1995,7 +1995,7 @@ func (m *InfoIDPageReq) Reset()         { *m = InfoIDPageReq{} }
 func (m *InfoIDPageReq) String() string { return proto.CompactTextString(m) }
 func (*InfoIDPageReq) ProtoMessage()    {}
 func (*InfoIDPageReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{30}
+	return fileDescriptor_content_e1257a5c9f255750, []int{30}
 }
 func (m *InfoIDPageReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoIDPageReq.Unmarshal(m, b)
@@ -2055,7 +2055,7 @@ func (m *UIDWithTime) Reset()         { *m = UIDWithTime{} }
 func (m *UIDWithTime) String() string { return proto.CompactTextString(m) }
 func (*UIDWithTime) ProtoMessage()    {}
 func (*UIDWithTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{31}
+	return fileDescriptor_content_e1257a5c9f255750, []int{31}
 }
 func (m *UIDWithTime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UIDWithTime.Unmarshal(m, b)
@@ -2100,7 +2100,7 @@ func (m *UserIDsResp) Reset()         { *m = UserIDsResp{} }
 func (m *UserIDsResp) String() string { return proto.CompactTextString(m) }
 func (*UserIDsResp) ProtoMessage()    {}
 func (*UserIDsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{32}
+	return fileDescriptor_content_e1257a5c9f255750, []int{32}
 }
 func (m *UserIDsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserIDsResp.Unmarshal(m, b)
@@ -2139,7 +2139,7 @@ func (m *InfoIDWithTime) Reset()         { *m = InfoIDWithTime{} }
 func (m *InfoIDWithTime) String() string { return proto.CompactTextString(m) }
 func (*InfoIDWithTime) ProtoMessage()    {}
 func (*InfoIDWithTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{33}
+	return fileDescriptor_content_e1257a5c9f255750, []int{33}
 }
 func (m *InfoIDWithTime) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoIDWithTime.Unmarshal(m, b)
@@ -2184,7 +2184,7 @@ func (m *InfoIDsResp) Reset()         { *m = InfoIDsResp{} }
 func (m *InfoIDsResp) String() string { return proto.CompactTextString(m) }
 func (*InfoIDsResp) ProtoMessage()    {}
 func (*InfoIDsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_content_cdd323d2fa2c3157, []int{34}
+	return fileDescriptor_content_e1257a5c9f255750, []int{34}
 }
 func (m *InfoIDsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfoIDsResp.Unmarshal(m, b)
@@ -2211,6 +2211,106 @@ func (m *InfoIDsResp) GetItems() []*InfoIDWithTime {
 	return nil
 }
 
+type UIDReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UIDReq) Reset()         { *m = UIDReq{} }
+func (m *UIDReq) String() string { return proto.CompactTextString(m) }
+func (*UIDReq) ProtoMessage()    {}
+func (*UIDReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_content_e1257a5c9f255750, []int{35}
+}
+func (m *UIDReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UIDReq.Unmarshal(m, b)
+}
+func (m *UIDReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UIDReq.Marshal(b, m, deterministic)
+}
+func (dst *UIDReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UIDReq.Merge(dst, src)
+}
+func (m *UIDReq) XXX_Size() int {
+	return xxx_messageInfo_UIDReq.Size(m)
+}
+func (m *UIDReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UIDReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UIDReq proto.InternalMessageInfo
+
+func (m *UIDReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type UserDataResp struct {
+	Infos                []*InfoResp       `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+	ThumbUps             []*InfoIDWithTime `protobuf:"bytes,2,rep,name=thumbUps,proto3" json:"thumbUps,omitempty"`
+	ThumbDowns           []*InfoIDWithTime `protobuf:"bytes,3,rep,name=thumbDowns,proto3" json:"thumbDowns,omitempty"`
+	Favorites            []*InfoIDWithTime `protobuf:"bytes,4,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UserDataResp) Reset()         { *m = UserDataResp{} }
+func (m *UserDataResp) String() string { return proto.CompactTextString(m) }
+func (*UserDataResp) ProtoMessage()    {}
+func (*UserDataResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_content_e1257a5c9f255750, []int{36}
+}
+func (m *UserDataResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserDataResp.Unmarshal(m, b)
+}
+func (m *UserDataResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserDataResp.Marshal(b, m, deterministic)
+}
+func (dst *UserDataResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserDataResp.Merge(dst, src)
+}
+func (m *UserDataResp) XXX_Size() int {
+	return xxx_messageInfo_UserDataResp.Size(m)
+}
+func (m *UserDataResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserDataResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserDataResp proto.InternalMessageInfo
+
+func (m *UserDataResp) GetInfos() []*InfoResp {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *UserDataResp) GetThumbUps() []*InfoIDWithTime {
+	if m != nil {
+		return m.ThumbUps
+	}
+	return nil
+}
+
+func (m *UserDataResp) GetThumbDowns() []*InfoIDWithTime {
+	if m != nil {
+		return m.ThumbDowns
+	}
+	return nil
+}
+
+func (m *UserDataResp) GetFavorites() []*InfoIDWithTime {
+	if m != nil {
+		return m.Favorites
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sort)(nil), "teddy.srv.content.Sort")
 	proto.RegisterType((*TagResp)(nil), "teddy.srv.content.TagResp")
@@ -2252,6 +2352,8 @@ func init() {
 	proto.RegisterType((*UserIDsResp)(nil), "teddy.srv.content.UserIDsResp")
 	proto.RegisterType((*InfoIDWithTime)(nil), "teddy.srv.content.InfoIDWithTime")
 	proto.RegisterType((*InfoIDsResp)(nil), "teddy.srv.content.InfoIDsResp")
+	proto.RegisterType((*UIDReq)(nil), "teddy.srv.content.UIDReq")
+	proto.RegisterType((*UserDataResp)(nil), "teddy.srv.content.UserDataResp")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2296,6 +2398,10 @@ type ContentClient interface {
 	DeleteFavorite(ctx context.Context, in *InfoIDWithUIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetUserFavorite(ctx context.Context, in *UIDPageReq, opts ...grpc.CallOption) (*InfoIDsResp, error)
 	GetInfoFavorite(ctx context.Context, in *InfoIDPageReq, opts ...grpc.CallOption) (*UserIDsResp, error)
+	// Removes infos published by the user and behaviors of the user
+	DeleteUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Everything above of the user, for the user to download
+	ExportUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*UserDataResp, error)
 }
 
 type contentClient struct {
@@ -2576,6 +2682,24 @@ func (c *contentClient) GetInfoFavorite(ctx context.Context, in *InfoIDPageReq,
 	return out, nil
 }
 
+func (c *contentClient) DeleteUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.content.Content/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentClient) ExportUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*UserDataResp, error) {
+	out := new(UserDataResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.content.Content/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServer is the server API for Content service.
 type ContentServer interface {
 	GetTag(context.Context, *GetTagReq) (*TagResp, error)
@@ -2608,6 +2732,10 @@ type ContentServer interface {
 	DeleteFavorite(context.Context, *InfoIDWithUIDReq) (*empty.Empty, error)
 	GetUserFavorite(context.Context, *UIDPageReq) (*InfoIDsResp, error)
 	GetInfoFavorite(context.Context, *InfoIDPageReq) (*UserIDsResp, error)
+	// Removes infos published by the user and behaviors of the user
+	DeleteUserData(context.Context, *UIDReq) (*empty.Empty, error)
+	// Everything above of the user, for the user to download
+	ExportUserData(context.Context, *UIDReq) (*UserDataResp, error)
 }
 
 func RegisterContentServer(s *grpc.Server, srv ContentServer) {
@@ -3154,6 +3282,42 @@ func _Content_GetInfoFavorite_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Content_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.content.Content/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).DeleteUserData(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Content_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.content.Content/ExportUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServer).ExportUserData(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Content_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "teddy.srv.content.Content",
 	HandlerType: (*ContentServer)(nil),
@@ -3278,134 +3442,146 @@ var _Content_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfoFavorite",
 			Handler:    _Content_GetInfoFavorite_Handler,
 		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _Content_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Content_ExportUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "teddy-backend/internal/proto/content/content.proto",
 }
 
 func init() {
-	proto.RegisterFile("teddy-backend/internal/proto/content/content.proto", fileDescriptor_content_cdd323d2fa2c3157)
-}
-
-var fileDescriptor_content_cdd323d2fa2c3157 = []byte{
-	// 1902 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x72, 0xe3, 0xc6,
-	0x11, 0x16, 0xff, 0x44, 0xa2, 0x29, 0x51, 0xbb, 0x63, 0x67, 0x8d, 0x60, 0x7f, 0x2c, 0x23, 0x9b,
-	0x2a, 0xe5, 0xc7, 0x54, 0x56, 0x76, 0x12, 0xd7, 0xc6, 0x39, 0xd8, 0x4b, 0xad, 0xcc, 0x38, 0xce,
-	0xae, 0xb1, 0xd2, 0x6e, 0xca, 0x95, 0x3f, 0x88, 0x1c, 0x91, 0xa8, 0x25, 0x01, 0x1a, 0x33, 0xd4,
-	0x16, 0x7d, 0xc8, 0x21, 0x95, 0x37, 0xc8, 0x21, 0x79, 0x91, 0x9c, 0x73, 0x4c, 0xe5, 0x90, 0x4b,
-	0x1e, 0x25, 0x4f, 0x90, 0x9a, 0x9e, 0x19, 0x60, 0x20, 0x01, 0x24, 0x68, 0x69, 0x53, 0xbe, 0x48,
-	0x98, 0xc1, 0xcc, 0xd7, 0xdd, 0x5f, 0xf7, 0x74, 0xf7, 0x80, 0x70, 0x38, 0x0a, 0xf8, 0x78, 0x7e,
-	0xda, 0x1d, 0x44, 0xd3, 0xfd, 0xaf, 0xc6, 0x6c, 0x11, 0xcd, 0x63, 0x3f, 0xd8, 0xe7, 0x74, 0x38,
-	0x5c, 0xbc, 0x7b, 0xea, 0x0f, 0x5e, 0xd2, 0x70, 0xb8, 0x3f, 0x88, 0xa6, 0xd3, 0x28, 0xdc, 0x9f,
-	0xc5, 0x11, 0x8f, 0xf6, 0x07, 0x51, 0xc8, 0x69, 0xc8, 0xf5, 0xff, 0x2e, 0xce, 0x92, 0x9b, 0xb8,
-	0xa3, 0xcb, 0xe2, 0xf3, 0xae, 0x7a, 0xe1, 0xbc, 0x67, 0x20, 0x8f, 0xa2, 0x89, 0x1f, 0x8e, 0x24,
-	0xc2, 0xe9, 0xfc, 0x6c, 0x7f, 0xc6, 0x17, 0x33, 0xca, 0xf6, 0xe9, 0x74, 0xc6, 0x17, 0xf2, 0xaf,
-	0xc4, 0x71, 0x7e, 0xb6, 0x7a, 0x13, 0x0f, 0xa6, 0x94, 0x71, 0x7f, 0x3a, 0x4b, 0x9f, 0xe4, 0x66,
-	0xf7, 0x87, 0x50, 0x7f, 0x16, 0xc5, 0x9c, 0x10, 0xa8, 0x87, 0xfe, 0x94, 0xda, 0x95, 0xdd, 0xca,
-	0x9e, 0xe5, 0xe1, 0x33, 0xb9, 0x01, 0x35, 0x9f, 0x0d, 0xec, 0xea, 0x6e, 0x65, 0xaf, 0xe5, 0x89,
-	0x47, 0xf7, 0x1f, 0x15, 0x68, 0x1e, 0xfb, 0x23, 0x8f, 0xb2, 0x99, 0x78, 0xcb, 0xfd, 0x91, 0xda,
-	0x20, 0x1e, 0x05, 0x86, 0x10, 0x87, 0x1b, 0x2c, 0x0f, 0x9f, 0xc9, 0x9b, 0xd0, 0x98, 0x33, 0x7f,
-	0x44, 0xed, 0xda, 0x6e, 0x65, 0xaf, 0xee, 0xc9, 0x01, 0x79, 0x08, 0x30, 0x88, 0xa9, 0xcf, 0xe9,
-	0x71, 0x30, 0xa5, 0x76, 0x7d, 0xb7, 0xb2, 0xd7, 0x3e, 0x70, 0xba, 0xa3, 0x28, 0x1a, 0x4d, 0x68,
-	0x57, 0x2b, 0xdf, 0x3d, 0xd6, 0xba, 0x7a, 0xc6, 0x6a, 0xf2, 0x21, 0xb4, 0x27, 0x3e, 0xe3, 0x27,
-	0x4c, 0x6e, 0x6e, 0xac, 0xdc, 0x6c, 0x2e, 0x77, 0x7f, 0x03, 0xad, 0x63, 0x7f, 0xc4, 0xd0, 0x82,
-	0x7b, 0x00, 0x3c, 0xe2, 0xfe, 0xe4, 0x51, 0x34, 0x0f, 0x39, 0x1a, 0x52, 0xf7, 0x8c, 0x19, 0xf2,
-	0x23, 0x68, 0x04, 0x9c, 0x4e, 0x99, 0x5d, 0xdd, 0xad, 0xa1, 0x8c, 0x4b, 0x0e, 0xeb, 0x2a, 0x32,
-	0x3c, 0xb9, 0xd0, 0x3d, 0x00, 0x38, 0xf6, 0x47, 0x1f, 0x85, 0xc3, 0x63, 0x61, 0x7b, 0x29, 0x86,
-	0xdc, 0x7f, 0xb7, 0xa0, 0xd5, 0x0f, 0xcf, 0x22, 0x54, 0xe9, 0x16, 0x6c, 0x06, 0xe1, 0x59, 0xd4,
-	0xef, 0xa9, 0x5d, 0x6a, 0x24, 0xa0, 0xe6, 0xc1, 0x50, 0xed, 0x13, 0x8f, 0x62, 0xa5, 0x3f, 0xe7,
-	0xe3, 0x28, 0x46, 0x66, 0x2d, 0x4f, 0x8d, 0x04, 0xe1, 0x3c, 0xe0, 0x13, 0xc9, 0xaa, 0xe5, 0xc9,
-	0x01, 0xb1, 0xa1, 0xc9, 0xe6, 0xd3, 0xa9, 0x1f, 0x2f, 0x90, 0x30, 0xcb, 0xd3, 0x43, 0xf1, 0x66,
-	0x20, 0xac, 0x8d, 0x17, 0xf6, 0xa6, 0x7c, 0xa3, 0x86, 0x82, 0x68, 0x65, 0x26, 0x12, 0xdd, 0x5c,
-	0x4d, 0xb4, 0xb1, 0x9c, 0xbc, 0x80, 0xce, 0x20, 0x3a, 0xa7, 0xb1, 0x47, 0x59, 0x34, 0x8f, 0x07,
-	0x94, 0xd9, 0x2d, 0x64, 0x71, 0x3f, 0x87, 0x45, 0x6d, 0x7e, 0xf7, 0x51, 0x66, 0xc7, 0xa1, 0x50,
-	0xc3, 0xbb, 0x00, 0x23, 0xd4, 0x9a, 0xcd, 0x4f, 0x27, 0x01, 0x1b, 0xa3, 0x5a, 0xd6, 0x6a, 0xb5,
-	0x8c, 0xe5, 0xe4, 0x63, 0xe8, 0x88, 0x70, 0xf0, 0xe8, 0x79, 0x40, 0x5f, 0x21, 0x00, 0xac, 0x04,
-	0xb8, 0xb0, 0x43, 0xc4, 0xcd, 0x2b, 0x9f, 0x0f, 0xc6, 0x32, 0x6e, 0xda, 0x32, 0x6e, 0xd2, 0x19,
-	0xf2, 0x00, 0xea, 0xdc, 0x1f, 0x31, 0x7b, 0x0b, 0x0d, 0xbe, 0x9b, 0x1f, 0x36, 0x2a, 0x48, 0x3c,
-	0x5c, 0x4a, 0x1c, 0x68, 0xf1, 0xf1, 0x7c, 0x7a, 0x7a, 0x32, 0x63, 0xf6, 0x36, 0x02, 0x26, 0x63,
-	0x72, 0x07, 0xac, 0x80, 0x1d, 0xcb, 0x91, 0xdd, 0xc1, 0xc3, 0x98, 0x4e, 0x90, 0x5d, 0x68, 0xab,
-	0x95, 0xbf, 0x0c, 0x18, 0xb7, 0x77, 0x76, 0x6b, 0x7b, 0x96, 0x67, 0x4e, 0x61, 0x98, 0x8b, 0x61,
-	0x2f, 0x7a, 0x15, 0x32, 0xfb, 0x86, 0x0a, 0xf3, 0x64, 0x46, 0x20, 0x28, 0x38, 0x31, 0xb6, 0x6f,
-	0xa2, 0x04, 0x73, 0x8a, 0xdc, 0x87, 0xed, 0x64, 0x3d, 0x4a, 0x21, 0x28, 0x25, 0x3b, 0x29, 0xf4,
-	0x3c, 0xf3, 0xcf, 0xa3, 0x38, 0xe0, 0x94, 0xd9, 0x6f, 0xa0, 0x98, 0x74, 0x42, 0x68, 0x11, 0xb0,
-	0xc7, 0x6a, 0x68, 0xbf, 0x89, 0x42, 0x8c, 0x19, 0xe2, 0xc2, 0x96, 0x5e, 0x8c, 0x22, 0xbe, 0x85,
-	0x22, 0x32, 0x73, 0xda, 0x79, 0x9f, 0x45, 0xc3, 0xe0, 0x6c, 0x81, 0xce, 0xbb, 0x55, 0xce, 0x79,
-	0xe9, 0x0e, 0xa1, 0xe5, 0xc0, 0x0f, 0xa5, 0x37, 0xed, 0xb7, 0x24, 0x9b, 0xc9, 0x84, 0x38, 0x3d,
-	0xe7, 0xfe, 0x24, 0x18, 0xda, 0x36, 0xbe, 0x91, 0x03, 0xe1, 0x1d, 0x3f, 0x1e, 0x8c, 0x83, 0x73,
-	0x3a, 0xb4, 0xbf, 0x8d, 0x2f, 0x92, 0x31, 0xd9, 0x83, 0x9d, 0x89, 0xcf, 0x29, 0xe3, 0xcf, 0xe8,
-	0x68, 0x4a, 0x43, 0xde, 0xef, 0xd9, 0x0e, 0x9e, 0xa3, 0x8b, 0xd3, 0xc2, 0x42, 0x26, 0x07, 0x32,
-	0x70, 0x6e, 0x23, 0x45, 0x99, 0x39, 0xe7, 0x23, 0x78, 0x23, 0xe7, 0x0c, 0x88, 0xe3, 0xff, 0x92,
-	0x2e, 0x74, 0x26, 0x79, 0x49, 0x17, 0x4a, 0xd1, 0xb9, 0x4e, 0x25, 0x72, 0xf0, 0xb0, 0xfa, 0x41,
-	0xc5, 0xfd, 0x1d, 0x58, 0xe2, 0x3c, 0x95, 0x4b, 0x71, 0x0f, 0xb2, 0x29, 0xee, 0xf6, 0x92, 0xc3,
-	0xa9, 0x73, 0x1c, 0x83, 0xb6, 0xb2, 0x09, 0x25, 0x74, 0xa0, 0x1a, 0x0c, 0x95, 0x66, 0x55, 0x99,
-	0x97, 0x54, 0x06, 0xab, 0x66, 0x32, 0x58, 0x07, 0xaa, 0x61, 0xa4, 0xaa, 0x40, 0x35, 0x8c, 0x0a,
-	0xf2, 0xd4, 0x2d, 0xd8, 0x9c, 0xf8, 0xa7, 0x74, 0xc2, 0xec, 0x06, 0xfa, 0x5f, 0x8d, 0xdc, 0x21,
-	0x6c, 0x29, 0xa1, 0xe5, 0xec, 0x7a, 0x3f, 0x6b, 0xd7, 0xbd, 0x1c, 0xbb, 0x0c, 0x23, 0xb4, 0x69,
-	0x3e, 0x58, 0xcf, 0x05, 0x8f, 0xb9, 0x86, 0x75, 0xa1, 0x2e, 0x8a, 0x27, 0x9a, 0xb5, 0x3c, 0xe4,
-	0x70, 0x5d, 0xea, 0xa1, 0x9a, 0xe1, 0x21, 0xf7, 0x0f, 0x00, 0x28, 0xa2, 0x9c, 0x19, 0x07, 0x59,
-	0x33, 0xee, 0xe4, 0x98, 0x91, 0x28, 0xac, 0x8d, 0x78, 0x05, 0x70, 0x44, 0xb9, 0x2c, 0x72, 0x5f,
-	0x8a, 0x8a, 0x33, 0x13, 0xe5, 0x57, 0x62, 0xe3, 0xb3, 0x98, 0x63, 0xc1, 0x57, 0xd2, 0x92, 0xba,
-	0x87, 0xcf, 0x49, 0x65, 0xaa, 0x19, 0xb5, 0xfb, 0x5d, 0x68, 0xb0, 0x28, 0xe6, 0xcc, 0xae, 0xa3,
-	0xf4, 0xb7, 0xf2, 0x48, 0x8c, 0x62, 0xee, 0xc9, 0x55, 0xee, 0x6d, 0xb0, 0xa4, 0x60, 0x21, 0xf7,
-	0x02, 0x7b, 0xee, 0x1c, 0xe0, 0xa4, 0xdf, 0x7b, 0xea, 0x8f, 0xe8, 0x3a, 0x5a, 0x25, 0x1a, 0xd4,
-	0xca, 0x68, 0xa0, 0xab, 0x64, 0x3d, 0xa9, 0x92, 0xee, 0x7d, 0x00, 0x11, 0xbf, 0x4f, 0x42, 0x14,
-	0x5b, 0x50, 0x5d, 0xdd, 0xbf, 0xd7, 0xa0, 0xf3, 0x54, 0x16, 0x09, 0x19, 0xed, 0x5f, 0x6a, 0xa8,
-	0x4a, 0x5e, 0xc1, 0xad, 0xe6, 0x17, 0xdc, 0x5a, 0x41, 0xc1, 0xad, 0x17, 0x16, 0xdc, 0x46, 0xb6,
-	0xe0, 0xea, 0xba, 0xb1, 0x59, 0xbe, 0x6e, 0xfc, 0xf6, 0x52, 0x95, 0x6d, 0xe2, 0xe6, 0x1f, 0xe7,
-	0x6c, 0xce, 0x5a, 0x58, 0xaa, 0xd6, 0x66, 0x92, 0x65, 0xeb, 0x62, 0xb2, 0xbc, 0xd0, 0x20, 0x58,
-	0x6b, 0x35, 0x08, 0xd7, 0x91, 0xea, 0xbe, 0x07, 0x3b, 0x19, 0xa3, 0x8a, 0x1b, 0x28, 0xf7, 0x9f,
-	0x35, 0x68, 0x1f, 0x0e, 0x03, 0xae, 0xfd, 0xfb, 0x4d, 0x6a, 0xb4, 0xb4, 0xdf, 0x9b, 0xe5, 0xfd,
-	0xfe, 0x45, 0x41, 0x77, 0x75, 0x90, 0xb3, 0xd9, 0x30, 0x7b, 0x7d, 0xa7, 0x5b, 0x2b, 0x9c, 0x0e,
-	0xff, 0x77, 0xa7, 0xff, 0xb5, 0x02, 0x9d, 0x23, 0xca, 0xd3, 0x72, 0x50, 0x3e, 0x9d, 0xa4, 0x4e,
-	0xaf, 0x65, 0x9c, 0x9e, 0x56, 0x9d, 0xba, 0x59, 0x75, 0xd2, 0xf4, 0xd3, 0x28, 0x95, 0x00, 0xff,
-	0x5b, 0x81, 0x9b, 0x2a, 0x1e, 0x93, 0xe2, 0x52, 0x1c, 0x69, 0xb2, 0x20, 0x56, 0x2f, 0x17, 0xc4,
-	0x5a, 0x7e, 0x41, 0xcc, 0xaa, 0xf6, 0xa9, 0x88, 0x26, 0x54, 0x41, 0x29, 0xf7, 0xa0, 0xf8, 0xc4,
-	0xa7, 0xca, 0x74, 0x1f, 0xc9, 0x37, 0xd2, 0xf1, 0x1a, 0xc1, 0x79, 0x08, 0x5b, 0xe6, 0x8b, 0xb5,
-	0xdc, 0xf1, 0x7d, 0x20, 0x17, 0xc5, 0xb0, 0x99, 0x58, 0xcf, 0xe8, 0x28, 0xb1, 0x59, 0x0e, 0xdc,
-	0x3f, 0x55, 0xa1, 0x23, 0xa2, 0xb1, 0x04, 0x3b, 0x09, 0x40, 0xd5, 0x00, 0xb8, 0x5a, 0x13, 0x41,
-	0x3e, 0x49, 0x39, 0x93, 0x29, 0xb6, 0x5b, 0x70, 0x5a, 0x5e, 0x2b, 0x61, 0x3f, 0x87, 0x6d, 0x85,
-	0xbf, 0xbc, 0x2a, 0xe5, 0x53, 0xe0, 0xfe, 0xa5, 0x02, 0x5b, 0x47, 0x94, 0xeb, 0x26, 0xe2, 0xea,
-	0xc1, 0x9f, 0x88, 0xa9, 0x9b, 0x4c, 0xaf, 0x19, 0xfa, 0x7f, 0xae, 0x40, 0xa7, 0x1f, 0x32, 0x1a,
-	0x73, 0xd5, 0x8f, 0x94, 0x30, 0xab, 0x66, 0xca, 0xd3, 0xdd, 0x55, 0x7d, 0xdd, 0xee, 0xaa, 0x61,
-	0x76, 0x57, 0x3f, 0x80, 0x9d, 0x8c, 0x16, 0x6c, 0x26, 0xd2, 0x2e, 0xbe, 0x4b, 0xf4, 0xd0, 0x43,
-	0xf7, 0x6f, 0x15, 0xd8, 0x12, 0xde, 0xfe, 0x9a, 0x1a, 0x4b, 0x0d, 0x52, 0xde, 0x70, 0x90, 0xd8,
-	0xd1, 0x58, 0xd7, 0x8e, 0x4d, 0xd3, 0x8e, 0xcf, 0xa1, 0x8d, 0x5a, 0x95, 0x8d, 0x90, 0xd5, 0x8a,
-	0xb9, 0x3f, 0xc1, 0xb6, 0x70, 0x69, 0x7b, 0x93, 0xd7, 0xb7, 0xbb, 0xff, 0xaa, 0x42, 0x5b, 0x6d,
-	0x64, 0xf9, 0x3b, 0x75, 0x00, 0x56, 0x73, 0x02, 0xb0, 0x96, 0xd7, 0xcc, 0x95, 0x6a, 0x27, 0xd3,
-	0xb3, 0xdd, 0x30, 0xcf, 0xf6, 0xd7, 0xe8, 0x91, 0x8c, 0xc2, 0xdb, 0xcc, 0x16, 0xde, 0x0f, 0xc0,
-	0x62, 0xdc, 0x8f, 0x65, 0x25, 0x6b, 0xad, 0xf4, 0x58, 0xba, 0x98, 0xbc, 0x0f, 0x4d, 0x1a, 0x0e,
-	0x4b, 0xb6, 0x3d, 0x7a, 0xa9, 0xfb, 0x21, 0xdc, 0xe8, 0x23, 0xab, 0x2f, 0x02, 0x3e, 0x3e, 0xe9,
-	0xf7, 0xd6, 0xf3, 0xc4, 0x1f, 0x61, 0x5b, 0xee, 0xd6, 0x5d, 0x74, 0x51, 0x58, 0xbc, 0x1e, 0x87,
-	0xb8, 0x4f, 0xa0, 0x7d, 0x22, 0x55, 0x47, 0x0a, 0x2e, 0x2b, 0xbe, 0xe6, 0x0d, 0xc9, 0x7d, 0x04,
-	0xed, 0x13, 0x46, 0xe3, 0x7e, 0x4f, 0x5e, 0x86, 0x92, 0x3b, 0x5b, 0xa5, 0xf0, 0xce, 0x66, 0xc8,
-	0xd7, 0xd7, 0x9d, 0x5f, 0x8b, 0xc4, 0xa3, 0x39, 0x45, 0xc5, 0x34, 0x2d, 0xc3, 0x0c, 0x2d, 0xeb,
-	0xab, 0xf7, 0x18, 0xda, 0x12, 0x59, 0xaa, 0xf7, 0xd3, 0xac, 0x7a, 0xef, 0x14, 0x5c, 0x95, 0x2f,
-	0x69, 0x78, 0xf0, 0x1f, 0x02, 0x4d, 0x55, 0x2d, 0x48, 0x0f, 0x36, 0xe5, 0x1d, 0x89, 0xe4, 0xdd,
-	0xe5, 0x92, 0xeb, 0x93, 0xb3, 0xe4, 0x5b, 0xa3, 0xbb, 0x41, 0x8e, 0xa0, 0xa9, 0xae, 0x78, 0xe4,
-	0x6e, 0x21, 0x8c, 0x38, 0xad, 0xce, 0xed, 0x7c, 0x1c, 0x96, 0x01, 0x12, 0x6a, 0x17, 0x01, 0xa9,
-	0x84, 0xe1, 0x2c, 0xfb, 0x32, 0xe0, 0x6e, 0x90, 0x5f, 0x40, 0x4b, 0x27, 0x09, 0x72, 0xaf, 0x18,
-	0x09, 0x75, 0xba, 0x53, 0x00, 0xa5, 0x95, 0x7a, 0x0e, 0x6d, 0xa3, 0xab, 0x27, 0xef, 0xac, 0xbc,
-	0xca, 0x38, 0xee, 0xaa, 0x25, 0x88, 0xdb, 0x83, 0x96, 0x6e, 0x85, 0x73, 0x75, 0x34, 0xfa, 0x64,
-	0xe7, 0xd6, 0xa5, 0xe8, 0x38, 0x9c, 0xce, 0xf8, 0xc2, 0xdd, 0x20, 0x87, 0x00, 0x3d, 0x3a, 0xa1,
-	0x9c, 0x16, 0xb2, 0x96, 0x5e, 0x38, 0x97, 0xc0, 0xf4, 0xc0, 0x7a, 0xe1, 0xf3, 0xc1, 0xf8, 0x6a,
-	0x28, 0xcf, 0x30, 0x37, 0xeb, 0x56, 0x38, 0x97, 0xaa, 0x6c, 0xab, 0xec, 0xbc, 0x5d, 0xfc, 0x25,
-	0x44, 0xf3, 0xff, 0x14, 0x2b, 0x85, 0x9a, 0x24, 0xbb, 0xc5, 0x1b, 0x94, 0x7a, 0x2b, 0x3e, 0xae,
-	0xb8, 0x1b, 0xe4, 0xf7, 0xc9, 0xf5, 0x5a, 0xa3, 0xde, 0x2f, 0xd3, 0xad, 0x3a, 0xdf, 0x2d, 0xb1,
-	0x4a, 0x85, 0x5f, 0xdb, 0xe8, 0xdb, 0x72, 0x79, 0xc8, 0xf6, 0x75, 0x4b, 0x38, 0xfd, 0x14, 0xb6,
-	0xa5, 0x83, 0xcb, 0x33, 0x50, 0x0c, 0xf6, 0x19, 0x7e, 0x13, 0x91, 0xcd, 0x1a, 0x79, 0x3b, 0xdf,
-	0x3d, 0x49, 0x2b, 0xe7, 0xdc, 0x2d, 0xfa, 0xbe, 0xc3, 0x32, 0xc7, 0x0c, 0xa7, 0x72, 0x43, 0xd8,
-	0x68, 0x1a, 0x9c, 0xa5, 0x1f, 0x8b, 0xe4, 0x31, 0x33, 0x7a, 0x25, 0x92, 0x9f, 0xcf, 0xcc, 0x8e,
-	0x2e, 0xf7, 0x98, 0x5d, 0x68, 0xb7, 0xdc, 0x0d, 0xf2, 0x18, 0xac, 0xa4, 0xab, 0xca, 0x35, 0xd9,
-	0xec, 0xb9, 0x96, 0x50, 0x77, 0x04, 0x6d, 0xe9, 0x87, 0x72, 0xe6, 0x16, 0x03, 0x7d, 0x02, 0x4d,
-	0xfd, 0xb1, 0xfc, 0x3b, 0x4b, 0x93, 0xb6, 0xac, 0xc8, 0x4b, 0x90, 0x7e, 0xa5, 0x43, 0xe3, 0x9a,
-	0xf0, 0x3e, 0xc7, 0x9b, 0xac, 0xa8, 0x81, 0x1a, 0xf0, 0x6e, 0x7e, 0xd1, 0x53, 0x15, 0x3f, 0xf7,
-	0xa8, 0x19, 0x35, 0xca, 0xdd, 0x20, 0xc7, 0x08, 0x29, 0xe6, 0x92, 0x1f, 0x08, 0x0a, 0xf7, 0x2c,
-	0x43, 0x35, 0x0a, 0x33, 0xc6, 0x9d, 0x95, 0xfe, 0x1a, 0x70, 0x45, 0xa3, 0x9f, 0xc2, 0x8e, 0x41,
-	0xe2, 0x75, 0x20, 0x3e, 0x83, 0x1b, 0x26, 0x8d, 0x08, 0x79, 0x65, 0x22, 0x9f, 0x23, 0x68, 0x42,
-	0x24, 0x82, 0x5e, 0x07, 0x95, 0x7d, 0x68, 0x25, 0xbf, 0x79, 0x5c, 0xd1, 0xee, 0x27, 0xd0, 0x91,
-	0x4c, 0x5e, 0x17, 0xa0, 0x07, 0x3b, 0x8a, 0xc8, 0x04, 0xf1, 0xca, 0x3c, 0x9e, 0x20, 0xa6, 0x98,
-	0x4b, 0x30, 0xaf, 0x81, 0xc6, 0x8f, 0xad, 0x2f, 0xf4, 0x65, 0xfc, 0x74, 0x13, 0xed, 0x78, 0xef,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x1a, 0xba, 0x62, 0x97, 0x1f, 0x00, 0x00,
+	proto.RegisterFile("teddy-backend/internal/proto/content/content.proto", fileDescriptor_content_e1257a5c9f255750)
+}
+
+var fileDescriptor_content_e1257a5c9f255750 = []byte{
+	// 1965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x73, 0xdb, 0xc6,
+	0x15, 0x16, 0xc1, 0x2b, 0x0e, 0x25, 0xca, 0x5e, 0xa7, 0x0e, 0x02, 0xdb, 0x32, 0x83, 0xba, 0x33,
+	0xea, 0x25, 0x54, 0xad, 0xa4, 0x6d, 0xc6, 0x4d, 0xa6, 0xe3, 0x88, 0xb2, 0xc2, 0xa6, 0xa9, 0x14,
+	0x88, 0xb2, 0x3b, 0x99, 0xde, 0x20, 0x72, 0x45, 0x61, 0x4c, 0x02, 0x0c, 0x76, 0x29, 0x95, 0x7d,
+	0xe8, 0x43, 0x27, 0xff, 0xa0, 0x0f, 0xed, 0x1f, 0xe9, 0x73, 0x1f, 0x3b, 0x7d, 0xe8, 0x0f, 0xe9,
+	0x6b, 0x7f, 0x41, 0x67, 0x6f, 0xc0, 0x42, 0x02, 0x48, 0x30, 0x52, 0x3a, 0x79, 0x91, 0xb0, 0x97,
+	0xf3, 0x9d, 0xeb, 0x9e, 0x73, 0x76, 0x09, 0xbb, 0x14, 0x0f, 0x87, 0xf3, 0x77, 0x4e, 0xbd, 0xc1,
+	0x6b, 0x1c, 0x0c, 0x77, 0xfc, 0x80, 0xe2, 0x28, 0xf0, 0xc6, 0x3b, 0xd3, 0x28, 0xa4, 0xe1, 0xce,
+	0x20, 0x0c, 0x28, 0x0e, 0xa8, 0xfa, 0xdf, 0xe1, 0xb3, 0xe8, 0x2e, 0xa7, 0xe9, 0x90, 0xe8, 0xa2,
+	0x23, 0x17, 0xec, 0x77, 0x47, 0x3e, 0x3d, 0x9f, 0x9d, 0x76, 0x06, 0xe1, 0x64, 0x67, 0x14, 0x8e,
+	0xbd, 0x60, 0x24, 0x10, 0x4e, 0x67, 0x67, 0x3b, 0x53, 0x3a, 0x9f, 0x62, 0xb2, 0x83, 0x27, 0x53,
+	0x3a, 0x17, 0x7f, 0x05, 0x8e, 0xfd, 0xd3, 0xe5, 0x44, 0xd4, 0x9f, 0x60, 0x42, 0xbd, 0xc9, 0x34,
+	0xf9, 0x12, 0xc4, 0xce, 0x0f, 0xa0, 0x72, 0x1c, 0x46, 0x14, 0x21, 0xa8, 0x04, 0xde, 0x04, 0x5b,
+	0xa5, 0x76, 0x69, 0xdb, 0x74, 0xf9, 0x37, 0xba, 0x03, 0x65, 0x8f, 0x0c, 0x2c, 0xa3, 0x5d, 0xda,
+	0x6e, 0xb8, 0xec, 0xd3, 0xf9, 0x47, 0x09, 0xea, 0x7d, 0x6f, 0xe4, 0x62, 0x32, 0x65, 0xab, 0xd4,
+	0x1b, 0x49, 0x02, 0xf6, 0xc9, 0x30, 0x18, 0x3b, 0x4e, 0x60, 0xba, 0xfc, 0x1b, 0xbd, 0x01, 0xd5,
+	0x19, 0xf1, 0x46, 0xd8, 0x2a, 0xb7, 0x4b, 0xdb, 0x15, 0x57, 0x0c, 0xd0, 0x33, 0x80, 0x41, 0x84,
+	0x3d, 0x8a, 0xfb, 0xfe, 0x04, 0x5b, 0x95, 0x76, 0x69, 0xbb, 0xb9, 0x6b, 0x77, 0x46, 0x61, 0x38,
+	0x1a, 0xe3, 0x8e, 0x12, 0xbe, 0xd3, 0x57, 0xb2, 0xba, 0xda, 0x6e, 0xf4, 0x01, 0x34, 0xc7, 0x1e,
+	0xa1, 0x27, 0x44, 0x10, 0x57, 0x97, 0x12, 0xeb, 0xdb, 0x9d, 0x5f, 0x43, 0xa3, 0xef, 0x8d, 0x08,
+	0xd7, 0x60, 0x0b, 0x80, 0x86, 0xd4, 0x1b, 0xef, 0x85, 0xb3, 0x80, 0x72, 0x45, 0x2a, 0xae, 0x36,
+	0x83, 0x7e, 0x08, 0x55, 0x9f, 0xe2, 0x09, 0xb1, 0x8c, 0x76, 0x99, 0xf3, 0xb8, 0xe6, 0xb0, 0x8e,
+	0x34, 0x86, 0x2b, 0x36, 0x3a, 0xbb, 0x00, 0x7d, 0x6f, 0xf4, 0x3c, 0x18, 0xf6, 0x99, 0xee, 0x85,
+	0x2c, 0xe4, 0xfc, 0xbb, 0x01, 0x8d, 0x5e, 0x70, 0x16, 0x72, 0x91, 0xee, 0x43, 0xcd, 0x0f, 0xce,
+	0xc2, 0x5e, 0x57, 0x52, 0xc9, 0x11, 0x83, 0x9a, 0xf9, 0x43, 0x49, 0xc7, 0x3e, 0xd9, 0x4e, 0x6f,
+	0x46, 0xcf, 0xc3, 0x88, 0x5b, 0xd6, 0x74, 0xe5, 0x88, 0x19, 0x9c, 0xfa, 0x74, 0x2c, 0xac, 0x6a,
+	0xba, 0x62, 0x80, 0x2c, 0xa8, 0x93, 0xd9, 0x64, 0xe2, 0x45, 0x73, 0x6e, 0x30, 0xd3, 0x55, 0x43,
+	0xb6, 0x32, 0x60, 0xda, 0x46, 0x73, 0xab, 0x26, 0x56, 0xe4, 0x90, 0x19, 0x5a, 0xaa, 0xc9, 0x0d,
+	0x5d, 0x5f, 0x6e, 0x68, 0x6d, 0x3b, 0x7a, 0x05, 0xad, 0x41, 0x78, 0x81, 0x23, 0x17, 0x93, 0x70,
+	0x16, 0x0d, 0x30, 0xb1, 0x1a, 0xdc, 0x8a, 0x3b, 0x19, 0x56, 0x54, 0xea, 0x77, 0xf6, 0x52, 0x14,
+	0xfb, 0x4c, 0x0c, 0xf7, 0x0a, 0x0c, 0x13, 0x6b, 0x3a, 0x3b, 0x1d, 0xfb, 0xe4, 0x9c, 0x8b, 0x65,
+	0x2e, 0x17, 0x4b, 0xdb, 0x8e, 0x3e, 0x82, 0x16, 0x0b, 0x07, 0x17, 0x5f, 0xf8, 0xf8, 0x92, 0x03,
+	0xc0, 0x52, 0x80, 0x2b, 0x14, 0x2c, 0x6e, 0x2e, 0x3d, 0x3a, 0x38, 0x17, 0x71, 0xd3, 0x14, 0x71,
+	0x93, 0xcc, 0xa0, 0xa7, 0x50, 0xa1, 0xde, 0x88, 0x58, 0xeb, 0x5c, 0xe1, 0x47, 0xd9, 0x61, 0x23,
+	0x83, 0xc4, 0xe5, 0x5b, 0x91, 0x0d, 0x0d, 0x7a, 0x3e, 0x9b, 0x9c, 0x9e, 0x4c, 0x89, 0xb5, 0xc1,
+	0x01, 0xe3, 0x31, 0x7a, 0x08, 0xa6, 0x4f, 0xfa, 0x62, 0x64, 0xb5, 0xf8, 0x61, 0x4c, 0x26, 0x50,
+	0x1b, 0x9a, 0x72, 0xe7, 0x2f, 0x7c, 0x42, 0xad, 0xcd, 0x76, 0x79, 0xdb, 0x74, 0xf5, 0x29, 0x1e,
+	0xe6, 0x6c, 0xd8, 0x0d, 0x2f, 0x03, 0x62, 0xdd, 0x91, 0x61, 0x1e, 0xcf, 0x30, 0x04, 0x09, 0xc7,
+	0xc6, 0xd6, 0x5d, 0xce, 0x41, 0x9f, 0x42, 0x4f, 0x60, 0x23, 0xde, 0xcf, 0xb9, 0x20, 0xce, 0x25,
+	0x3d, 0xc9, 0xe4, 0x3c, 0xf3, 0x2e, 0xc2, 0xc8, 0xa7, 0x98, 0x58, 0xf7, 0x38, 0x9b, 0x64, 0x82,
+	0x49, 0xe1, 0x93, 0x17, 0x72, 0x68, 0xbd, 0xc1, 0x99, 0x68, 0x33, 0xc8, 0x81, 0x75, 0xb5, 0x99,
+	0xb3, 0xf8, 0x16, 0x67, 0x91, 0x9a, 0x53, 0xce, 0xfb, 0x34, 0x1c, 0xfa, 0x67, 0x73, 0xee, 0xbc,
+	0xfb, 0xc5, 0x9c, 0x97, 0x50, 0x30, 0x29, 0x07, 0x5e, 0x20, 0xbc, 0x69, 0xbd, 0x29, 0xac, 0x19,
+	0x4f, 0xb0, 0xd3, 0x73, 0xe1, 0x8d, 0xfd, 0xa1, 0x65, 0xf1, 0x15, 0x31, 0x60, 0xde, 0xf1, 0xa2,
+	0xc1, 0xb9, 0x7f, 0x81, 0x87, 0xd6, 0x5b, 0x7c, 0x21, 0x1e, 0xa3, 0x6d, 0xd8, 0x1c, 0x7b, 0x14,
+	0x13, 0x7a, 0x8c, 0x47, 0x13, 0x1c, 0xd0, 0x5e, 0xd7, 0xb2, 0xf9, 0x39, 0xba, 0x3a, 0xcd, 0x34,
+	0x24, 0x62, 0x20, 0x02, 0xe7, 0x01, 0x37, 0x51, 0x6a, 0xce, 0x7e, 0x0e, 0xf7, 0x32, 0xce, 0x00,
+	0x3b, 0xfe, 0xaf, 0xf1, 0x5c, 0x65, 0x92, 0xd7, 0x78, 0x2e, 0x05, 0x9d, 0xa9, 0x54, 0x22, 0x06,
+	0xcf, 0x8c, 0xf7, 0x4b, 0xce, 0x6f, 0xc1, 0x64, 0xe7, 0xa9, 0x58, 0x8a, 0x7b, 0x9a, 0x4e, 0x71,
+	0x0f, 0x16, 0x1c, 0x4e, 0x95, 0xe3, 0x08, 0x34, 0xa5, 0x4e, 0x9c, 0x43, 0x0b, 0x0c, 0x7f, 0x28,
+	0x25, 0x33, 0x44, 0x5e, 0x92, 0x19, 0xcc, 0x48, 0x65, 0xb0, 0x16, 0x18, 0x41, 0x28, 0xab, 0x80,
+	0x11, 0x84, 0x39, 0x79, 0xea, 0x3e, 0xd4, 0xc6, 0xde, 0x29, 0x1e, 0x13, 0xab, 0xca, 0xfd, 0x2f,
+	0x47, 0xce, 0x10, 0xd6, 0x25, 0xd3, 0x62, 0x7a, 0xbd, 0x97, 0xd6, 0x6b, 0x2b, 0x43, 0x2f, 0x4d,
+	0x09, 0xa5, 0x9a, 0x07, 0xe6, 0x4b, 0x66, 0xc7, 0x4c, 0xc5, 0x3a, 0x50, 0x61, 0xc5, 0xd3, 0x32,
+	0x96, 0x86, 0x1c, 0xdf, 0x97, 0x78, 0xa8, 0xac, 0x79, 0xc8, 0xf9, 0x3d, 0x00, 0x67, 0x51, 0x4c,
+	0x8d, 0xdd, 0xb4, 0x1a, 0x0f, 0x33, 0xd4, 0x88, 0x05, 0x56, 0x4a, 0x5c, 0x02, 0x1c, 0x60, 0x2a,
+	0x8a, 0xdc, 0x17, 0xac, 0xe2, 0x4c, 0x59, 0xf9, 0x15, 0xd8, 0xfc, 0x9b, 0xcd, 0x11, 0xff, 0x8f,
+	0x42, 0x93, 0x8a, 0xcb, 0xbf, 0xe3, 0xca, 0x54, 0xd6, 0x6a, 0xf7, 0x3b, 0x50, 0x25, 0x61, 0x44,
+	0x89, 0x55, 0xe1, 0xdc, 0xdf, 0xcc, 0x32, 0x62, 0x18, 0x51, 0x57, 0xec, 0x72, 0x1e, 0x80, 0x29,
+	0x18, 0x33, 0xbe, 0x57, 0xac, 0xe7, 0xcc, 0x00, 0x4e, 0x7a, 0xdd, 0x23, 0x6f, 0x84, 0x57, 0x91,
+	0x2a, 0x96, 0xa0, 0x5c, 0x44, 0x02, 0x55, 0x25, 0x2b, 0x71, 0x95, 0x74, 0x9e, 0x00, 0xb0, 0xf8,
+	0x3d, 0x0c, 0x38, 0xdb, 0x9c, 0xea, 0xea, 0xfc, 0xbd, 0x0c, 0xad, 0x23, 0x51, 0x24, 0x44, 0xb4,
+	0x7f, 0xa1, 0xa0, 0x4a, 0x59, 0x05, 0xd7, 0xc8, 0x2e, 0xb8, 0xe5, 0x9c, 0x82, 0x5b, 0xc9, 0x2d,
+	0xb8, 0xd5, 0x74, 0xc1, 0x55, 0x75, 0xa3, 0x56, 0xbc, 0x6e, 0xfc, 0xe6, 0x5a, 0x95, 0xad, 0x73,
+	0xe2, 0x1f, 0x65, 0x10, 0xa7, 0x35, 0x2c, 0x54, 0x6b, 0x53, 0xc9, 0xb2, 0x71, 0x35, 0x59, 0x5e,
+	0x69, 0x10, 0xcc, 0x95, 0x1a, 0x84, 0xdb, 0x48, 0x75, 0xdf, 0x85, 0xcd, 0x94, 0x52, 0xf9, 0x0d,
+	0x94, 0xf3, 0xcf, 0x32, 0x34, 0xf7, 0x87, 0x3e, 0x55, 0xfe, 0xfd, 0x26, 0x35, 0x5a, 0xca, 0xef,
+	0xf5, 0xe2, 0x7e, 0xff, 0x3c, 0xa7, 0xbb, 0xda, 0xcd, 0x20, 0xd6, 0xd4, 0x5e, 0xdd, 0xe9, 0xe6,
+	0x12, 0xa7, 0xc3, 0xff, 0xdd, 0xe9, 0x7f, 0x2d, 0x41, 0xeb, 0x00, 0xd3, 0xa4, 0x1c, 0x14, 0x4f,
+	0x27, 0x89, 0xd3, 0xcb, 0x29, 0xa7, 0x27, 0x55, 0xa7, 0xa2, 0x57, 0x9d, 0x24, 0xfd, 0x54, 0x0b,
+	0x25, 0xc0, 0xff, 0x96, 0xe0, 0xae, 0x8c, 0xc7, 0xb8, 0xb8, 0xe4, 0x47, 0x9a, 0x28, 0x88, 0xc6,
+	0xf5, 0x82, 0x58, 0xce, 0x2e, 0x88, 0x69, 0xd1, 0x3e, 0x81, 0xba, 0x14, 0x41, 0x0a, 0xf7, 0x34,
+	0xff, 0xc4, 0x27, 0xc2, 0x74, 0xf6, 0xc4, 0x8a, 0x70, 0xbc, 0x42, 0xb0, 0x9f, 0xc1, 0xba, 0xbe,
+	0xb0, 0x92, 0x3b, 0xbe, 0x07, 0xe8, 0x2a, 0x1b, 0x32, 0x65, 0xfb, 0x09, 0x1e, 0xc5, 0x3a, 0x8b,
+	0x81, 0xf3, 0x67, 0x03, 0x5a, 0x2c, 0x1a, 0x0b, 0x58, 0x27, 0x06, 0x30, 0x34, 0x80, 0x9b, 0x35,
+	0x11, 0xe8, 0xe3, 0xc4, 0x66, 0x22, 0xc5, 0x76, 0x72, 0x4e, 0xcb, 0xd7, 0x6a, 0xb0, 0x0f, 0x61,
+	0x43, 0xe2, 0x2f, 0xae, 0x4a, 0xd9, 0x26, 0x70, 0xfe, 0x52, 0x82, 0xf5, 0x03, 0x4c, 0x55, 0x13,
+	0x71, 0xf3, 0xe0, 0x8f, 0xd9, 0x54, 0x74, 0x4b, 0xaf, 0x18, 0xfa, 0x5f, 0x96, 0xa0, 0xd5, 0x0b,
+	0x08, 0x8e, 0xa8, 0xec, 0x47, 0x0a, 0xa8, 0x55, 0xd6, 0xf9, 0xa9, 0xee, 0xaa, 0xb2, 0x6a, 0x77,
+	0x55, 0xd5, 0xbb, 0xab, 0xef, 0xc3, 0x66, 0x4a, 0x0a, 0x32, 0x65, 0x69, 0x97, 0xaf, 0xc5, 0x72,
+	0xa8, 0xa1, 0xf3, 0xb7, 0x12, 0xac, 0x33, 0x6f, 0x7f, 0x45, 0x89, 0x85, 0x04, 0x89, 0xdd, 0xf8,
+	0x20, 0xd6, 0xa3, 0xba, 0xaa, 0x1e, 0x35, 0x5d, 0x8f, 0xcf, 0xa0, 0xc9, 0xa5, 0x3a, 0x0c, 0x6e,
+	0x4b, 0x30, 0xe7, 0xc7, 0xbc, 0x2d, 0x5c, 0xd8, 0xde, 0x64, 0xf5, 0xed, 0xce, 0xbf, 0x0c, 0x68,
+	0x4a, 0x42, 0x92, 0x4d, 0xa9, 0x02, 0xd0, 0xc8, 0x08, 0xc0, 0x72, 0x56, 0x33, 0x57, 0xa8, 0x9d,
+	0x4c, 0xce, 0x76, 0x55, 0x3f, 0xdb, 0x5f, 0xa1, 0x47, 0xd2, 0x0a, 0x6f, 0x3d, 0x5d, 0x78, 0xdf,
+	0x07, 0x93, 0x50, 0x2f, 0x12, 0x95, 0xac, 0xb1, 0xd4, 0x63, 0xc9, 0x66, 0xf4, 0x1e, 0xd4, 0x71,
+	0x30, 0x2c, 0xd8, 0xf6, 0xa8, 0xad, 0xce, 0x07, 0x70, 0xa7, 0xc7, 0xad, 0xfa, 0xca, 0xa7, 0xe7,
+	0x27, 0xbd, 0xee, 0x6a, 0x9e, 0xf8, 0x13, 0x6c, 0x08, 0x6a, 0xd5, 0x45, 0xe7, 0x85, 0xc5, 0xd7,
+	0xe3, 0x10, 0xe7, 0x10, 0x9a, 0x27, 0x42, 0x74, 0x6e, 0x82, 0xeb, 0x82, 0xaf, 0x78, 0x43, 0x72,
+	0xf6, 0xa0, 0x79, 0x42, 0x70, 0xd4, 0xeb, 0x8a, 0xcb, 0x50, 0x7c, 0x67, 0x2b, 0xe5, 0xde, 0xd9,
+	0x34, 0xfe, 0xea, 0xba, 0xf3, 0x2b, 0x96, 0x78, 0xce, 0xc2, 0x64, 0x21, 0x36, 0xcb, 0x30, 0x65,
+	0x96, 0xd5, 0xc5, 0x7b, 0x01, 0x4d, 0x81, 0x2c, 0xc4, 0xfb, 0x49, 0x5a, 0xbc, 0xb7, 0x73, 0xae,
+	0xca, 0xd7, 0x25, 0xb4, 0xa1, 0x96, 0xe7, 0x6b, 0xe7, 0x4b, 0x03, 0xd6, 0x99, 0x0d, 0xba, 0x1e,
+	0xf5, 0x38, 0x17, 0x76, 0x21, 0x67, 0x47, 0xcd, 0x2a, 0x15, 0xb9, 0x90, 0xb3, 0x9d, 0xe8, 0x43,
+	0xed, 0xed, 0xc8, 0x28, 0x2a, 0x5b, 0x4c, 0x82, 0x9e, 0xa7, 0x9e, 0x87, 0xca, 0x45, 0x01, 0x34,
+	0x22, 0xf4, 0x33, 0xfd, 0xe5, 0xa7, 0x52, 0x14, 0x21, 0xa1, 0xd9, 0xfd, 0xcf, 0x3d, 0xa8, 0xcb,
+	0x82, 0x8a, 0xba, 0x50, 0x13, 0xd7, 0x48, 0x94, 0x75, 0xdd, 0x8d, 0x6f, 0x98, 0xf6, 0x82, 0xe7,
+	0x58, 0x67, 0x0d, 0x1d, 0x40, 0x5d, 0x6c, 0x25, 0xe8, 0x51, 0x2e, 0x0c, 0x4b, 0x68, 0xf6, 0x83,
+	0x6c, 0x1c, 0x92, 0x02, 0x62, 0xa2, 0xe7, 0x01, 0xc9, 0x9c, 0x6a, 0x2f, 0xf2, 0x95, 0xb3, 0x86,
+	0x7e, 0x0e, 0x0d, 0xb9, 0x99, 0xa0, 0xad, 0x7c, 0x24, 0x2e, 0xd3, 0xc3, 0x1c, 0x28, 0x25, 0xd4,
+	0x4b, 0x68, 0x6a, 0x17, 0x1f, 0xf4, 0xf6, 0xd2, 0xdb, 0x9e, 0xed, 0x2c, 0xdb, 0xc2, 0x71, 0xbb,
+	0xd0, 0x50, 0xb7, 0x85, 0x4c, 0x19, 0xb5, 0xab, 0x84, 0x7d, 0xff, 0xda, 0x01, 0xda, 0x67, 0x3f,
+	0x4a, 0x38, 0x6b, 0x68, 0x1f, 0xa0, 0x8b, 0xc7, 0x98, 0xe2, 0x5c, 0xab, 0x25, 0x77, 0xf2, 0x05,
+	0x30, 0x5d, 0x30, 0x5f, 0xb1, 0x47, 0xd5, 0x9b, 0xa1, 0x1c, 0xf3, 0xf2, 0xa5, 0x6e, 0x0b, 0x99,
+	0xa6, 0x4a, 0xdf, 0x26, 0xec, 0xc7, 0xf9, 0x8f, 0x45, 0xca, 0xfe, 0x47, 0xbc, 0x98, 0xca, 0x49,
+	0xd4, 0xce, 0x27, 0x90, 0xe2, 0x2d, 0x79, 0x7f, 0x72, 0xd6, 0xd0, 0xef, 0xe2, 0x17, 0x08, 0x85,
+	0xfa, 0xa4, 0x48, 0x43, 0x6f, 0x7f, 0xa7, 0xc0, 0x2e, 0x19, 0x7e, 0x4d, 0xad, 0xb5, 0xcd, 0xb4,
+	0x43, 0xba, 0xf5, 0x5d, 0x60, 0xd3, 0x4f, 0x60, 0x43, 0x38, 0xb8, 0xb8, 0x05, 0xf2, 0xc1, 0x3e,
+	0xe5, 0xcf, 0x46, 0xa2, 0x9f, 0x45, 0x8f, 0xb3, 0xdd, 0x13, 0x77, 0xbb, 0xf6, 0xa3, 0xbc, 0x27,
+	0x30, 0x92, 0x3a, 0x66, 0x7c, 0x2a, 0x33, 0x84, 0xb5, 0xbe, 0xca, 0x5e, 0xf8, 0x9e, 0x26, 0x8e,
+	0x99, 0xd6, 0x4e, 0xa2, 0xec, 0x9c, 0xa6, 0x37, 0xbd, 0xb6, 0xb3, 0x6c, 0x0b, 0xc7, 0x7d, 0x01,
+	0x66, 0xdc, 0x78, 0x66, 0xaa, 0xac, 0xb7, 0xa5, 0x0b, 0x4c, 0x77, 0x00, 0x4d, 0xe1, 0x87, 0x62,
+	0xea, 0xe6, 0x03, 0x7d, 0x0c, 0x75, 0xf5, 0x7b, 0xc2, 0xb7, 0x17, 0x26, 0x6e, 0x51, 0xc8, 0x16,
+	0x20, 0xfd, 0x52, 0x85, 0xc6, 0x2d, 0xe1, 0x7d, 0xc6, 0x2f, 0xfb, 0xac, 0x44, 0x2a, 0xc0, 0x47,
+	0xd9, 0x7d, 0x81, 0x6c, 0x8a, 0xec, 0xad, 0x5c, 0x7e, 0x2a, 0x42, 0xfa, 0x1c, 0x92, 0xcd, 0x29,
+	0xc8, 0x76, 0x2e, 0xcd, 0x22, 0x54, 0xad, 0x77, 0xe1, 0x71, 0x67, 0x26, 0x3f, 0x98, 0xdc, 0x50,
+	0xe9, 0x23, 0xd8, 0xd4, 0x8c, 0x78, 0x1b, 0x88, 0xc7, 0x70, 0x47, 0x37, 0x23, 0x87, 0xbc, 0xb1,
+	0x21, 0x5f, 0x72, 0xd0, 0xd8, 0x90, 0x1c, 0xf4, 0x36, 0x4c, 0xd9, 0x83, 0x46, 0xfc, 0xb3, 0xd0,
+	0x0d, 0xf5, 0x3e, 0x84, 0x96, 0xb0, 0xe4, 0x6d, 0x01, 0xba, 0xb0, 0x29, 0x0d, 0x19, 0x23, 0xde,
+	0xd8, 0x8e, 0x27, 0x1c, 0x93, 0xcd, 0xc5, 0x98, 0xb7, 0x61, 0xc6, 0x7d, 0xa5, 0xbb, 0x6a, 0x30,
+	0xd1, 0x5b, 0xd9, 0x92, 0x2e, 0x3b, 0xd1, 0xad, 0xfd, 0x3f, 0x4c, 0xc3, 0x88, 0x16, 0x81, 0x79,
+	0x9c, 0x23, 0x95, 0xea, 0x6f, 0x9d, 0xb5, 0x8f, 0xcc, 0xcf, 0xd5, 0x33, 0xca, 0x69, 0x8d, 0x33,
+	0x7b, 0xf7, 0x7f, 0x03, 0x00, 0x9b, 0x52, 0xa0, 0x32, 0x3e, 0x21, 0x00, 0x00,
 }
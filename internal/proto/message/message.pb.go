@@ -43,7 +43,7 @@ func (m *InBoxItem) Reset()         { *m = InBoxItem{} }
 func (m *InBoxItem) String() string { return proto.CompactTextString(m) }
 func (*InBoxItem) ProtoMessage()    {}
 func (*InBoxItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{0}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{0}
 }
 func (m *InBoxItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InBoxItem.Unmarshal(m, b)
@@ -131,7 +131,7 @@ func (m *NotifyItem) Reset()         { *m = NotifyItem{} }
 func (m *NotifyItem) String() string { return proto.CompactTextString(m) }
 func (*NotifyItem) ProtoMessage()    {}
 func (*NotifyItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{1}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{1}
 }
 func (m *NotifyItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyItem.Unmarshal(m, b)
@@ -179,7 +179,7 @@ func (m *SendEmailReq) Reset()         { *m = SendEmailReq{} }
 func (m *SendEmailReq) String() string { return proto.CompactTextString(m) }
 func (*SendEmailReq) ProtoMessage()    {}
 func (*SendEmailReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{2}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{2}
 }
 func (m *SendEmailReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendEmailReq.Unmarshal(m, b)
@@ -243,7 +243,7 @@ func (m *SendInBoxReq) Reset()         { *m = SendInBoxReq{} }
 func (m *SendInBoxReq) String() string { return proto.CompactTextString(m) }
 func (*SendInBoxReq) ProtoMessage()    {}
 func (*SendInBoxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{3}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{3}
 }
 func (m *SendInBoxReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendInBoxReq.Unmarshal(m, b)
@@ -318,7 +318,7 @@ func (m *SendNotifyReq) Reset()         { *m = SendNotifyReq{} }
 func (m *SendNotifyReq) String() string { return proto.CompactTextString(m) }
 func (*SendNotifyReq) ProtoMessage()    {}
 func (*SendNotifyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{4}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{4}
 }
 func (m *SendNotifyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendNotifyReq.Unmarshal(m, b)
@@ -371,7 +371,7 @@ func (m *SendSMSReq) Reset()         { *m = SendSMSReq{} }
 func (m *SendSMSReq) String() string { return proto.CompactTextString(m) }
 func (*SendSMSReq) ProtoMessage()    {}
 func (*SendSMSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{5}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{5}
 }
 func (m *SendSMSReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendSMSReq.Unmarshal(m, b)
@@ -419,7 +419,7 @@ func (m *GetInBoxReq) Reset()         { *m = GetInBoxReq{} }
 func (m *GetInBoxReq) String() string { return proto.CompactTextString(m) }
 func (*GetInBoxReq) ProtoMessage()    {}
 func (*GetInBoxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{6}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{6}
 }
 func (m *GetInBoxReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInBoxReq.Unmarshal(m, b)
@@ -478,7 +478,7 @@ func (m *GetInboxResp) Reset()         { *m = GetInboxResp{} }
 func (m *GetInboxResp) String() string { return proto.CompactTextString(m) }
 func (*GetInboxResp) ProtoMessage()    {}
 func (*GetInboxResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{7}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{7}
 }
 func (m *GetInboxResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInboxResp.Unmarshal(m, b)
@@ -516,7 +516,7 @@ func (m *GetNotifyReq) Reset()         { *m = GetNotifyReq{} }
 func (m *GetNotifyReq) String() string { return proto.CompactTextString(m) }
 func (*GetNotifyReq) ProtoMessage()    {}
 func (*GetNotifyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_1f99f339dab91b21, []int{8}
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{8}
 }
 func (m *GetNotifyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNotifyReq.Unmarshal(m, b)
@@ -543,6 +543,44 @@ func (m *GetNotifyReq) GetUid() string {
 	return ""
 }
 
+type UIDReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UIDReq) Reset()         { *m = UIDReq{} }
+func (m *UIDReq) String() string { return proto.CompactTextString(m) }
+func (*UIDReq) ProtoMessage()    {}
+func (*UIDReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_34a9a0f02e7af45a, []int{9}
+}
+func (m *UIDReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UIDReq.Unmarshal(m, b)
+}
+func (m *UIDReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UIDReq.Marshal(b, m, deterministic)
+}
+func (dst *UIDReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UIDReq.Merge(dst, src)
+}
+func (m *UIDReq) XXX_Size() int {
+	return xxx_messageInfo_UIDReq.Size(m)
+}
+func (m *UIDReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UIDReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UIDReq proto.InternalMessageInfo
+
+func (m *UIDReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InBoxItem)(nil), "teddy.srv.message.InBoxItem")
 	proto.RegisterType((*NotifyItem)(nil), "teddy.srv.message.NotifyItem")
@@ -553,6 +591,7 @@ func init() {
 	proto.RegisterType((*GetInBoxReq)(nil), "teddy.srv.message.GetInBoxReq")
 	proto.RegisterType((*GetInboxResp)(nil), "teddy.srv.message.GetInboxResp")
 	proto.RegisterType((*GetNotifyReq)(nil), "teddy.srv.message.GetNotifyReq")
+	proto.RegisterType((*UIDReq)(nil), "teddy.srv.message.UIDReq")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -573,6 +612,10 @@ type MessageClient interface {
 	SendSMS(ctx context.Context, in *SendSMSReq, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetInBox(ctx context.Context, in *GetInBoxReq, opts ...grpc.CallOption) (*GetInboxResp, error)
 	GetNotify(ctx context.Context, in *GetNotifyReq, opts ...grpc.CallOption) (Message_GetNotifyClient, error)
+	// Removes the inbox of the user
+	DeleteUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	// The whole inbox of the user, for the user to download
+	ExportUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*GetInboxResp, error)
 }
 
 type messageClient struct {
@@ -660,6 +703,24 @@ func (x *messageGetNotifyClient) Recv() (*NotifyItem, error) {
 	return m, nil
 }
 
+func (c *messageClient) DeleteUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.message.Message/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageClient) ExportUserData(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*GetInboxResp, error) {
+	out := new(GetInboxResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.message.Message/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServer is the server API for Message service.
 type MessageServer interface {
 	SendEmail(context.Context, *SendEmailReq) (*empty.Empty, error)
@@ -668,6 +729,10 @@ type MessageServer interface {
 	SendSMS(context.Context, *SendSMSReq) (*empty.Empty, error)
 	GetInBox(context.Context, *GetInBoxReq) (*GetInboxResp, error)
 	GetNotify(*GetNotifyReq, Message_GetNotifyServer) error
+	// Removes the inbox of the user
+	DeleteUserData(context.Context, *UIDReq) (*empty.Empty, error)
+	// The whole inbox of the user, for the user to download
+	ExportUserData(context.Context, *UIDReq) (*GetInboxResp, error)
 }
 
 func RegisterMessageServer(s *grpc.Server, srv MessageServer) {
@@ -785,6 +850,42 @@ func (x *messageGetNotifyServer) Send(m *NotifyItem) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Message_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.message.Message/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).DeleteUserData(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Message_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.message.Message/ExportUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServer).ExportUserData(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Message_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "teddy.srv.message.Message",
 	HandlerType: (*MessageServer)(nil),
@@ -809,6 +910,14 @@ var _Message_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInBox",
 			Handler:    _Message_GetInBox_Handler,
 		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _Message_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Message_ExportUserData_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -821,47 +930,49 @@ var _Message_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("teddy-backend/internal/proto/message/message.proto", fileDescriptor_message_1f99f339dab91b21)
-}
-
-var fileDescriptor_message_1f99f339dab91b21 = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x5f, 0x9a, 0xae, 0x7f, 0x5e, 0x57, 0x04, 0x16, 0x9a, 0xa2, 0x0a, 0x58, 0x94, 0x53, 0x2f,
-	0x24, 0xa8, 0x93, 0x38, 0xc0, 0xad, 0xd2, 0x80, 0x1d, 0xb6, 0x49, 0xe9, 0x4e, 0x70, 0x4a, 0x9a,
-	0xd7, 0xd4, 0xa2, 0x8e, 0x43, 0xe2, 0x20, 0xba, 0xcf, 0xc0, 0x87, 0xe1, 0x5b, 0xf0, 0xa1, 0xb8,
-	0x20, 0x3b, 0x4e, 0x9a, 0x69, 0x6d, 0x47, 0x0f, 0x5c, 0xda, 0xe7, 0x67, 0xbf, 0x9f, 0x7e, 0x7f,
-	0x1c, 0xc3, 0x45, 0x4c, 0xc5, 0xb2, 0x08, 0xdd, 0x39, 0x67, 0xde, 0xdd, 0x32, 0x5f, 0xf3, 0x22,
-	0x0b, 0xa8, 0x27, 0x30, 0x8a, 0xd6, 0xaf, 0xc3, 0x60, 0xfe, 0x15, 0x93, 0xc8, 0x9b, 0x73, 0xc6,
-	0x78, 0xe2, 0xa5, 0x19, 0x17, 0xdc, 0x63, 0x98, 0xe7, 0x41, 0x8c, 0xd5, 0xbf, 0xab, 0xba, 0xe4,
-	0x99, 0x9a, 0x70, 0xf3, 0xec, 0xbb, 0xab, 0x37, 0x46, 0xe7, 0x0d, 0xe4, 0x98, 0xaf, 0x82, 0x24,
-	0x2e, 0x11, 0xc2, 0x62, 0xe1, 0xa5, 0x62, 0x9d, 0x62, 0xee, 0x21, 0x4b, 0xc5, 0xba, 0xfc, 0x2d,
-	0x71, 0x46, 0xef, 0x1f, 0x1f, 0x12, 0x94, 0x61, 0x2e, 0x02, 0x96, 0x6e, 0xaa, 0x72, 0xd8, 0xf9,
-	0x63, 0x40, 0xff, 0x32, 0x99, 0xf2, 0x1f, 0x97, 0x02, 0x19, 0x79, 0x02, 0x2d, 0x1a, 0x59, 0x86,
-	0x6d, 0x8c, 0xfb, 0x7e, 0x8b, 0x46, 0xe4, 0x39, 0x1c, 0x0b, 0x9e, 0xd2, 0xb9, 0xd5, 0x52, 0xad,
-	0x72, 0x41, 0x2c, 0xe8, 0xce, 0x79, 0x22, 0x30, 0x11, 0x96, 0xa9, 0xfa, 0xd5, 0x92, 0x10, 0x68,
-	0x2f, 0x32, 0xce, 0xac, 0xb6, 0x6a, 0xab, 0x5a, 0xf6, 0x24, 0x09, 0xeb, 0xd8, 0x36, 0xc6, 0x43,
-	0x5f, 0xd5, 0xe4, 0x14, 0x3a, 0x45, 0x92, 0x61, 0x10, 0x59, 0x1d, 0xdb, 0x18, 0xf7, 0x7c, 0xbd,
-	0x22, 0x6f, 0xa1, 0x97, 0x63, 0x12, 0xdd, 0x52, 0x86, 0x56, 0xd7, 0x36, 0xc6, 0x83, 0xc9, 0xc8,
-	0x8d, 0x39, 0x8f, 0x57, 0xda, 0xb3, 0xb0, 0x58, 0xb8, 0xb7, 0x95, 0x02, 0xbf, 0x3e, 0x2b, 0xe7,
-	0x7c, 0x0c, 0xca, 0xb9, 0xde, 0xe3, 0x73, 0xd5, 0x59, 0xe7, 0x1d, 0xc0, 0x35, 0x17, 0x74, 0xb1,
-	0x56, 0xea, 0x6b, 0xb5, 0x46, 0x53, 0xed, 0x29, 0x74, 0x22, 0x14, 0x01, 0x5d, 0x69, 0x13, 0xf4,
-	0xca, 0xf9, 0x69, 0xc0, 0xc9, 0x0c, 0x93, 0xe8, 0x82, 0x05, 0x74, 0xe5, 0xe3, 0x37, 0x39, 0x8e,
-	0xb2, 0xae, 0xc6, 0xd5, 0xe2, 0x60, 0x0b, 0x9b, 0x16, 0xb4, 0xff, 0xdd, 0x02, 0xe7, 0x97, 0xa6,
-	0xa3, 0xc2, 0x94, 0x74, 0x9e, 0x82, 0x59, 0xd4, 0x61, 0xca, 0xf2, 0xbf, 0xa5, 0xd9, 0xa4, 0xdc,
-	0x39, 0x80, 0xf2, 0x0d, 0x0c, 0x25, 0xe3, 0x32, 0x81, 0x43, 0x28, 0x6f, 0x22, 0x31, 0xef, 0x45,
-	0xf2, 0x09, 0x40, 0x02, 0xce, 0xae, 0x66, 0x12, 0xcd, 0x86, 0x41, 0xba, 0xe4, 0x09, 0x5e, 0x17,
-	0x2c, 0xc4, 0x4c, 0xa3, 0x36, 0x5b, 0x4d, 0xe9, 0xad, 0x7b, 0xd2, 0x9d, 0x2f, 0x30, 0xf8, 0x88,
-	0xa2, 0xf6, 0x92, 0x40, 0x3b, 0x0d, 0x62, 0x54, 0x18, 0x43, 0x5f, 0xd5, 0xb2, 0x97, 0xd3, 0x3b,
-	0x54, 0x93, 0x43, 0x5f, 0xd5, 0xb5, 0x3b, 0x66, 0xc3, 0x1d, 0x2d, 0xaa, 0x5d, 0x8b, 0x72, 0xa6,
-	0x70, 0xa2, 0xc0, 0x43, 0x09, 0x9e, 0xa7, 0x64, 0x02, 0xc7, 0x54, 0x20, 0xcb, 0x2d, 0xc3, 0x36,
-	0xc7, 0x83, 0xc9, 0x0b, 0xf7, 0xc1, 0xc3, 0xe0, 0xd6, 0x9f, 0xa8, 0x5f, 0x1e, 0x75, 0x6c, 0x85,
-	0xb1, 0xc7, 0xba, 0xc9, 0x6f, 0x13, 0xba, 0x57, 0xe5, 0x38, 0xf9, 0x00, 0xfd, 0xfa, 0xaa, 0x92,
-	0xb3, 0x2d, 0xf8, 0xcd, 0x8b, 0x3c, 0x3a, 0x7d, 0x90, 0xde, 0x85, 0x7c, 0x6e, 0x9c, 0xa3, 0x0a,
-	0x47, 0xb1, 0xd9, 0x89, 0x53, 0xb9, 0xb6, 0x07, 0x47, 0x07, 0x55, 0xd2, 0x27, 0xf6, 0x0e, 0xa0,
-	0x5a, 0xdd, 0x1e, 0xa4, 0x29, 0x74, 0x75, 0xe4, 0xe4, 0xe5, 0x0e, 0x98, 0xf2, 0x3a, 0xec, 0xc1,
-	0xb8, 0x82, 0x5e, 0x15, 0x36, 0x79, 0xb5, 0x05, 0xa4, 0x71, 0x13, 0x46, 0x67, 0xbb, 0xf6, 0x75,
-	0x98, 0xce, 0x11, 0xb9, 0x81, 0x7e, 0x1d, 0x0d, 0xd9, 0x71, 0x7e, 0x23, 0x6d, 0x1b, 0xeb, 0xcd,
-	0x9b, 0xe4, 0x1c, 0xbd, 0x31, 0xa6, 0xfd, 0xcf, 0x5d, 0xbd, 0x13, 0x76, 0x14, 0xf9, 0xf3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x1b, 0xa9, 0xaa, 0x83, 0x06, 0x00, 0x00,
+	proto.RegisterFile("teddy-backend/internal/proto/message/message.proto", fileDescriptor_message_34a9a0f02e7af45a)
+}
+
+var fileDescriptor_message_34a9a0f02e7af45a = []byte{
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xae, 0x93, 0x34, 0x7f, 0x26, 0x4d, 0xf5, 0xfb, 0xad, 0x50, 0x65, 0x22, 0xa0, 0x96, 0x4f,
+	0xb9, 0xe0, 0xa0, 0x54, 0xe2, 0x00, 0xb7, 0xa8, 0x01, 0x7a, 0x68, 0x2b, 0xb9, 0xed, 0x05, 0x4e,
+	0xeb, 0x78, 0xe2, 0xae, 0x88, 0xbd, 0xc6, 0xde, 0xa0, 0x86, 0x67, 0xe0, 0x61, 0x78, 0x22, 0x5e,
+	0x84, 0x0b, 0xda, 0x3f, 0x76, 0x5c, 0x35, 0x49, 0x9b, 0x03, 0x97, 0x76, 0x66, 0x76, 0xe7, 0xf3,
+	0x7c, 0xdf, 0x37, 0x59, 0x18, 0x09, 0x0c, 0xc3, 0xe5, 0xeb, 0x80, 0x4e, 0xbf, 0x62, 0x12, 0x0e,
+	0x59, 0x22, 0x30, 0x4b, 0xe8, 0x7c, 0x98, 0x66, 0x5c, 0xf0, 0x61, 0x8c, 0x79, 0x4e, 0x23, 0x2c,
+	0xfe, 0x7b, 0xaa, 0x4a, 0xfe, 0x57, 0x3d, 0x5e, 0x9e, 0x7d, 0xf7, 0xcc, 0x41, 0xff, 0x24, 0x62,
+	0xe2, 0x76, 0x11, 0x78, 0x53, 0x1e, 0x0f, 0x23, 0x3e, 0xa7, 0x49, 0xa4, 0x11, 0x82, 0xc5, 0x6c,
+	0x98, 0x8a, 0x65, 0x8a, 0xf9, 0x10, 0xe3, 0x54, 0x2c, 0xf5, 0x5f, 0x8d, 0xd3, 0x7f, 0xff, 0x78,
+	0x93, 0x60, 0x31, 0xe6, 0x82, 0xc6, 0xe9, 0x2a, 0xd2, 0xcd, 0xee, 0x1f, 0x0b, 0x3a, 0x67, 0xc9,
+	0x98, 0xdf, 0x9d, 0x09, 0x8c, 0xc9, 0x21, 0xd4, 0x58, 0x68, 0x5b, 0x8e, 0x35, 0xe8, 0xf8, 0x35,
+	0x16, 0x92, 0x67, 0xb0, 0x2f, 0x78, 0xca, 0xa6, 0x76, 0x4d, 0x95, 0x74, 0x42, 0x6c, 0x68, 0x4d,
+	0x79, 0x22, 0x30, 0x11, 0x76, 0x5d, 0xd5, 0x8b, 0x94, 0x10, 0x68, 0xcc, 0x32, 0x1e, 0xdb, 0x0d,
+	0x55, 0x56, 0xb1, 0xac, 0xc9, 0x21, 0xec, 0x7d, 0xc7, 0x1a, 0xf4, 0x7c, 0x15, 0x93, 0x23, 0x68,
+	0x2e, 0x92, 0x0c, 0x69, 0x68, 0x37, 0x1d, 0x6b, 0xd0, 0xf6, 0x4d, 0x46, 0xde, 0x42, 0x3b, 0xc7,
+	0x24, 0xbc, 0x66, 0x31, 0xda, 0x2d, 0xc7, 0x1a, 0x74, 0x47, 0x7d, 0x2f, 0xe2, 0x3c, 0x9a, 0x1b,
+	0xcd, 0x82, 0xc5, 0xcc, 0xbb, 0x2e, 0x18, 0xf8, 0xe5, 0x5d, 0xd9, 0xe7, 0x23, 0xd5, 0x7d, 0xed,
+	0xc7, 0xfb, 0x8a, 0xbb, 0xee, 0x3b, 0x80, 0x0b, 0x2e, 0xd8, 0x6c, 0xa9, 0xd8, 0x97, 0x6c, 0xad,
+	0x2a, 0xdb, 0x23, 0x68, 0x86, 0x28, 0x28, 0x9b, 0x1b, 0x11, 0x4c, 0xe6, 0xfe, 0xb4, 0xe0, 0xe0,
+	0x0a, 0x93, 0x70, 0x12, 0x53, 0x36, 0xf7, 0xf1, 0x9b, 0x6c, 0x47, 0x19, 0x17, 0xed, 0x2a, 0xd9,
+	0x59, 0xc2, 0xaa, 0x04, 0x8d, 0xa7, 0x4b, 0xe0, 0xfe, 0x32, 0xe3, 0x28, 0x33, 0xe5, 0x38, 0xff,
+	0x41, 0x7d, 0x51, 0x9a, 0x29, 0xc3, 0x7f, 0xe6, 0x66, 0x75, 0xe4, 0xe6, 0x0e, 0x23, 0x5f, 0x42,
+	0x4f, 0x4e, 0xac, 0x1d, 0xd8, 0x65, 0xe4, 0x95, 0x25, 0xf5, 0x7b, 0x96, 0x7c, 0x02, 0x90, 0x80,
+	0x57, 0xe7, 0x57, 0x12, 0xcd, 0x81, 0x6e, 0x7a, 0xcb, 0x13, 0xbc, 0x58, 0xc4, 0x01, 0x66, 0x06,
+	0xb5, 0x5a, 0xaa, 0x52, 0xaf, 0xdd, 0xa3, 0xee, 0x7e, 0x81, 0xee, 0x47, 0x14, 0xa5, 0x96, 0x04,
+	0x1a, 0x29, 0x8d, 0x50, 0x61, 0xf4, 0x7c, 0x15, 0xcb, 0x5a, 0xce, 0x7e, 0xa0, 0xea, 0xec, 0xf9,
+	0x2a, 0x2e, 0xd5, 0xa9, 0x57, 0xd4, 0x31, 0xa4, 0x1a, 0x25, 0x29, 0x77, 0x0c, 0x07, 0x0a, 0x3c,
+	0x90, 0xe0, 0x79, 0x4a, 0x46, 0xb0, 0xcf, 0x04, 0xc6, 0xb9, 0x6d, 0x39, 0xf5, 0x41, 0x77, 0xf4,
+	0xc2, 0x7b, 0xf0, 0x30, 0x78, 0xe5, 0x4f, 0xd4, 0xd7, 0x57, 0x5d, 0x47, 0x61, 0x6c, 0x91, 0xce,
+	0xed, 0x43, 0xf3, 0xe6, 0xec, 0x74, 0xed, 0xd9, 0xe8, 0x77, 0x03, 0x5a, 0xe7, 0x1a, 0x9a, 0x7c,
+	0x80, 0x4e, 0xb9, 0xc6, 0xe4, 0x78, 0xcd, 0xb7, 0xab, 0x4b, 0xde, 0x3f, 0x7a, 0xe0, 0xec, 0x44,
+	0x3e, 0x45, 0xee, 0x5e, 0x81, 0xa3, 0x26, 0xdd, 0x88, 0x53, 0x28, 0xba, 0x05, 0xc7, 0x98, 0xa8,
+	0xa9, 0x11, 0x67, 0x03, 0x50, 0xc9, 0x7c, 0x0b, 0xd2, 0x18, 0x5a, 0x66, 0x1d, 0xc8, 0xcb, 0x0d,
+	0x30, 0x7a, 0x55, 0xb6, 0x60, 0x9c, 0x43, 0xbb, 0x58, 0x04, 0xf2, 0x6a, 0x0d, 0x48, 0x65, 0x4b,
+	0xfa, 0xc7, 0x9b, 0xce, 0x8d, 0xd1, 0xee, 0x1e, 0xb9, 0x84, 0x4e, 0x69, 0x1b, 0xd9, 0x70, 0x7f,
+	0x45, 0x6d, 0xdd, 0xd4, 0xab, 0xf7, 0xca, 0xdd, 0x7b, 0x63, 0x91, 0x09, 0x1c, 0x9e, 0xe2, 0x1c,
+	0x05, 0xde, 0xe4, 0x98, 0x9d, 0x52, 0x41, 0xc9, 0xf3, 0x35, 0x4d, 0x7a, 0x11, 0xb6, 0xd0, 0xbc,
+	0x80, 0xc3, 0xc9, 0x5d, 0xca, 0x33, 0xf1, 0x14, 0x98, 0xc7, 0x79, 0x8e, 0x3b, 0x9f, 0x5b, 0xe6,
+	0x24, 0x68, 0xaa, 0x8f, 0x9d, 0xfc, 0x1d, 0x00, 0x61, 0x48, 0x69, 0x10, 0x23, 0x07, 0x00, 0x00,
 }
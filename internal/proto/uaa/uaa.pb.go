@@ -25,6 +25,173 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ErrorReason int32
+
+const (
+	ErrorReason_UNKNOWN_REASON           ErrorReason = 0
+	ErrorReason_ACCOUNT_LOCKED           ErrorReason = 1
+	ErrorReason_CREDENTIALS_EXPIRED      ErrorReason = 2
+	ErrorReason_MUST_CHANGE_PASSWORD     ErrorReason = 3
+	ErrorReason_CAPTCHA_REQUIRED         ErrorReason = 4
+	ErrorReason_PASSWORD_TOO_SHORT       ErrorReason = 5
+	ErrorReason_PASSWORD_TOO_LONG        ErrorReason = 6
+	ErrorReason_PASSWORD_MISSING_CLASSES ErrorReason = 7
+	ErrorReason_PASSWORD_TOO_COMMON      ErrorReason = 8
+	ErrorReason_PASSWORD_REUSED          ErrorReason = 9
+	ErrorReason_OAUTH_IDENTITY_TAKEN     ErrorReason = 10
+	ErrorReason_OAUTH_PROVIDER_LINKED    ErrorReason = 11
+	ErrorReason_LAST_LOGIN_METHOD        ErrorReason = 12
+	ErrorReason_MERGE_NOT_ALLOWED        ErrorReason = 13
+	ErrorReason_REAUTH_REQUIRED          ErrorReason = 14
+	ErrorReason_CHALLENGE_USED           ErrorReason = 15
+	ErrorReason_REDIRECT_URI_INVALID     ErrorReason = 16
+)
+
+var ErrorReason_name = map[int32]string{
+	0:  "UNKNOWN_REASON",
+	1:  "ACCOUNT_LOCKED",
+	2:  "CREDENTIALS_EXPIRED",
+	3:  "MUST_CHANGE_PASSWORD",
+	4:  "CAPTCHA_REQUIRED",
+	5:  "PASSWORD_TOO_SHORT",
+	6:  "PASSWORD_TOO_LONG",
+	7:  "PASSWORD_MISSING_CLASSES",
+	8:  "PASSWORD_TOO_COMMON",
+	9:  "PASSWORD_REUSED",
+	10: "OAUTH_IDENTITY_TAKEN",
+	11: "OAUTH_PROVIDER_LINKED",
+	12: "LAST_LOGIN_METHOD",
+	13: "MERGE_NOT_ALLOWED",
+	14: "REAUTH_REQUIRED",
+	15: "CHALLENGE_USED",
+	16: "REDIRECT_URI_INVALID",
+}
+var ErrorReason_value = map[string]int32{
+	"UNKNOWN_REASON":           0,
+	"ACCOUNT_LOCKED":           1,
+	"CREDENTIALS_EXPIRED":      2,
+	"MUST_CHANGE_PASSWORD":     3,
+	"CAPTCHA_REQUIRED":         4,
+	"PASSWORD_TOO_SHORT":       5,
+	"PASSWORD_TOO_LONG":        6,
+	"PASSWORD_MISSING_CLASSES": 7,
+	"PASSWORD_TOO_COMMON":      8,
+	"PASSWORD_REUSED":          9,
+	"OAUTH_IDENTITY_TAKEN":     10,
+	"OAUTH_PROVIDER_LINKED":    11,
+	"LAST_LOGIN_METHOD":        12,
+	"MERGE_NOT_ALLOWED":        13,
+	"REAUTH_REQUIRED":          14,
+	"CHALLENGE_USED":           15,
+	"REDIRECT_URI_INVALID":     16,
+}
+
+func (x ErrorReason) String() string {
+	return proto.EnumName(ErrorReason_name, int32(x))
+}
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{0}
+}
+
+type BoolFilter int32
+
+const (
+	BoolFilter_ANY BoolFilter = 0
+	BoolFilter_YES BoolFilter = 1
+	BoolFilter_NO  BoolFilter = 2
+)
+
+var BoolFilter_name = map[int32]string{
+	0: "ANY",
+	1: "YES",
+	2: "NO",
+}
+var BoolFilter_value = map[string]int32{
+	"ANY": 0,
+	"YES": 1,
+	"NO":  2,
+}
+
+func (x BoolFilter) String() string {
+	return proto.EnumName(BoolFilter_name, int32(x))
+}
+func (BoolFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{1}
+}
+
+type Gender int32
+
+const (
+	Gender_MAN     Gender = 0
+	Gender_WOMAN   Gender = 1
+	Gender_UNKNOWN Gender = 2
+)
+
+var Gender_name = map[int32]string{
+	0: "MAN",
+	1: "WOMAN",
+	2: "UNKNOWN",
+}
+var Gender_value = map[string]int32{
+	"MAN":     0,
+	"WOMAN":   1,
+	"UNKNOWN": 2,
+}
+
+func (x Gender) String() string {
+	return proto.EnumName(Gender_name, int32(x))
+}
+func (Gender) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{2}
+}
+
+// Attached to grpc status details so api can tell failures apart
+type ErrorDetail struct {
+	Reason               ErrorReason          `protobuf:"varint,1,opt,name=reason,proto3,enum=teddy.srv.uaa.ErrorReason" json:"reason,omitempty"`
+	Until                *timestamp.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
+func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetail) ProtoMessage()    {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{0}
+}
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorDetail.Unmarshal(m, b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+}
+func (dst *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(dst, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return xxx_messageInfo_ErrorDetail.Size(m)
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetReason() ErrorReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ErrorReason_UNKNOWN_REASON
+}
+
+func (m *ErrorDetail) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
 type Account struct {
 	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Username             string               `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
@@ -39,6 +206,10 @@ type Account struct {
 	UpdateDate           *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
 	LastSignInIP         string               `protobuf:"bytes,13,opt,name=lastSignInIP,proto3" json:"lastSignInIP,omitempty"`
 	LastSignInTime       *timestamp.Timestamp `protobuf:"bytes,14,opt,name=lastSignInTime,proto3" json:"lastSignInTime,omitempty"`
+	LockedUntil          *timestamp.Timestamp `protobuf:"bytes,15,opt,name=lockedUntil,proto3" json:"lockedUntil,omitempty"`
+	MustChangePassword   bool                 `protobuf:"varint,16,opt,name=mustChangePassword,proto3" json:"mustChangePassword,omitempty"`
+	TwoFactorEnabled     bool                 `protobuf:"varint,17,opt,name=twoFactorEnabled,proto3" json:"twoFactorEnabled,omitempty"`
+	EmailVerified        bool                 `protobuf:"varint,18,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -48,7 +219,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{0}
+	return fileDescriptor_uaa_d539f0198393248f, []int{1}
 }
 func (m *Account) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Account.Unmarshal(m, b)
@@ -159,6 +330,34 @@ func (m *Account) GetLastSignInTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Account) GetLockedUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return nil
+}
+
+func (m *Account) GetMustChangePassword() bool {
+	if m != nil {
+		return m.MustChangePassword
+	}
+	return false
+}
+
+func (m *Account) GetTwoFactorEnabled() bool {
+	if m != nil {
+		return m.TwoFactorEnabled
+	}
+	return false
+}
+
+func (m *Account) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
 type Sort struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Asc                  bool     `protobuf:"varint,2,opt,name=asc,proto3" json:"asc,omitempty"`
@@ -171,7 +370,7 @@ func (m *Sort) Reset()         { *m = Sort{} }
 func (m *Sort) String() string { return proto.CompactTextString(m) }
 func (*Sort) ProtoMessage()    {}
 func (*Sort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{1}
+	return fileDescriptor_uaa_d539f0198393248f, []int{2}
 }
 func (m *Sort) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Sort.Unmarshal(m, b)
@@ -216,7 +415,7 @@ func (m *UIDReq) Reset()         { *m = UIDReq{} }
 func (m *UIDReq) String() string { return proto.CompactTextString(m) }
 func (*UIDReq) ProtoMessage()    {}
 func (*UIDReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{2}
+	return fileDescriptor_uaa_d539f0198393248f, []int{3}
 }
 func (m *UIDReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UIDReq.Unmarshal(m, b)
@@ -243,10 +442,114 @@ func (m *UIDReq) GetUid() string {
 	return ""
 }
 
+type LockAccountReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Lock forever when empty
+	LockedUntil          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=lockedUntil,proto3" json:"lockedUntil,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LockAccountReq) Reset()         { *m = LockAccountReq{} }
+func (m *LockAccountReq) String() string { return proto.CompactTextString(m) }
+func (*LockAccountReq) ProtoMessage()    {}
+func (*LockAccountReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{4}
+}
+func (m *LockAccountReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockAccountReq.Unmarshal(m, b)
+}
+func (m *LockAccountReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockAccountReq.Marshal(b, m, deterministic)
+}
+func (dst *LockAccountReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAccountReq.Merge(dst, src)
+}
+func (m *LockAccountReq) XXX_Size() int {
+	return xxx_messageInfo_LockAccountReq.Size(m)
+}
+func (m *LockAccountReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAccountReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAccountReq proto.InternalMessageInfo
+
+func (m *LockAccountReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *LockAccountReq) GetLockedUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return nil
+}
+
+type CredentialsExpiredReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	MustChangePassword   bool     `protobuf:"varint,2,opt,name=mustChangePassword,proto3" json:"mustChangePassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialsExpiredReq) Reset()         { *m = CredentialsExpiredReq{} }
+func (m *CredentialsExpiredReq) String() string { return proto.CompactTextString(m) }
+func (*CredentialsExpiredReq) ProtoMessage()    {}
+func (*CredentialsExpiredReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{5}
+}
+func (m *CredentialsExpiredReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialsExpiredReq.Unmarshal(m, b)
+}
+func (m *CredentialsExpiredReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialsExpiredReq.Marshal(b, m, deterministic)
+}
+func (dst *CredentialsExpiredReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialsExpiredReq.Merge(dst, src)
+}
+func (m *CredentialsExpiredReq) XXX_Size() int {
+	return xxx_messageInfo_CredentialsExpiredReq.Size(m)
+}
+func (m *CredentialsExpiredReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialsExpiredReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialsExpiredReq proto.InternalMessageInfo
+
+func (m *CredentialsExpiredReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CredentialsExpiredReq) GetMustChangePassword() bool {
+	if m != nil {
+		return m.MustChangePassword
+	}
+	return false
+}
+
 type GetAllReq struct {
-	Page                 uint32   `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size                 uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Sorts                []*Sort  `protobuf:"bytes,3,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	Page  uint32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size  uint32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sorts []*Sort `protobuf:"bytes,3,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	Role  string  `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// Timed locks already passed don't count as locked
+	Locked             BoolFilter `protobuf:"varint,5,opt,name=locked,proto3,enum=teddy.srv.uaa.BoolFilter" json:"locked,omitempty"`
+	CredentialsExpired BoolFilter `protobuf:"varint,6,opt,name=credentialsExpired,proto3,enum=teddy.srv.uaa.BoolFilter" json:"credentialsExpired,omitempty"`
+	// Ranges are [from, to), unset bounds are open
+	CreatedFrom    *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`
+	CreatedTo      *timestamp.Timestamp `protobuf:"bytes,8,opt,name=createdTo,proto3" json:"createdTo,omitempty"`
+	LastSignInFrom *timestamp.Timestamp `protobuf:"bytes,9,opt,name=lastSignInFrom,proto3" json:"lastSignInFrom,omitempty"`
+	LastSignInTo   *timestamp.Timestamp `protobuf:"bytes,10,opt,name=lastSignInTo,proto3" json:"lastSignInTo,omitempty"`
+	// Matches the start of username or email
+	Prefix               string   `protobuf:"bytes,11,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -256,7 +559,7 @@ func (m *GetAllReq) Reset()         { *m = GetAllReq{} }
 func (m *GetAllReq) String() string { return proto.CompactTextString(m) }
 func (*GetAllReq) ProtoMessage()    {}
 func (*GetAllReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{3}
+	return fileDescriptor_uaa_d539f0198393248f, []int{6}
 }
 func (m *GetAllReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAllReq.Unmarshal(m, b)
@@ -297,6 +600,62 @@ func (m *GetAllReq) GetSorts() []*Sort {
 	return nil
 }
 
+func (m *GetAllReq) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *GetAllReq) GetLocked() BoolFilter {
+	if m != nil {
+		return m.Locked
+	}
+	return BoolFilter_ANY
+}
+
+func (m *GetAllReq) GetCredentialsExpired() BoolFilter {
+	if m != nil {
+		return m.CredentialsExpired
+	}
+	return BoolFilter_ANY
+}
+
+func (m *GetAllReq) GetCreatedFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedFrom
+	}
+	return nil
+}
+
+func (m *GetAllReq) GetCreatedTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedTo
+	}
+	return nil
+}
+
+func (m *GetAllReq) GetLastSignInFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSignInFrom
+	}
+	return nil
+}
+
+func (m *GetAllReq) GetLastSignInTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSignInTo
+	}
+	return nil
+}
+
+func (m *GetAllReq) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
 type GetOneReq struct {
 	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -308,7 +667,7 @@ func (m *GetOneReq) Reset()         { *m = GetOneReq{} }
 func (m *GetOneReq) String() string { return proto.CompactTextString(m) }
 func (*GetOneReq) ProtoMessage()    {}
 func (*GetOneReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{4}
+	return fileDescriptor_uaa_d539f0198393248f, []int{7}
 }
 func (m *GetOneReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetOneReq.Unmarshal(m, b)
@@ -337,6 +696,7 @@ func (m *GetOneReq) GetPrincipal() string {
 
 type GetAllResp struct {
 	Accounts             []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TotalCount           uint64     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -346,7 +706,7 @@ func (m *GetAllResp) Reset()         { *m = GetAllResp{} }
 func (m *GetAllResp) String() string { return proto.CompactTextString(m) }
 func (*GetAllResp) ProtoMessage()    {}
 func (*GetAllResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{5}
+	return fileDescriptor_uaa_d539f0198393248f, []int{8}
 }
 func (m *GetAllResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAllResp.Unmarshal(m, b)
@@ -373,24 +733,32 @@ func (m *GetAllResp) GetAccounts() []*Account {
 	return nil
 }
 
+func (m *GetAllResp) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
 type RegisterNormalReq struct {
-	Roles    []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	Username string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	// Types that are valid to be assigned to Contact:
 	//	*RegisterNormalReq_Email
 	//	*RegisterNormalReq_Phone
-	Contact              isRegisterNormalReq_Contact `protobuf_oneof:"contact"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Contact isRegisterNormalReq_Contact `protobuf_oneof:"contact"`
+	// Caller has checked a code sent to the email
+	EmailVerified        bool     `protobuf:"varint,6,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RegisterNormalReq) Reset()         { *m = RegisterNormalReq{} }
 func (m *RegisterNormalReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterNormalReq) ProtoMessage()    {}
 func (*RegisterNormalReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{6}
+	return fileDescriptor_uaa_d539f0198393248f, []int{9}
 }
 func (m *RegisterNormalReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterNormalReq.Unmarshal(m, b)
@@ -410,13 +778,6 @@ func (m *RegisterNormalReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterNormalReq proto.InternalMessageInfo
 
-func (m *RegisterNormalReq) GetRoles() []string {
-	if m != nil {
-		return m.Roles
-	}
-	return nil
-}
-
 func (m *RegisterNormalReq) GetUsername() string {
 	if m != nil {
 		return m.Username
@@ -468,6 +829,13 @@ func (m *RegisterNormalReq) GetPhone() string {
 	return ""
 }
 
+func (m *RegisterNormalReq) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*RegisterNormalReq) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _RegisterNormalReq_OneofMarshaler, _RegisterNormalReq_OneofUnmarshaler, _RegisterNormalReq_OneofSizer, []interface{}{
@@ -535,9 +903,12 @@ func _RegisterNormalReq_OneofSizer(msg proto.Message) (n int) {
 }
 
 type RegisterOAuthReq struct {
-	Roles                []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	OauthProvider        string   `protobuf:"bytes,2,opt,name=oauthProvider,proto3" json:"oauthProvider,omitempty"`
-	OauthUID             string   `protobuf:"bytes,3,opt,name=oauthUID,proto3" json:"oauthUID,omitempty"`
+	OauthProvider string `protobuf:"bytes,2,opt,name=oauthProvider,proto3" json:"oauthProvider,omitempty"`
+	OauthUID      string `protobuf:"bytes,3,opt,name=oauthUID,proto3" json:"oauthUID,omitempty"`
+	Username      string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// Only kept when the provider has verified it
+	Email                string   `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified        bool     `protobuf:"varint,6,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -547,7 +918,7 @@ func (m *RegisterOAuthReq) Reset()         { *m = RegisterOAuthReq{} }
 func (m *RegisterOAuthReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterOAuthReq) ProtoMessage()    {}
 func (*RegisterOAuthReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{7}
+	return fileDescriptor_uaa_d539f0198393248f, []int{10}
 }
 func (m *RegisterOAuthReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterOAuthReq.Unmarshal(m, b)
@@ -567,13 +938,6 @@ func (m *RegisterOAuthReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterOAuthReq proto.InternalMessageInfo
 
-func (m *RegisterOAuthReq) GetRoles() []string {
-	if m != nil {
-		return m.Roles
-	}
-	return nil
-}
-
 func (m *RegisterOAuthReq) GetOauthProvider() string {
 	if m != nil {
 		return m.OauthProvider
@@ -588,9 +952,34 @@ func (m *RegisterOAuthReq) GetOauthUID() string {
 	return ""
 }
 
+func (m *RegisterOAuthReq) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegisterOAuthReq) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *RegisterOAuthReq) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
 type VerifyAccountReq struct {
-	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Password  string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Failures are counted per ip too
+	Ip string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Image captcha has been verified by caller
+	CaptchaVerified      bool     `protobuf:"varint,4,opt,name=captchaVerified,proto3" json:"captchaVerified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -600,7 +989,7 @@ func (m *VerifyAccountReq) Reset()         { *m = VerifyAccountReq{} }
 func (m *VerifyAccountReq) String() string { return proto.CompactTextString(m) }
 func (*VerifyAccountReq) ProtoMessage()    {}
 func (*VerifyAccountReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{8}
+	return fileDescriptor_uaa_d539f0198393248f, []int{11}
 }
 func (m *VerifyAccountReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyAccountReq.Unmarshal(m, b)
@@ -634,6 +1023,20 @@ func (m *VerifyAccountReq) GetPassword() string {
 	return ""
 }
 
+func (m *VerifyAccountReq) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *VerifyAccountReq) GetCaptchaVerified() bool {
+	if m != nil {
+		return m.CaptchaVerified
+	}
+	return false
+}
+
 type ChangePasswordReq struct {
 	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
 	OldPassword          string   `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
@@ -647,7 +1050,7 @@ func (m *ChangePasswordReq) Reset()         { *m = ChangePasswordReq{} }
 func (m *ChangePasswordReq) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordReq) ProtoMessage()    {}
 func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{9}
+	return fileDescriptor_uaa_d539f0198393248f, []int{12}
 }
 func (m *ChangePasswordReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordReq.Unmarshal(m, b)
@@ -688,65 +1091,2453 @@ func (m *ChangePasswordReq) GetNewPassword() string {
 	return ""
 }
 
-type UpdateSignInReq struct {
-	Principal            string               `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
-	Ip                   string               `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Time                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type ResetPasswordReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateSignInReq) Reset()         { *m = UpdateSignInReq{} }
-func (m *UpdateSignInReq) String() string { return proto.CompactTextString(m) }
-func (*UpdateSignInReq) ProtoMessage()    {}
-func (*UpdateSignInReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_uaa_dfbdc074a1357c45, []int{10}
+func (m *ResetPasswordReq) Reset()         { *m = ResetPasswordReq{} }
+func (m *ResetPasswordReq) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordReq) ProtoMessage()    {}
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{13}
 }
-func (m *UpdateSignInReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateSignInReq.Unmarshal(m, b)
+func (m *ResetPasswordReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordReq.Unmarshal(m, b)
 }
-func (m *UpdateSignInReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateSignInReq.Marshal(b, m, deterministic)
+func (m *ResetPasswordReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordReq.Marshal(b, m, deterministic)
 }
-func (dst *UpdateSignInReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateSignInReq.Merge(dst, src)
+func (dst *ResetPasswordReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordReq.Merge(dst, src)
 }
-func (m *UpdateSignInReq) XXX_Size() int {
-	return xxx_messageInfo_UpdateSignInReq.Size(m)
+func (m *ResetPasswordReq) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordReq.Size(m)
 }
-func (m *UpdateSignInReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateSignInReq.DiscardUnknown(m)
+func (m *ResetPasswordReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateSignInReq proto.InternalMessageInfo
+var xxx_messageInfo_ResetPasswordReq proto.InternalMessageInfo
 
-func (m *UpdateSignInReq) GetPrincipal() string {
+func (m *ResetPasswordReq) GetUid() string {
 	if m != nil {
-		return m.Principal
+		return m.Uid
 	}
 	return ""
 }
 
-func (m *UpdateSignInReq) GetIp() string {
+func (m *ResetPasswordReq) GetNewPassword() string {
 	if m != nil {
-		return m.Ip
+		return m.NewPassword
 	}
 	return ""
 }
 
-func (m *UpdateSignInReq) GetTime() *timestamp.Timestamp {
-	if m != nil {
-		return m.Time
-	}
-	return nil
+type CheckPasswordReq struct {
+	// Optional, reuse is only checked for an account
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckPasswordReq) Reset()         { *m = CheckPasswordReq{} }
+func (m *CheckPasswordReq) String() string { return proto.CompactTextString(m) }
+func (*CheckPasswordReq) ProtoMessage()    {}
+func (*CheckPasswordReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{14}
+}
+func (m *CheckPasswordReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckPasswordReq.Unmarshal(m, b)
+}
+func (m *CheckPasswordReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckPasswordReq.Marshal(b, m, deterministic)
+}
+func (dst *CheckPasswordReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckPasswordReq.Merge(dst, src)
+}
+func (m *CheckPasswordReq) XXX_Size() int {
+	return xxx_messageInfo_CheckPasswordReq.Size(m)
+}
+func (m *CheckPasswordReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckPasswordReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckPasswordReq proto.InternalMessageInfo
+
+func (m *CheckPasswordReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CheckPasswordReq) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type UpdateSignInReq struct {
+	Principal            string               `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Ip                   string               `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateSignInReq) Reset()         { *m = UpdateSignInReq{} }
+func (m *UpdateSignInReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateSignInReq) ProtoMessage()    {}
+func (*UpdateSignInReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{15}
+}
+func (m *UpdateSignInReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSignInReq.Unmarshal(m, b)
+}
+func (m *UpdateSignInReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSignInReq.Marshal(b, m, deterministic)
+}
+func (dst *UpdateSignInReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSignInReq.Merge(dst, src)
+}
+func (m *UpdateSignInReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateSignInReq.Size(m)
+}
+func (m *UpdateSignInReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSignInReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSignInReq proto.InternalMessageInfo
+
+func (m *UpdateSignInReq) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *UpdateSignInReq) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *UpdateSignInReq) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type IssueRefreshTokenReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Access token issued along with, recorded in the new session
+	Jti                  string   `protobuf:"bytes,2,opt,name=jti,proto3" json:"jti,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueRefreshTokenReq) Reset()         { *m = IssueRefreshTokenReq{} }
+func (m *IssueRefreshTokenReq) String() string { return proto.CompactTextString(m) }
+func (*IssueRefreshTokenReq) ProtoMessage()    {}
+func (*IssueRefreshTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{16}
+}
+func (m *IssueRefreshTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueRefreshTokenReq.Unmarshal(m, b)
+}
+func (m *IssueRefreshTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueRefreshTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *IssueRefreshTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueRefreshTokenReq.Merge(dst, src)
+}
+func (m *IssueRefreshTokenReq) XXX_Size() int {
+	return xxx_messageInfo_IssueRefreshTokenReq.Size(m)
+}
+func (m *IssueRefreshTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueRefreshTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueRefreshTokenReq proto.InternalMessageInfo
+
+func (m *IssueRefreshTokenReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *IssueRefreshTokenReq) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *IssueRefreshTokenReq) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *IssueRefreshTokenReq) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+type RefreshToken struct {
+	Token                string               `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	SessionId            string               `protobuf:"bytes,3,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RefreshToken) Reset()         { *m = RefreshToken{} }
+func (m *RefreshToken) String() string { return proto.CompactTextString(m) }
+func (*RefreshToken) ProtoMessage()    {}
+func (*RefreshToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{17}
+}
+func (m *RefreshToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshToken.Unmarshal(m, b)
+}
+func (m *RefreshToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshToken.Marshal(b, m, deterministic)
+}
+func (dst *RefreshToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshToken.Merge(dst, src)
+}
+func (m *RefreshToken) XXX_Size() int {
+	return xxx_messageInfo_RefreshToken.Size(m)
+}
+func (m *RefreshToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshToken proto.InternalMessageInfo
+
+func (m *RefreshToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RefreshToken) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *RefreshToken) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type RefreshTokenReq struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Jti                  string   `protobuf:"bytes,2,opt,name=jti,proto3" json:"jti,omitempty"`
+	Ip                   string   `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent            string   `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenReq) Reset()         { *m = RefreshTokenReq{} }
+func (m *RefreshTokenReq) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenReq) ProtoMessage()    {}
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{18}
+}
+func (m *RefreshTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenReq.Unmarshal(m, b)
+}
+func (m *RefreshTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *RefreshTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenReq.Merge(dst, src)
+}
+func (m *RefreshTokenReq) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenReq.Size(m)
+}
+func (m *RefreshTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenReq proto.InternalMessageInfo
+
+func (m *RefreshTokenReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RefreshTokenReq) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *RefreshTokenReq) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *RefreshTokenReq) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+type RotateRefreshTokenResp struct {
+	Account              *Account      `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	RefreshToken         *RefreshToken `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RotateRefreshTokenResp) Reset()         { *m = RotateRefreshTokenResp{} }
+func (m *RotateRefreshTokenResp) String() string { return proto.CompactTextString(m) }
+func (*RotateRefreshTokenResp) ProtoMessage()    {}
+func (*RotateRefreshTokenResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{19}
+}
+func (m *RotateRefreshTokenResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateRefreshTokenResp.Unmarshal(m, b)
+}
+func (m *RotateRefreshTokenResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateRefreshTokenResp.Marshal(b, m, deterministic)
+}
+func (dst *RotateRefreshTokenResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRefreshTokenResp.Merge(dst, src)
+}
+func (m *RotateRefreshTokenResp) XXX_Size() int {
+	return xxx_messageInfo_RotateRefreshTokenResp.Size(m)
+}
+func (m *RotateRefreshTokenResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRefreshTokenResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateRefreshTokenResp proto.InternalMessageInfo
+
+func (m *RotateRefreshTokenResp) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *RotateRefreshTokenResp) GetRefreshToken() *RefreshToken {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return nil
+}
+
+type RevokeTokenReq struct {
+	Jti        string               `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	Uid        string               `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	// Revoke the family of refresh token as well when not empty
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// Revoke the session of the token as well when not empty
+	SessionId            string   `protobuf:"bytes,5,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenReq) Reset()         { *m = RevokeTokenReq{} }
+func (m *RevokeTokenReq) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenReq) ProtoMessage()    {}
+func (*RevokeTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{20}
+}
+func (m *RevokeTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenReq.Unmarshal(m, b)
+}
+func (m *RevokeTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *RevokeTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenReq.Merge(dst, src)
+}
+func (m *RevokeTokenReq) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenReq.Size(m)
+}
+func (m *RevokeTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenReq proto.InternalMessageInfo
+
+func (m *RevokeTokenReq) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *RevokeTokenReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RevokeTokenReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *RevokeTokenReq) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *RevokeTokenReq) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type UseTokenReq struct {
+	Jti string `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Kept as used until then
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UseTokenReq) Reset()         { *m = UseTokenReq{} }
+func (m *UseTokenReq) String() string { return proto.CompactTextString(m) }
+func (*UseTokenReq) ProtoMessage()    {}
+func (*UseTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{21}
+}
+func (m *UseTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UseTokenReq.Unmarshal(m, b)
+}
+func (m *UseTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UseTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *UseTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UseTokenReq.Merge(dst, src)
+}
+func (m *UseTokenReq) XXX_Size() int {
+	return xxx_messageInfo_UseTokenReq.Size(m)
+}
+func (m *UseTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UseTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UseTokenReq proto.InternalMessageInfo
+
+func (m *UseTokenReq) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *UseTokenReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UseTokenReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type IsTokenRevokedReq struct {
+	Jti      string               `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	Uid      string               `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	IssuedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	// Empty for tokens not bound to a session
+	SessionId            string   `protobuf:"bytes,4,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsTokenRevokedReq) Reset()         { *m = IsTokenRevokedReq{} }
+func (m *IsTokenRevokedReq) String() string { return proto.CompactTextString(m) }
+func (*IsTokenRevokedReq) ProtoMessage()    {}
+func (*IsTokenRevokedReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{22}
+}
+func (m *IsTokenRevokedReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsTokenRevokedReq.Unmarshal(m, b)
+}
+func (m *IsTokenRevokedReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsTokenRevokedReq.Marshal(b, m, deterministic)
+}
+func (dst *IsTokenRevokedReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsTokenRevokedReq.Merge(dst, src)
+}
+func (m *IsTokenRevokedReq) XXX_Size() int {
+	return xxx_messageInfo_IsTokenRevokedReq.Size(m)
+}
+func (m *IsTokenRevokedReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsTokenRevokedReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsTokenRevokedReq proto.InternalMessageInfo
+
+func (m *IsTokenRevokedReq) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *IsTokenRevokedReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *IsTokenRevokedReq) GetIssuedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return nil
+}
+
+func (m *IsTokenRevokedReq) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type IsTokenRevokedResp struct {
+	Revoked              bool     `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsTokenRevokedResp) Reset()         { *m = IsTokenRevokedResp{} }
+func (m *IsTokenRevokedResp) String() string { return proto.CompactTextString(m) }
+func (*IsTokenRevokedResp) ProtoMessage()    {}
+func (*IsTokenRevokedResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{23}
+}
+func (m *IsTokenRevokedResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsTokenRevokedResp.Unmarshal(m, b)
+}
+func (m *IsTokenRevokedResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsTokenRevokedResp.Marshal(b, m, deterministic)
+}
+func (dst *IsTokenRevokedResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsTokenRevokedResp.Merge(dst, src)
+}
+func (m *IsTokenRevokedResp) XXX_Size() int {
+	return xxx_messageInfo_IsTokenRevokedResp.Size(m)
+}
+func (m *IsTokenRevokedResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsTokenRevokedResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsTokenRevokedResp proto.InternalMessageInfo
+
+func (m *IsTokenRevokedResp) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+type EnrollTOTPResp struct {
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth key URI, encode it to QR code for authenticator apps
+	Uri                  string   `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTOTPResp) Reset()         { *m = EnrollTOTPResp{} }
+func (m *EnrollTOTPResp) String() string { return proto.CompactTextString(m) }
+func (*EnrollTOTPResp) ProtoMessage()    {}
+func (*EnrollTOTPResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{24}
+}
+func (m *EnrollTOTPResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTOTPResp.Unmarshal(m, b)
+}
+func (m *EnrollTOTPResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTOTPResp.Marshal(b, m, deterministic)
+}
+func (dst *EnrollTOTPResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTOTPResp.Merge(dst, src)
+}
+func (m *EnrollTOTPResp) XXX_Size() int {
+	return xxx_messageInfo_EnrollTOTPResp.Size(m)
+}
+func (m *EnrollTOTPResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTOTPResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTOTPResp proto.InternalMessageInfo
+
+func (m *EnrollTOTPResp) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *EnrollTOTPResp) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type TOTPCodeReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// TOTP code or one of recovery codes
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Of login, the challenge token is used once, kept until it expires
+	ChallengeJti        string               `protobuf:"bytes,3,opt,name=challengeJti,proto3" json:"challengeJti,omitempty"`
+	ChallengeExpireTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=challengeExpireTime,proto3" json:"challengeExpireTime,omitempty"`
+	// Of login, failures are counted per ip too
+	Ip                   string   `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TOTPCodeReq) Reset()         { *m = TOTPCodeReq{} }
+func (m *TOTPCodeReq) String() string { return proto.CompactTextString(m) }
+func (*TOTPCodeReq) ProtoMessage()    {}
+func (*TOTPCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{25}
+}
+func (m *TOTPCodeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TOTPCodeReq.Unmarshal(m, b)
+}
+func (m *TOTPCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TOTPCodeReq.Marshal(b, m, deterministic)
+}
+func (dst *TOTPCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPCodeReq.Merge(dst, src)
+}
+func (m *TOTPCodeReq) XXX_Size() int {
+	return xxx_messageInfo_TOTPCodeReq.Size(m)
+}
+func (m *TOTPCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TOTPCodeReq proto.InternalMessageInfo
+
+func (m *TOTPCodeReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *TOTPCodeReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *TOTPCodeReq) GetChallengeJti() string {
+	if m != nil {
+		return m.ChallengeJti
+	}
+	return ""
+}
+
+func (m *TOTPCodeReq) GetChallengeExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ChallengeExpireTime
+	}
+	return nil
+}
+
+func (m *TOTPCodeReq) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type RecoveryCodesResp struct {
+	Codes                []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveryCodesResp) Reset()         { *m = RecoveryCodesResp{} }
+func (m *RecoveryCodesResp) String() string { return proto.CompactTextString(m) }
+func (*RecoveryCodesResp) ProtoMessage()    {}
+func (*RecoveryCodesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{26}
+}
+func (m *RecoveryCodesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoveryCodesResp.Unmarshal(m, b)
+}
+func (m *RecoveryCodesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoveryCodesResp.Marshal(b, m, deterministic)
+}
+func (dst *RecoveryCodesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryCodesResp.Merge(dst, src)
+}
+func (m *RecoveryCodesResp) XXX_Size() int {
+	return xxx_messageInfo_RecoveryCodesResp.Size(m)
+}
+func (m *RecoveryCodesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryCodesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryCodesResp proto.InternalMessageInfo
+
+func (m *RecoveryCodesResp) GetCodes() []string {
+	if m != nil {
+		return m.Codes
+	}
+	return nil
+}
+
+type Profile struct {
+	Uid        string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Firstname  string               `protobuf:"bytes,2,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Lastname   string               `protobuf:"bytes,3,opt,name=lastname,proto3" json:"lastname,omitempty"`
+	AvatarUrl  string               `protobuf:"bytes,4,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	Bio        string               `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	Birthday   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Gender     Gender               `protobuf:"varint,7,opt,name=gender,proto3,enum=teddy.srv.uaa.Gender" json:"gender,omitempty"`
+	Locale     string               `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	UpdateDate *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	// Only told to the owner
+	PrivateFields        []string `protobuf:"bytes,10,rep,name=privateFields,proto3" json:"privateFields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile) Reset()         { *m = Profile{} }
+func (m *Profile) String() string { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()    {}
+func (*Profile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{27}
+}
+func (m *Profile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile.Unmarshal(m, b)
+}
+func (m *Profile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile.Marshal(b, m, deterministic)
+}
+func (dst *Profile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile.Merge(dst, src)
+}
+func (m *Profile) XXX_Size() int {
+	return xxx_messageInfo_Profile.Size(m)
+}
+func (m *Profile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile proto.InternalMessageInfo
+
+func (m *Profile) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Profile) GetFirstname() string {
+	if m != nil {
+		return m.Firstname
+	}
+	return ""
+}
+
+func (m *Profile) GetLastname() string {
+	if m != nil {
+		return m.Lastname
+	}
+	return ""
+}
+
+func (m *Profile) GetAvatarUrl() string {
+	if m != nil {
+		return m.AvatarUrl
+	}
+	return ""
+}
+
+func (m *Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Profile) GetBirthday() *timestamp.Timestamp {
+	if m != nil {
+		return m.Birthday
+	}
+	return nil
+}
+
+func (m *Profile) GetGender() Gender {
+	if m != nil {
+		return m.Gender
+	}
+	return Gender_MAN
+}
+
+func (m *Profile) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+func (m *Profile) GetUpdateDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateDate
+	}
+	return nil
+}
+
+func (m *Profile) GetPrivateFields() []string {
+	if m != nil {
+		return m.PrivateFields
+	}
+	return nil
+}
+
+type GetProfileReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Private fields are cleared unless viewer is the owner, empty for anonymous
+	ViewerUid            string   `protobuf:"bytes,2,opt,name=viewerUid,proto3" json:"viewerUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProfileReq) Reset()         { *m = GetProfileReq{} }
+func (m *GetProfileReq) String() string { return proto.CompactTextString(m) }
+func (*GetProfileReq) ProtoMessage()    {}
+func (*GetProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{28}
+}
+func (m *GetProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProfileReq.Unmarshal(m, b)
+}
+func (m *GetProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProfileReq.Marshal(b, m, deterministic)
+}
+func (dst *GetProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProfileReq.Merge(dst, src)
+}
+func (m *GetProfileReq) XXX_Size() int {
+	return xxx_messageInfo_GetProfileReq.Size(m)
+}
+func (m *GetProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProfileReq proto.InternalMessageInfo
+
+func (m *GetProfileReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *GetProfileReq) GetViewerUid() string {
+	if m != nil {
+		return m.ViewerUid
+	}
+	return ""
+}
+
+type BatchGetProfilesReq struct {
+	Uids                 []string `protobuf:"bytes,1,rep,name=uids,proto3" json:"uids,omitempty"`
+	ViewerUid            string   `protobuf:"bytes,2,opt,name=viewerUid,proto3" json:"viewerUid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchGetProfilesReq) Reset()         { *m = BatchGetProfilesReq{} }
+func (m *BatchGetProfilesReq) String() string { return proto.CompactTextString(m) }
+func (*BatchGetProfilesReq) ProtoMessage()    {}
+func (*BatchGetProfilesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{29}
+}
+func (m *BatchGetProfilesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetProfilesReq.Unmarshal(m, b)
+}
+func (m *BatchGetProfilesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetProfilesReq.Marshal(b, m, deterministic)
+}
+func (dst *BatchGetProfilesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetProfilesReq.Merge(dst, src)
+}
+func (m *BatchGetProfilesReq) XXX_Size() int {
+	return xxx_messageInfo_BatchGetProfilesReq.Size(m)
+}
+func (m *BatchGetProfilesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetProfilesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetProfilesReq proto.InternalMessageInfo
+
+func (m *BatchGetProfilesReq) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
+func (m *BatchGetProfilesReq) GetViewerUid() string {
+	if m != nil {
+		return m.ViewerUid
+	}
+	return ""
+}
+
+type BatchGetProfilesResp struct {
+	Profiles             []*Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BatchGetProfilesResp) Reset()         { *m = BatchGetProfilesResp{} }
+func (m *BatchGetProfilesResp) String() string { return proto.CompactTextString(m) }
+func (*BatchGetProfilesResp) ProtoMessage()    {}
+func (*BatchGetProfilesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{30}
+}
+func (m *BatchGetProfilesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetProfilesResp.Unmarshal(m, b)
+}
+func (m *BatchGetProfilesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetProfilesResp.Marshal(b, m, deterministic)
+}
+func (dst *BatchGetProfilesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetProfilesResp.Merge(dst, src)
+}
+func (m *BatchGetProfilesResp) XXX_Size() int {
+	return xxx_messageInfo_BatchGetProfilesResp.Size(m)
+}
+func (m *BatchGetProfilesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetProfilesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetProfilesResp proto.InternalMessageInfo
+
+func (m *BatchGetProfilesResp) GetProfiles() []*Profile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+type UpdateProfileReq struct {
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Fields of profile to update, e.g. "bio", "private_fields"
+	Fields               []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProfileReq) Reset()         { *m = UpdateProfileReq{} }
+func (m *UpdateProfileReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateProfileReq) ProtoMessage()    {}
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{31}
+}
+func (m *UpdateProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProfileReq.Unmarshal(m, b)
+}
+func (m *UpdateProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProfileReq.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProfileReq.Merge(dst, src)
+}
+func (m *UpdateProfileReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateProfileReq.Size(m)
+}
+func (m *UpdateProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProfileReq proto.InternalMessageInfo
+
+func (m *UpdateProfileReq) GetProfile() *Profile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *UpdateProfileReq) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type Session struct {
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Latest access token of the session
+	Jti                  string               `protobuf:"bytes,3,opt,name=jti,proto3" json:"jti,omitempty"`
+	Ip                   string               `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent            string               `protobuf:"bytes,5,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	CreateDate           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	LastActiveDate       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=lastActiveDate,proto3" json:"lastActiveDate,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{32}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (dst *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(dst, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Session) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Session) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
+func (m *Session) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *Session) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *Session) GetCreateDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateDate
+	}
+	return nil
+}
+
+func (m *Session) GetLastActiveDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastActiveDate
+	}
+	return nil
+}
+
+func (m *Session) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type ListSessionsResp struct {
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListSessionsResp) Reset()         { *m = ListSessionsResp{} }
+func (m *ListSessionsResp) String() string { return proto.CompactTextString(m) }
+func (*ListSessionsResp) ProtoMessage()    {}
+func (*ListSessionsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{33}
+}
+func (m *ListSessionsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionsResp.Unmarshal(m, b)
+}
+func (m *ListSessionsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionsResp.Marshal(b, m, deterministic)
+}
+func (dst *ListSessionsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResp.Merge(dst, src)
+}
+func (m *ListSessionsResp) XXX_Size() int {
+	return xxx_messageInfo_ListSessionsResp.Size(m)
+}
+func (m *ListSessionsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionsResp proto.InternalMessageInfo
+
+func (m *ListSessionsResp) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type SessionReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	SessionId            string   `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionReq) Reset()         { *m = SessionReq{} }
+func (m *SessionReq) String() string { return proto.CompactTextString(m) }
+func (*SessionReq) ProtoMessage()    {}
+func (*SessionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{34}
+}
+func (m *SessionReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionReq.Unmarshal(m, b)
+}
+func (m *SessionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionReq.Marshal(b, m, deterministic)
+}
+func (dst *SessionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionReq.Merge(dst, src)
+}
+func (m *SessionReq) XXX_Size() int {
+	return xxx_messageInfo_SessionReq.Size(m)
+}
+func (m *SessionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionReq proto.InternalMessageInfo
+
+func (m *SessionReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SessionReq) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type SetRolesReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Roles                []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRolesReq) Reset()         { *m = SetRolesReq{} }
+func (m *SetRolesReq) String() string { return proto.CompactTextString(m) }
+func (*SetRolesReq) ProtoMessage()    {}
+func (*SetRolesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{35}
+}
+func (m *SetRolesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRolesReq.Unmarshal(m, b)
+}
+func (m *SetRolesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRolesReq.Marshal(b, m, deterministic)
+}
+func (dst *SetRolesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRolesReq.Merge(dst, src)
+}
+func (m *SetRolesReq) XXX_Size() int {
+	return xxx_messageInfo_SetRolesReq.Size(m)
+}
+func (m *SetRolesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRolesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRolesReq proto.InternalMessageInfo
+
+func (m *SetRolesReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *SetRolesReq) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type RolesResp struct {
+	Roles                []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolesResp) Reset()         { *m = RolesResp{} }
+func (m *RolesResp) String() string { return proto.CompactTextString(m) }
+func (*RolesResp) ProtoMessage()    {}
+func (*RolesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{36}
+}
+func (m *RolesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolesResp.Unmarshal(m, b)
+}
+func (m *RolesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolesResp.Marshal(b, m, deterministic)
+}
+func (dst *RolesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolesResp.Merge(dst, src)
+}
+func (m *RolesResp) XXX_Size() int {
+	return xxx_messageInfo_RolesResp.Size(m)
+}
+func (m *RolesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolesResp proto.InternalMessageInfo
+
+func (m *RolesResp) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type VerifyEmailReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Only verified while it's still the email of the account
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailReq) Reset()         { *m = VerifyEmailReq{} }
+func (m *VerifyEmailReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailReq) ProtoMessage()    {}
+func (*VerifyEmailReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{37}
+}
+func (m *VerifyEmailReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailReq.Unmarshal(m, b)
+}
+func (m *VerifyEmailReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailReq.Marshal(b, m, deterministic)
+}
+func (dst *VerifyEmailReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailReq.Merge(dst, src)
+}
+func (m *VerifyEmailReq) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailReq.Size(m)
+}
+func (m *VerifyEmailReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailReq proto.InternalMessageInfo
+
+func (m *VerifyEmailReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *VerifyEmailReq) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type StartEmailChangeReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartEmailChangeReq) Reset()         { *m = StartEmailChangeReq{} }
+func (m *StartEmailChangeReq) String() string { return proto.CompactTextString(m) }
+func (*StartEmailChangeReq) ProtoMessage()    {}
+func (*StartEmailChangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{38}
+}
+func (m *StartEmailChangeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartEmailChangeReq.Unmarshal(m, b)
+}
+func (m *StartEmailChangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartEmailChangeReq.Marshal(b, m, deterministic)
+}
+func (dst *StartEmailChangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartEmailChangeReq.Merge(dst, src)
+}
+func (m *StartEmailChangeReq) XXX_Size() int {
+	return xxx_messageInfo_StartEmailChangeReq.Size(m)
+}
+func (m *StartEmailChangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartEmailChangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartEmailChangeReq proto.InternalMessageInfo
+
+func (m *StartEmailChangeReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *StartEmailChangeReq) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *StartEmailChangeReq) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type ConfirmEmailChangeReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Confirmed by the current address, otherwise by the new one
+	OldAddress           bool     `protobuf:"varint,2,opt,name=oldAddress,proto3" json:"oldAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailChangeReq) Reset()         { *m = ConfirmEmailChangeReq{} }
+func (m *ConfirmEmailChangeReq) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailChangeReq) ProtoMessage()    {}
+func (*ConfirmEmailChangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{39}
+}
+func (m *ConfirmEmailChangeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailChangeReq.Unmarshal(m, b)
+}
+func (m *ConfirmEmailChangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailChangeReq.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmEmailChangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailChangeReq.Merge(dst, src)
+}
+func (m *ConfirmEmailChangeReq) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailChangeReq.Size(m)
+}
+func (m *ConfirmEmailChangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailChangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailChangeReq proto.InternalMessageInfo
+
+func (m *ConfirmEmailChangeReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ConfirmEmailChangeReq) GetOldAddress() bool {
+	if m != nil {
+		return m.OldAddress
+	}
+	return false
+}
+
+type ConfirmEmailChangeResp struct {
+	// Both addresses confirmed and the email switched
+	Done                 bool     `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Account              *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmEmailChangeResp) Reset()         { *m = ConfirmEmailChangeResp{} }
+func (m *ConfirmEmailChangeResp) String() string { return proto.CompactTextString(m) }
+func (*ConfirmEmailChangeResp) ProtoMessage()    {}
+func (*ConfirmEmailChangeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{40}
+}
+func (m *ConfirmEmailChangeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmEmailChangeResp.Unmarshal(m, b)
+}
+func (m *ConfirmEmailChangeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmEmailChangeResp.Marshal(b, m, deterministic)
+}
+func (dst *ConfirmEmailChangeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmEmailChangeResp.Merge(dst, src)
+}
+func (m *ConfirmEmailChangeResp) XXX_Size() int {
+	return xxx_messageInfo_ConfirmEmailChangeResp.Size(m)
+}
+func (m *ConfirmEmailChangeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmEmailChangeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmEmailChangeResp proto.InternalMessageInfo
+
+func (m *ConfirmEmailChangeResp) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *ConfirmEmailChangeResp) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type RequestDeletionReq struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Not needed by account without password, e.g. registered by oauth
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Caller has checked a code sent to the email, or a fresh sign in with a
+	// linked provider, of an account without password
+	Reauthenticated      bool     `protobuf:"varint,3,opt,name=reauthenticated,proto3" json:"reauthenticated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestDeletionReq) Reset()         { *m = RequestDeletionReq{} }
+func (m *RequestDeletionReq) String() string { return proto.CompactTextString(m) }
+func (*RequestDeletionReq) ProtoMessage()    {}
+func (*RequestDeletionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{41}
+}
+func (m *RequestDeletionReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestDeletionReq.Unmarshal(m, b)
+}
+func (m *RequestDeletionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestDeletionReq.Marshal(b, m, deterministic)
+}
+func (dst *RequestDeletionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDeletionReq.Merge(dst, src)
+}
+func (m *RequestDeletionReq) XXX_Size() int {
+	return xxx_messageInfo_RequestDeletionReq.Size(m)
+}
+func (m *RequestDeletionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDeletionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestDeletionReq proto.InternalMessageInfo
+
+func (m *RequestDeletionReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RequestDeletionReq) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RequestDeletionReq) GetReauthenticated() bool {
+	if m != nil {
+		return m.Reauthenticated
+	}
+	return false
+}
+
+type DeletionStep struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Done                 bool                 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	DoneDate             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=doneDate,proto3" json:"doneDate,omitempty"`
+	Attempts             int64                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeletionStep) Reset()         { *m = DeletionStep{} }
+func (m *DeletionStep) String() string { return proto.CompactTextString(m) }
+func (*DeletionStep) ProtoMessage()    {}
+func (*DeletionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{42}
+}
+func (m *DeletionStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletionStep.Unmarshal(m, b)
+}
+func (m *DeletionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletionStep.Marshal(b, m, deterministic)
+}
+func (dst *DeletionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletionStep.Merge(dst, src)
+}
+func (m *DeletionStep) XXX_Size() int {
+	return xxx_messageInfo_DeletionStep.Size(m)
+}
+func (m *DeletionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletionStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletionStep proto.InternalMessageInfo
+
+func (m *DeletionStep) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeletionStep) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *DeletionStep) GetDoneDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.DoneDate
+	}
+	return nil
+}
+
+func (m *DeletionStep) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeletionStep) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type AccountDeletion struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RequestDate          *timestamp.Timestamp `protobuf:"bytes,2,opt,name=requestDate,proto3" json:"requestDate,omitempty"`
+	ScheduledDate        *timestamp.Timestamp `protobuf:"bytes,3,opt,name=scheduledDate,proto3" json:"scheduledDate,omitempty"`
+	Done                 bool                 `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	DoneDate             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=doneDate,proto3" json:"doneDate,omitempty"`
+	Steps                []*DeletionStep      `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccountDeletion) Reset()         { *m = AccountDeletion{} }
+func (m *AccountDeletion) String() string { return proto.CompactTextString(m) }
+func (*AccountDeletion) ProtoMessage()    {}
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{43}
+}
+func (m *AccountDeletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDeletion.Unmarshal(m, b)
+}
+func (m *AccountDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDeletion.Marshal(b, m, deterministic)
+}
+func (dst *AccountDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDeletion.Merge(dst, src)
+}
+func (m *AccountDeletion) XXX_Size() int {
+	return xxx_messageInfo_AccountDeletion.Size(m)
+}
+func (m *AccountDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDeletion proto.InternalMessageInfo
+
+func (m *AccountDeletion) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *AccountDeletion) GetRequestDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.RequestDate
+	}
+	return nil
+}
+
+func (m *AccountDeletion) GetScheduledDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScheduledDate
+	}
+	return nil
+}
+
+func (m *AccountDeletion) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *AccountDeletion) GetDoneDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.DoneDate
+	}
+	return nil
+}
+
+func (m *AccountDeletion) GetSteps() []*DeletionStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type OAuthClient struct {
+	ClientId string `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Roles the client may request as scopes
+	Scopes     []string             `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreateDate *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	UpdateDate *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updateDate,proto3" json:"updateDate,omitempty"`
+	// Where codes of the authorization code grant may be sent, exact match
+	RedirectUris         []string `protobuf:"bytes,6,rep,name=redirectUris,proto3" json:"redirectUris,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OAuthClient) Reset()         { *m = OAuthClient{} }
+func (m *OAuthClient) String() string { return proto.CompactTextString(m) }
+func (*OAuthClient) ProtoMessage()    {}
+func (*OAuthClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{44}
+}
+func (m *OAuthClient) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OAuthClient.Unmarshal(m, b)
+}
+func (m *OAuthClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OAuthClient.Marshal(b, m, deterministic)
+}
+func (dst *OAuthClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthClient.Merge(dst, src)
+}
+func (m *OAuthClient) XXX_Size() int {
+	return xxx_messageInfo_OAuthClient.Size(m)
+}
+func (m *OAuthClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthClient proto.InternalMessageInfo
+
+func (m *OAuthClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *OAuthClient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OAuthClient) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetCreateDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateDate
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetUpdateDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateDate
+	}
+	return nil
+}
+
+func (m *OAuthClient) GetRedirectUris() []string {
+	if m != nil {
+		return m.RedirectUris
+	}
+	return nil
+}
+
+type CreateClientReq struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RedirectUris         []string `protobuf:"bytes,3,rep,name=redirectUris,proto3" json:"redirectUris,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateClientReq) Reset()         { *m = CreateClientReq{} }
+func (m *CreateClientReq) String() string { return proto.CompactTextString(m) }
+func (*CreateClientReq) ProtoMessage()    {}
+func (*CreateClientReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{45}
+}
+func (m *CreateClientReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateClientReq.Unmarshal(m, b)
+}
+func (m *CreateClientReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateClientReq.Marshal(b, m, deterministic)
+}
+func (dst *CreateClientReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClientReq.Merge(dst, src)
+}
+func (m *CreateClientReq) XXX_Size() int {
+	return xxx_messageInfo_CreateClientReq.Size(m)
+}
+func (m *CreateClientReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClientReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClientReq proto.InternalMessageInfo
+
+func (m *CreateClientReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateClientReq) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *CreateClientReq) GetRedirectUris() []string {
+	if m != nil {
+		return m.RedirectUris
+	}
+	return nil
+}
+
+type CreateClientResp struct {
+	Client               *OAuthClient `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	Secret               string       `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateClientResp) Reset()         { *m = CreateClientResp{} }
+func (m *CreateClientResp) String() string { return proto.CompactTextString(m) }
+func (*CreateClientResp) ProtoMessage()    {}
+func (*CreateClientResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{46}
+}
+func (m *CreateClientResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateClientResp.Unmarshal(m, b)
+}
+func (m *CreateClientResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateClientResp.Marshal(b, m, deterministic)
+}
+func (dst *CreateClientResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClientResp.Merge(dst, src)
+}
+func (m *CreateClientResp) XXX_Size() int {
+	return xxx_messageInfo_CreateClientResp.Size(m)
+}
+func (m *CreateClientResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClientResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClientResp proto.InternalMessageInfo
+
+func (m *CreateClientResp) GetClient() *OAuthClient {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *CreateClientResp) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type ClientsResp struct {
+	Items                []*OAuthClient `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ClientsResp) Reset()         { *m = ClientsResp{} }
+func (m *ClientsResp) String() string { return proto.CompactTextString(m) }
+func (*ClientsResp) ProtoMessage()    {}
+func (*ClientsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{47}
+}
+func (m *ClientsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientsResp.Unmarshal(m, b)
+}
+func (m *ClientsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientsResp.Marshal(b, m, deterministic)
+}
+func (dst *ClientsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientsResp.Merge(dst, src)
+}
+func (m *ClientsResp) XXX_Size() int {
+	return xxx_messageInfo_ClientsResp.Size(m)
+}
+func (m *ClientsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientsResp proto.InternalMessageInfo
+
+func (m *ClientsResp) GetItems() []*OAuthClient {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ClientIDReq struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClientIDReq) Reset()         { *m = ClientIDReq{} }
+func (m *ClientIDReq) String() string { return proto.CompactTextString(m) }
+func (*ClientIDReq) ProtoMessage()    {}
+func (*ClientIDReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{48}
+}
+func (m *ClientIDReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientIDReq.Unmarshal(m, b)
+}
+func (m *ClientIDReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientIDReq.Marshal(b, m, deterministic)
+}
+func (dst *ClientIDReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientIDReq.Merge(dst, src)
+}
+func (m *ClientIDReq) XXX_Size() int {
+	return xxx_messageInfo_ClientIDReq.Size(m)
+}
+func (m *ClientIDReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientIDReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientIDReq proto.InternalMessageInfo
+
+func (m *ClientIDReq) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type VerifyClientReq struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyClientReq) Reset()         { *m = VerifyClientReq{} }
+func (m *VerifyClientReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyClientReq) ProtoMessage()    {}
+func (*VerifyClientReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{49}
+}
+func (m *VerifyClientReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyClientReq.Unmarshal(m, b)
+}
+func (m *VerifyClientReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyClientReq.Marshal(b, m, deterministic)
+}
+func (dst *VerifyClientReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyClientReq.Merge(dst, src)
+}
+func (m *VerifyClientReq) XXX_Size() int {
+	return xxx_messageInfo_VerifyClientReq.Size(m)
+}
+func (m *VerifyClientReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyClientReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyClientReq proto.InternalMessageInfo
+
+func (m *VerifyClientReq) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *VerifyClientReq) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type PersonalToken struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid  string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Scopes of personal tokens, not roles, see gin_jwt.PersonalTokenScopes
+	Scopes               []string             `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreateDate           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createDate,proto3" json:"createDate,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	LastUsedTime         *timestamp.Timestamp `protobuf:"bytes,7,opt,name=lastUsedTime,proto3" json:"lastUsedTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PersonalToken) Reset()         { *m = PersonalToken{} }
+func (m *PersonalToken) String() string { return proto.CompactTextString(m) }
+func (*PersonalToken) ProtoMessage()    {}
+func (*PersonalToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{50}
+}
+func (m *PersonalToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalToken.Unmarshal(m, b)
+}
+func (m *PersonalToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalToken.Marshal(b, m, deterministic)
+}
+func (dst *PersonalToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalToken.Merge(dst, src)
+}
+func (m *PersonalToken) XXX_Size() int {
+	return xxx_messageInfo_PersonalToken.Size(m)
+}
+func (m *PersonalToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalToken proto.InternalMessageInfo
+
+func (m *PersonalToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PersonalToken) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *PersonalToken) GetCreateDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateDate
+	}
+	return nil
+}
+
+func (m *PersonalToken) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *PersonalToken) GetLastUsedTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedTime
+	}
+	return nil
+}
+
+type CreatePersonalTokenReq struct {
+	Uid                  string               `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []string             `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreatePersonalTokenReq) Reset()         { *m = CreatePersonalTokenReq{} }
+func (m *CreatePersonalTokenReq) String() string { return proto.CompactTextString(m) }
+func (*CreatePersonalTokenReq) ProtoMessage()    {}
+func (*CreatePersonalTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{51}
+}
+func (m *CreatePersonalTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePersonalTokenReq.Unmarshal(m, b)
+}
+func (m *CreatePersonalTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePersonalTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *CreatePersonalTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePersonalTokenReq.Merge(dst, src)
+}
+func (m *CreatePersonalTokenReq) XXX_Size() int {
+	return xxx_messageInfo_CreatePersonalTokenReq.Size(m)
+}
+func (m *CreatePersonalTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePersonalTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePersonalTokenReq proto.InternalMessageInfo
+
+func (m *CreatePersonalTokenReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *CreatePersonalTokenReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreatePersonalTokenReq) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *CreatePersonalTokenReq) GetExpireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+type CreatePersonalTokenResp struct {
+	Token                *PersonalToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Value                string         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreatePersonalTokenResp) Reset()         { *m = CreatePersonalTokenResp{} }
+func (m *CreatePersonalTokenResp) String() string { return proto.CompactTextString(m) }
+func (*CreatePersonalTokenResp) ProtoMessage()    {}
+func (*CreatePersonalTokenResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{52}
+}
+func (m *CreatePersonalTokenResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePersonalTokenResp.Unmarshal(m, b)
+}
+func (m *CreatePersonalTokenResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePersonalTokenResp.Marshal(b, m, deterministic)
+}
+func (dst *CreatePersonalTokenResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePersonalTokenResp.Merge(dst, src)
+}
+func (m *CreatePersonalTokenResp) XXX_Size() int {
+	return xxx_messageInfo_CreatePersonalTokenResp.Size(m)
+}
+func (m *CreatePersonalTokenResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePersonalTokenResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePersonalTokenResp proto.InternalMessageInfo
+
+func (m *CreatePersonalTokenResp) GetToken() *PersonalToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *CreatePersonalTokenResp) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PersonalTokensResp struct {
+	Items                []*PersonalToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PersonalTokensResp) Reset()         { *m = PersonalTokensResp{} }
+func (m *PersonalTokensResp) String() string { return proto.CompactTextString(m) }
+func (*PersonalTokensResp) ProtoMessage()    {}
+func (*PersonalTokensResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{53}
+}
+func (m *PersonalTokensResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalTokensResp.Unmarshal(m, b)
+}
+func (m *PersonalTokensResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalTokensResp.Marshal(b, m, deterministic)
+}
+func (dst *PersonalTokensResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalTokensResp.Merge(dst, src)
+}
+func (m *PersonalTokensResp) XXX_Size() int {
+	return xxx_messageInfo_PersonalTokensResp.Size(m)
+}
+func (m *PersonalTokensResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalTokensResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalTokensResp proto.InternalMessageInfo
+
+func (m *PersonalTokensResp) GetItems() []*PersonalToken {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type PersonalTokenReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PersonalTokenReq) Reset()         { *m = PersonalTokenReq{} }
+func (m *PersonalTokenReq) String() string { return proto.CompactTextString(m) }
+func (*PersonalTokenReq) ProtoMessage()    {}
+func (*PersonalTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{54}
+}
+func (m *PersonalTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersonalTokenReq.Unmarshal(m, b)
+}
+func (m *PersonalTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersonalTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *PersonalTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonalTokenReq.Merge(dst, src)
+}
+func (m *PersonalTokenReq) XXX_Size() int {
+	return xxx_messageInfo_PersonalTokenReq.Size(m)
+}
+func (m *PersonalTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonalTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonalTokenReq proto.InternalMessageInfo
+
+func (m *PersonalTokenReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PersonalTokenReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type VerifyPersonalTokenReq struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPersonalTokenReq) Reset()         { *m = VerifyPersonalTokenReq{} }
+func (m *VerifyPersonalTokenReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyPersonalTokenReq) ProtoMessage()    {}
+func (*VerifyPersonalTokenReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{55}
+}
+func (m *VerifyPersonalTokenReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPersonalTokenReq.Unmarshal(m, b)
+}
+func (m *VerifyPersonalTokenReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPersonalTokenReq.Marshal(b, m, deterministic)
+}
+func (dst *VerifyPersonalTokenReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPersonalTokenReq.Merge(dst, src)
+}
+func (m *VerifyPersonalTokenReq) XXX_Size() int {
+	return xxx_messageInfo_VerifyPersonalTokenReq.Size(m)
+}
+func (m *VerifyPersonalTokenReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPersonalTokenReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPersonalTokenReq proto.InternalMessageInfo
+
+func (m *VerifyPersonalTokenReq) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type LinkOAuthReq struct {
+	Uid           string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	OauthProvider string `protobuf:"bytes,2,opt,name=oauthProvider,proto3" json:"oauthProvider,omitempty"`
+	OauthUID      string `protobuf:"bytes,3,opt,name=oauthUID,proto3" json:"oauthUID,omitempty"`
+	// Takes the identity over from the account it belongs to, which is then
+	// deleted. Only done when that account has no other login method.
+	Merge                bool     `protobuf:"varint,4,opt,name=merge,proto3" json:"merge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkOAuthReq) Reset()         { *m = LinkOAuthReq{} }
+func (m *LinkOAuthReq) String() string { return proto.CompactTextString(m) }
+func (*LinkOAuthReq) ProtoMessage()    {}
+func (*LinkOAuthReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{56}
+}
+func (m *LinkOAuthReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkOAuthReq.Unmarshal(m, b)
+}
+func (m *LinkOAuthReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkOAuthReq.Marshal(b, m, deterministic)
+}
+func (dst *LinkOAuthReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkOAuthReq.Merge(dst, src)
+}
+func (m *LinkOAuthReq) XXX_Size() int {
+	return xxx_messageInfo_LinkOAuthReq.Size(m)
+}
+func (m *LinkOAuthReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkOAuthReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkOAuthReq proto.InternalMessageInfo
+
+func (m *LinkOAuthReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *LinkOAuthReq) GetOauthProvider() string {
+	if m != nil {
+		return m.OauthProvider
+	}
+	return ""
+}
+
+func (m *LinkOAuthReq) GetOauthUID() string {
+	if m != nil {
+		return m.OauthUID
+	}
+	return ""
+}
+
+func (m *LinkOAuthReq) GetMerge() bool {
+	if m != nil {
+		return m.Merge
+	}
+	return false
+}
+
+type UnlinkOAuthReq struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	OauthProvider        string   `protobuf:"bytes,2,opt,name=oauthProvider,proto3" json:"oauthProvider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlinkOAuthReq) Reset()         { *m = UnlinkOAuthReq{} }
+func (m *UnlinkOAuthReq) String() string { return proto.CompactTextString(m) }
+func (*UnlinkOAuthReq) ProtoMessage()    {}
+func (*UnlinkOAuthReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_uaa_d539f0198393248f, []int{57}
+}
+func (m *UnlinkOAuthReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkOAuthReq.Unmarshal(m, b)
+}
+func (m *UnlinkOAuthReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkOAuthReq.Marshal(b, m, deterministic)
+}
+func (dst *UnlinkOAuthReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkOAuthReq.Merge(dst, src)
+}
+func (m *UnlinkOAuthReq) XXX_Size() int {
+	return xxx_messageInfo_UnlinkOAuthReq.Size(m)
+}
+func (m *UnlinkOAuthReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkOAuthReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlinkOAuthReq proto.InternalMessageInfo
+
+func (m *UnlinkOAuthReq) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *UnlinkOAuthReq) GetOauthProvider() string {
+	if m != nil {
+		return m.OauthProvider
+	}
+	return ""
 }
 
 func init() {
+	proto.RegisterType((*ErrorDetail)(nil), "teddy.srv.uaa.ErrorDetail")
 	proto.RegisterType((*Account)(nil), "teddy.srv.uaa.Account")
 	proto.RegisterMapType((map[string]string)(nil), "teddy.srv.uaa.Account.OauthUIDsEntry")
 	proto.RegisterType((*Sort)(nil), "teddy.srv.uaa.Sort")
 	proto.RegisterType((*UIDReq)(nil), "teddy.srv.uaa.UIDReq")
+	proto.RegisterType((*LockAccountReq)(nil), "teddy.srv.uaa.LockAccountReq")
+	proto.RegisterType((*CredentialsExpiredReq)(nil), "teddy.srv.uaa.CredentialsExpiredReq")
 	proto.RegisterType((*GetAllReq)(nil), "teddy.srv.uaa.GetAllReq")
 	proto.RegisterType((*GetOneReq)(nil), "teddy.srv.uaa.GetOneReq")
 	proto.RegisterType((*GetAllResp)(nil), "teddy.srv.uaa.GetAllResp")
@@ -754,325 +3545,1546 @@ func init() {
 	proto.RegisterType((*RegisterOAuthReq)(nil), "teddy.srv.uaa.RegisterOAuthReq")
 	proto.RegisterType((*VerifyAccountReq)(nil), "teddy.srv.uaa.VerifyAccountReq")
 	proto.RegisterType((*ChangePasswordReq)(nil), "teddy.srv.uaa.ChangePasswordReq")
+	proto.RegisterType((*ResetPasswordReq)(nil), "teddy.srv.uaa.ResetPasswordReq")
+	proto.RegisterType((*CheckPasswordReq)(nil), "teddy.srv.uaa.CheckPasswordReq")
 	proto.RegisterType((*UpdateSignInReq)(nil), "teddy.srv.uaa.UpdateSignInReq")
+	proto.RegisterType((*IssueRefreshTokenReq)(nil), "teddy.srv.uaa.IssueRefreshTokenReq")
+	proto.RegisterType((*RefreshToken)(nil), "teddy.srv.uaa.RefreshToken")
+	proto.RegisterType((*RefreshTokenReq)(nil), "teddy.srv.uaa.RefreshTokenReq")
+	proto.RegisterType((*RotateRefreshTokenResp)(nil), "teddy.srv.uaa.RotateRefreshTokenResp")
+	proto.RegisterType((*RevokeTokenReq)(nil), "teddy.srv.uaa.RevokeTokenReq")
+	proto.RegisterType((*UseTokenReq)(nil), "teddy.srv.uaa.UseTokenReq")
+	proto.RegisterType((*IsTokenRevokedReq)(nil), "teddy.srv.uaa.IsTokenRevokedReq")
+	proto.RegisterType((*IsTokenRevokedResp)(nil), "teddy.srv.uaa.IsTokenRevokedResp")
+	proto.RegisterType((*EnrollTOTPResp)(nil), "teddy.srv.uaa.EnrollTOTPResp")
+	proto.RegisterType((*TOTPCodeReq)(nil), "teddy.srv.uaa.TOTPCodeReq")
+	proto.RegisterType((*RecoveryCodesResp)(nil), "teddy.srv.uaa.RecoveryCodesResp")
+	proto.RegisterType((*Profile)(nil), "teddy.srv.uaa.Profile")
+	proto.RegisterType((*GetProfileReq)(nil), "teddy.srv.uaa.GetProfileReq")
+	proto.RegisterType((*BatchGetProfilesReq)(nil), "teddy.srv.uaa.BatchGetProfilesReq")
+	proto.RegisterType((*BatchGetProfilesResp)(nil), "teddy.srv.uaa.BatchGetProfilesResp")
+	proto.RegisterType((*UpdateProfileReq)(nil), "teddy.srv.uaa.UpdateProfileReq")
+	proto.RegisterType((*Session)(nil), "teddy.srv.uaa.Session")
+	proto.RegisterType((*ListSessionsResp)(nil), "teddy.srv.uaa.ListSessionsResp")
+	proto.RegisterType((*SessionReq)(nil), "teddy.srv.uaa.SessionReq")
+	proto.RegisterType((*SetRolesReq)(nil), "teddy.srv.uaa.SetRolesReq")
+	proto.RegisterType((*RolesResp)(nil), "teddy.srv.uaa.RolesResp")
+	proto.RegisterType((*VerifyEmailReq)(nil), "teddy.srv.uaa.VerifyEmailReq")
+	proto.RegisterType((*StartEmailChangeReq)(nil), "teddy.srv.uaa.StartEmailChangeReq")
+	proto.RegisterType((*ConfirmEmailChangeReq)(nil), "teddy.srv.uaa.ConfirmEmailChangeReq")
+	proto.RegisterType((*ConfirmEmailChangeResp)(nil), "teddy.srv.uaa.ConfirmEmailChangeResp")
+	proto.RegisterType((*RequestDeletionReq)(nil), "teddy.srv.uaa.RequestDeletionReq")
+	proto.RegisterType((*DeletionStep)(nil), "teddy.srv.uaa.DeletionStep")
+	proto.RegisterType((*AccountDeletion)(nil), "teddy.srv.uaa.AccountDeletion")
+	proto.RegisterType((*OAuthClient)(nil), "teddy.srv.uaa.OAuthClient")
+	proto.RegisterType((*CreateClientReq)(nil), "teddy.srv.uaa.CreateClientReq")
+	proto.RegisterType((*CreateClientResp)(nil), "teddy.srv.uaa.CreateClientResp")
+	proto.RegisterType((*ClientsResp)(nil), "teddy.srv.uaa.ClientsResp")
+	proto.RegisterType((*ClientIDReq)(nil), "teddy.srv.uaa.ClientIDReq")
+	proto.RegisterType((*VerifyClientReq)(nil), "teddy.srv.uaa.VerifyClientReq")
+	proto.RegisterType((*PersonalToken)(nil), "teddy.srv.uaa.PersonalToken")
+	proto.RegisterType((*CreatePersonalTokenReq)(nil), "teddy.srv.uaa.CreatePersonalTokenReq")
+	proto.RegisterType((*CreatePersonalTokenResp)(nil), "teddy.srv.uaa.CreatePersonalTokenResp")
+	proto.RegisterType((*PersonalTokensResp)(nil), "teddy.srv.uaa.PersonalTokensResp")
+	proto.RegisterType((*PersonalTokenReq)(nil), "teddy.srv.uaa.PersonalTokenReq")
+	proto.RegisterType((*VerifyPersonalTokenReq)(nil), "teddy.srv.uaa.VerifyPersonalTokenReq")
+	proto.RegisterType((*LinkOAuthReq)(nil), "teddy.srv.uaa.LinkOAuthReq")
+	proto.RegisterType((*UnlinkOAuthReq)(nil), "teddy.srv.uaa.UnlinkOAuthReq")
+	proto.RegisterEnum("teddy.srv.uaa.ErrorReason", ErrorReason_name, ErrorReason_value)
+	proto.RegisterEnum("teddy.srv.uaa.BoolFilter", BoolFilter_name, BoolFilter_value)
+	proto.RegisterEnum("teddy.srv.uaa.Gender", Gender_name, Gender_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UAAClient is the client API for UAA service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UAAClient interface {
+	GetAll(ctx context.Context, in *GetAllReq, opts ...grpc.CallOption) (*GetAllResp, error)
+	GetOne(ctx context.Context, in *GetOneReq, opts ...grpc.CallOption) (*Account, error)
+	RegisterByNormal(ctx context.Context, in *RegisterNormalReq, opts ...grpc.CallOption) (*Account, error)
+	RegisterByOAuth(ctx context.Context, in *RegisterOAuthReq, opts ...grpc.CallOption) (*Account, error)
+	VerifyPassword(ctx context.Context, in *VerifyAccountReq, opts ...grpc.CallOption) (*Account, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Checks a new password against the policy before a one-time code is used
+	CheckPassword(ctx context.Context, in *CheckPasswordReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	UpdateSignIn(ctx context.Context, in *UpdateSignInReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteOne(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoLockAccount(ctx context.Context, in *LockAccountReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoUnlockAccount(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoCredentialsExpired(ctx context.Context, in *CredentialsExpiredReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	DoRenewCredentials(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	IssueRefreshToken(ctx context.Context, in *IssueRefreshTokenReq, opts ...grpc.CallOption) (*RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RotateRefreshTokenResp, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	RevokeAllTokens(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	IsTokenRevoked(ctx context.Context, in *IsTokenRevokedReq, opts ...grpc.CallOption) (*IsTokenRevokedResp, error)
+	// One-time tokens, e.g. authorization codes, fail with AlreadyExists once used
+	UseToken(ctx context.Context, in *UseTokenReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRoles(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*RolesResp, error)
+	SetRoles(ctx context.Context, in *SetRolesReq, opts ...grpc.CallOption) (*RolesResp, error)
+	ListSessions(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*ListSessionsResp, error)
+	RevokeSession(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	RevokeOtherSessions(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	EnrollTOTP(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ConfirmTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	VerifyTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*Account, error)
+	DisableTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	GetProfile(ctx context.Context, in *GetProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	BatchGetProfiles(ctx context.Context, in *BatchGetProfilesReq, opts ...grpc.CallOption) (*BatchGetProfilesResp, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	StartEmailChange(ctx context.Context, in *StartEmailChangeReq, opts ...grpc.CallOption) (*Account, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeReq, opts ...grpc.CallOption) (*ConfirmEmailChangeResp, error)
+	// Deleted after the grace period, DeleteOne deletes without one
+	RequestDeletion(ctx context.Context, in *RequestDeletionReq, opts ...grpc.CallOption) (*AccountDeletion, error)
+	CancelDeletion(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetDeletion(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*AccountDeletion, error)
+	// Clients of the client_credentials grant, the secret is only returned
+	// when created
+	CreateClient(ctx context.Context, in *CreateClientReq, opts ...grpc.CallOption) (*CreateClientResp, error)
+	GetClients(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ClientsResp, error)
+	DeleteClient(ctx context.Context, in *ClientIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	VerifyClient(ctx context.Context, in *VerifyClientReq, opts ...grpc.CallOption) (*OAuthClient, error)
+	GetClient(ctx context.Context, in *ClientIDReq, opts ...grpc.CallOption) (*OAuthClient, error)
+	// Personal access tokens, the value is only returned when created
+	CreatePersonalToken(ctx context.Context, in *CreatePersonalTokenReq, opts ...grpc.CallOption) (*CreatePersonalTokenResp, error)
+	ListPersonalTokens(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*PersonalTokensResp, error)
+	RevokePersonalToken(ctx context.Context, in *PersonalTokenReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	VerifyPersonalToken(ctx context.Context, in *VerifyPersonalTokenReq, opts ...grpc.CallOption) (*PersonalToken, error)
+	// Login methods of an account, the last one can't be unlinked
+	LinkOAuth(ctx context.Context, in *LinkOAuthReq, opts ...grpc.CallOption) (*Account, error)
+	UnlinkOAuth(ctx context.Context, in *UnlinkOAuthReq, opts ...grpc.CallOption) (*Account, error)
+}
+
+type uAAClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUAAClient(cc *grpc.ClientConn) UAAClient {
+	return &uAAClient{cc}
+}
+
+func (c *uAAClient) GetAll(ctx context.Context, in *GetAllReq, opts ...grpc.CallOption) (*GetAllResp, error) {
+	out := new(GetAllResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetOne(ctx context.Context, in *GetOneReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetOne", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RegisterByNormal(ctx context.Context, in *RegisterNormalReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RegisterByNormal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RegisterByOAuth(ctx context.Context, in *RegisterOAuthReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RegisterByOAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) VerifyPassword(ctx context.Context, in *VerifyAccountReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) CheckPassword(ctx context.Context, in *CheckPasswordReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/CheckPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) UpdateSignIn(ctx context.Context, in *UpdateSignInReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/UpdateSignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DeleteOne(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DeleteOne", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DoLockAccount(ctx context.Context, in *LockAccountReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoLockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DoUnlockAccount(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoUnlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DoCredentialsExpired(ctx context.Context, in *CredentialsExpiredReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoCredentialsExpired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DoRenewCredentials(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoRenewCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) IssueRefreshToken(ctx context.Context, in *IssueRefreshTokenReq, opts ...grpc.CallOption) (*RefreshToken, error) {
+	out := new(RefreshToken)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/IssueRefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RotateRefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RotateRefreshTokenResp, error) {
+	out := new(RotateRefreshTokenResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RotateRefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RevokeAllTokens(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RevokeAllTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) IsTokenRevoked(ctx context.Context, in *IsTokenRevokedReq, opts ...grpc.CallOption) (*IsTokenRevokedResp, error) {
+	out := new(IsTokenRevokedResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/IsTokenRevoked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) UseToken(ctx context.Context, in *UseTokenReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/UseToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetRoles(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*RolesResp, error) {
+	out := new(RolesResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) SetRoles(ctx context.Context, in *SetRolesReq, opts ...grpc.CallOption) (*RolesResp, error) {
+	out := new(RolesResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/SetRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ListSessions(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*ListSessionsResp, error) {
+	out := new(ListSessionsResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RevokeSession(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RevokeOtherSessions(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RevokeOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) EnrollTOTP(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ConfirmTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) VerifyTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DisableTOTP(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RegenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetProfile(ctx context.Context, in *GetProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) BatchGetProfiles(ctx context.Context, in *BatchGetProfilesReq, opts ...grpc.CallOption) (*BatchGetProfilesResp, error) {
+	out := new(BatchGetProfilesResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/BatchGetProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) StartEmailChange(ctx context.Context, in *StartEmailChangeReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/StartEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeReq, opts ...grpc.CallOption) (*ConfirmEmailChangeResp, error) {
+	out := new(ConfirmEmailChangeResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ConfirmEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RequestDeletion(ctx context.Context, in *RequestDeletionReq, opts ...grpc.CallOption) (*AccountDeletion, error) {
+	out := new(AccountDeletion)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RequestDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) CancelDeletion(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/CancelDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetDeletion(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*AccountDeletion, error) {
+	out := new(AccountDeletion)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) CreateClient(ctx context.Context, in *CreateClientReq, opts ...grpc.CallOption) (*CreateClientResp, error) {
+	out := new(CreateClientResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/CreateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetClients(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ClientsResp, error) {
+	out := new(ClientsResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) DeleteClient(ctx context.Context, in *ClientIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DeleteClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) VerifyClient(ctx context.Context, in *VerifyClientReq, opts ...grpc.CallOption) (*OAuthClient, error) {
+	out := new(OAuthClient)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) GetClient(ctx context.Context, in *ClientIDReq, opts ...grpc.CallOption) (*OAuthClient, error) {
+	out := new(OAuthClient)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) CreatePersonalToken(ctx context.Context, in *CreatePersonalTokenReq, opts ...grpc.CallOption) (*CreatePersonalTokenResp, error) {
+	out := new(CreatePersonalTokenResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/CreatePersonalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) ListPersonalTokens(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*PersonalTokensResp, error) {
+	out := new(PersonalTokensResp)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ListPersonalTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) RevokePersonalToken(ctx context.Context, in *PersonalTokenReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RevokePersonalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) VerifyPersonalToken(ctx context.Context, in *VerifyPersonalTokenReq, opts ...grpc.CallOption) (*PersonalToken, error) {
+	out := new(PersonalToken)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyPersonalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) LinkOAuth(ctx context.Context, in *LinkOAuthReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/LinkOAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uAAClient) UnlinkOAuth(ctx context.Context, in *UnlinkOAuthReq, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/UnlinkOAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UAAServer is the server API for UAA service.
+type UAAServer interface {
+	GetAll(context.Context, *GetAllReq) (*GetAllResp, error)
+	GetOne(context.Context, *GetOneReq) (*Account, error)
+	RegisterByNormal(context.Context, *RegisterNormalReq) (*Account, error)
+	RegisterByOAuth(context.Context, *RegisterOAuthReq) (*Account, error)
+	VerifyPassword(context.Context, *VerifyAccountReq) (*Account, error)
+	ChangePassword(context.Context, *ChangePasswordReq) (*empty.Empty, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*empty.Empty, error)
+	// Checks a new password against the policy before a one-time code is used
+	CheckPassword(context.Context, *CheckPasswordReq) (*empty.Empty, error)
+	UpdateSignIn(context.Context, *UpdateSignInReq) (*empty.Empty, error)
+	DeleteOne(context.Context, *UIDReq) (*empty.Empty, error)
+	DoLockAccount(context.Context, *LockAccountReq) (*empty.Empty, error)
+	DoUnlockAccount(context.Context, *UIDReq) (*empty.Empty, error)
+	DoCredentialsExpired(context.Context, *CredentialsExpiredReq) (*empty.Empty, error)
+	DoRenewCredentials(context.Context, *UIDReq) (*empty.Empty, error)
+	IssueRefreshToken(context.Context, *IssueRefreshTokenReq) (*RefreshToken, error)
+	RotateRefreshToken(context.Context, *RefreshTokenReq) (*RotateRefreshTokenResp, error)
+	RevokeToken(context.Context, *RevokeTokenReq) (*empty.Empty, error)
+	RevokeAllTokens(context.Context, *UIDReq) (*empty.Empty, error)
+	IsTokenRevoked(context.Context, *IsTokenRevokedReq) (*IsTokenRevokedResp, error)
+	// One-time tokens, e.g. authorization codes, fail with AlreadyExists once used
+	UseToken(context.Context, *UseTokenReq) (*empty.Empty, error)
+	GetRoles(context.Context, *UIDReq) (*RolesResp, error)
+	SetRoles(context.Context, *SetRolesReq) (*RolesResp, error)
+	ListSessions(context.Context, *UIDReq) (*ListSessionsResp, error)
+	RevokeSession(context.Context, *SessionReq) (*empty.Empty, error)
+	RevokeOtherSessions(context.Context, *SessionReq) (*empty.Empty, error)
+	EnrollTOTP(context.Context, *UIDReq) (*EnrollTOTPResp, error)
+	ConfirmTOTP(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error)
+	VerifyTOTP(context.Context, *TOTPCodeReq) (*Account, error)
+	DisableTOTP(context.Context, *TOTPCodeReq) (*empty.Empty, error)
+	RegenerateRecoveryCodes(context.Context, *TOTPCodeReq) (*RecoveryCodesResp, error)
+	GetProfile(context.Context, *GetProfileReq) (*Profile, error)
+	BatchGetProfiles(context.Context, *BatchGetProfilesReq) (*BatchGetProfilesResp, error)
+	UpdateProfile(context.Context, *UpdateProfileReq) (*Profile, error)
+	VerifyEmail(context.Context, *VerifyEmailReq) (*empty.Empty, error)
+	StartEmailChange(context.Context, *StartEmailChangeReq) (*Account, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeReq) (*ConfirmEmailChangeResp, error)
+	// Deleted after the grace period, DeleteOne deletes without one
+	RequestDeletion(context.Context, *RequestDeletionReq) (*AccountDeletion, error)
+	CancelDeletion(context.Context, *UIDReq) (*empty.Empty, error)
+	GetDeletion(context.Context, *UIDReq) (*AccountDeletion, error)
+	// Clients of the client_credentials grant, the secret is only returned
+	// when created
+	CreateClient(context.Context, *CreateClientReq) (*CreateClientResp, error)
+	GetClients(context.Context, *empty.Empty) (*ClientsResp, error)
+	DeleteClient(context.Context, *ClientIDReq) (*empty.Empty, error)
+	VerifyClient(context.Context, *VerifyClientReq) (*OAuthClient, error)
+	GetClient(context.Context, *ClientIDReq) (*OAuthClient, error)
+	// Personal access tokens, the value is only returned when created
+	CreatePersonalToken(context.Context, *CreatePersonalTokenReq) (*CreatePersonalTokenResp, error)
+	ListPersonalTokens(context.Context, *UIDReq) (*PersonalTokensResp, error)
+	RevokePersonalToken(context.Context, *PersonalTokenReq) (*empty.Empty, error)
+	VerifyPersonalToken(context.Context, *VerifyPersonalTokenReq) (*PersonalToken, error)
+	// Login methods of an account, the last one can't be unlinked
+	LinkOAuth(context.Context, *LinkOAuthReq) (*Account, error)
+	UnlinkOAuth(context.Context, *UnlinkOAuthReq) (*Account, error)
+}
+
+func RegisterUAAServer(s *grpc.Server, srv UAAServer) {
+	s.RegisterService(&_UAA_serviceDesc, srv)
+}
+
+func _UAA_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/GetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).GetAll(ctx, req.(*GetAllReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_GetOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).GetOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/GetOne",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).GetOne(ctx, req.(*GetOneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_RegisterByNormal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNormalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RegisterByNormal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RegisterByNormal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RegisterByNormal(ctx, req.(*RegisterNormalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_RegisterByOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOAuthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RegisterByOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RegisterByOAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RegisterByOAuth(ctx, req.(*RegisterOAuthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_VerifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).VerifyPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/VerifyPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).VerifyPassword(ctx, req.(*VerifyAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_CheckPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).CheckPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/CheckPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).CheckPassword(ctx, req.(*CheckPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_UpdateSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSignInReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).UpdateSignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/UpdateSignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).UpdateSignIn(ctx, req.(*UpdateSignInReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DeleteOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DeleteOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DeleteOne",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DeleteOne(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DoLockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DoLockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DoLockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DoLockAccount(ctx, req.(*LockAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DoUnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DoUnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DoUnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DoUnlockAccount(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DoCredentialsExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialsExpiredReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DoCredentialsExpired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DoCredentialsExpired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DoCredentialsExpired(ctx, req.(*CredentialsExpiredReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DoRenewCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DoRenewCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DoRenewCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DoRenewCredentials(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func _UAA_IssueRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueRefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).IssueRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/IssueRefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).IssueRefreshToken(ctx, req.(*IssueRefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func _UAA_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RotateRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RotateRefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RotateRefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UAAClient is the client API for UAA service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UAAClient interface {
-	GetAll(ctx context.Context, in *GetAllReq, opts ...grpc.CallOption) (*GetAllResp, error)
-	GetOne(ctx context.Context, in *GetOneReq, opts ...grpc.CallOption) (*Account, error)
-	RegisterByNormal(ctx context.Context, in *RegisterNormalReq, opts ...grpc.CallOption) (*Account, error)
-	RegisterByOAuth(ctx context.Context, in *RegisterOAuthReq, opts ...grpc.CallOption) (*Account, error)
-	VerifyPassword(ctx context.Context, in *VerifyAccountReq, opts ...grpc.CallOption) (*Account, error)
-	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*empty.Empty, error)
-	UpdateSignIn(ctx context.Context, in *UpdateSignInReq, opts ...grpc.CallOption) (*empty.Empty, error)
-	DeleteOne(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
-	DoLockAccount(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
-	DoCredentialsExpired(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error)
+func _UAA_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RevokeToken(ctx, req.(*RevokeTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type uAAClient struct {
-	cc *grpc.ClientConn
+func _UAA_RevokeAllTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RevokeAllTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RevokeAllTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RevokeAllTokens(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewUAAClient(cc *grpc.ClientConn) UAAClient {
-	return &uAAClient{cc}
+func _UAA_IsTokenRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTokenRevokedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).IsTokenRevoked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/IsTokenRevoked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).IsTokenRevoked(ctx, req.(*IsTokenRevokedReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) GetAll(ctx context.Context, in *GetAllReq, opts ...grpc.CallOption) (*GetAllResp, error) {
-	out := new(GetAllResp)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetAll", in, out, opts...)
-	if err != nil {
+func _UAA_UseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseTokenReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).UseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/UseToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).UseToken(ctx, req.(*UseTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) GetOne(ctx context.Context, in *GetOneReq, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/GetOne", in, out, opts...)
-	if err != nil {
+func _UAA_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).GetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/GetRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).GetRoles(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) RegisterByNormal(ctx context.Context, in *RegisterNormalReq, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RegisterByNormal", in, out, opts...)
-	if err != nil {
+func _UAA_SetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).SetRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/SetRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).SetRoles(ctx, req.(*SetRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).ListSessions(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RevokeSession(ctx, req.(*SessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RevokeOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RevokeOtherSessions(ctx, req.(*SessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).EnrollTOTP(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).ConfirmTOTP(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_VerifyTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).VerifyTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/VerifyTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).VerifyTOTP(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).DisableTOTP(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RegenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RegenerateRecoveryCodes(ctx, req.(*TOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).GetProfile(ctx, req.(*GetProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UAA_BatchGetProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProfilesReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).BatchGetProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/BatchGetProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).BatchGetProfiles(ctx, req.(*BatchGetProfilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) RegisterByOAuth(ctx context.Context, in *RegisterOAuthReq, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/RegisterByOAuth", in, out, opts...)
-	if err != nil {
+func _UAA_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) VerifyPassword(ctx context.Context, in *VerifyAccountReq, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/VerifyPassword", in, out, opts...)
-	if err != nil {
+func _UAA_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).VerifyEmail(ctx, req.(*VerifyEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/ChangePassword", in, out, opts...)
-	if err != nil {
+func _UAA_StartEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEmailChangeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).StartEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/StartEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).StartEmailChange(ctx, req.(*StartEmailChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) UpdateSignIn(ctx context.Context, in *UpdateSignInReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/UpdateSignIn", in, out, opts...)
-	if err != nil {
+func _UAA_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/ConfirmEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) DeleteOne(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DeleteOne", in, out, opts...)
-	if err != nil {
+func _UAA_RequestDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeletionReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).RequestDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/RequestDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).RequestDeletion(ctx, req.(*RequestDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) DoLockAccount(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoLockAccount", in, out, opts...)
-	if err != nil {
+func _UAA_CancelDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UAAServer).CancelDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/CancelDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).CancelDeletion(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *uAAClient) DoCredentialsExpired(ctx context.Context, in *UIDReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/teddy.srv.uaa.UAA/DoCredentialsExpired", in, out, opts...)
-	if err != nil {
+func _UAA_GetDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// UAAServer is the server API for UAA service.
-type UAAServer interface {
-	GetAll(context.Context, *GetAllReq) (*GetAllResp, error)
-	GetOne(context.Context, *GetOneReq) (*Account, error)
-	RegisterByNormal(context.Context, *RegisterNormalReq) (*Account, error)
-	RegisterByOAuth(context.Context, *RegisterOAuthReq) (*Account, error)
-	VerifyPassword(context.Context, *VerifyAccountReq) (*Account, error)
-	ChangePassword(context.Context, *ChangePasswordReq) (*empty.Empty, error)
-	UpdateSignIn(context.Context, *UpdateSignInReq) (*empty.Empty, error)
-	DeleteOne(context.Context, *UIDReq) (*empty.Empty, error)
-	DoLockAccount(context.Context, *UIDReq) (*empty.Empty, error)
-	DoCredentialsExpired(context.Context, *UIDReq) (*empty.Empty, error)
+	if interceptor == nil {
+		return srv.(UAAServer).GetDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/GetDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).GetDeletion(ctx, req.(*UIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUAAServer(s *grpc.Server, srv UAAServer) {
-	s.RegisterService(&_UAA_serviceDesc, srv)
+func _UAA_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UAAServer).CreateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teddy.srv.uaa.UAA/CreateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UAAServer).CreateClient(ctx, req.(*CreateClientReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllReq)
+func _UAA_GetClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).GetAll(ctx, in)
+		return srv.(UAAServer).GetClients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/GetAll",
+		FullMethod: "/teddy.srv.uaa.UAA/GetClients",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).GetAll(ctx, req.(*GetAllReq))
+		return srv.(UAAServer).GetClients(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_GetOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOneReq)
+func _UAA_DeleteClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).GetOne(ctx, in)
+		return srv.(UAAServer).DeleteClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/GetOne",
+		FullMethod: "/teddy.srv.uaa.UAA/DeleteClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).GetOne(ctx, req.(*GetOneReq))
+		return srv.(UAAServer).DeleteClient(ctx, req.(*ClientIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_RegisterByNormal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterNormalReq)
+func _UAA_VerifyClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyClientReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).RegisterByNormal(ctx, in)
+		return srv.(UAAServer).VerifyClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/RegisterByNormal",
+		FullMethod: "/teddy.srv.uaa.UAA/VerifyClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).RegisterByNormal(ctx, req.(*RegisterNormalReq))
+		return srv.(UAAServer).VerifyClient(ctx, req.(*VerifyClientReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_RegisterByOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterOAuthReq)
+func _UAA_GetClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).RegisterByOAuth(ctx, in)
+		return srv.(UAAServer).GetClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/RegisterByOAuth",
+		FullMethod: "/teddy.srv.uaa.UAA/GetClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).RegisterByOAuth(ctx, req.(*RegisterOAuthReq))
+		return srv.(UAAServer).GetClient(ctx, req.(*ClientIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_VerifyPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyAccountReq)
+func _UAA_CreatePersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonalTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).VerifyPassword(ctx, in)
+		return srv.(UAAServer).CreatePersonalToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/VerifyPassword",
+		FullMethod: "/teddy.srv.uaa.UAA/CreatePersonalToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).VerifyPassword(ctx, req.(*VerifyAccountReq))
+		return srv.(UAAServer).CreatePersonalToken(ctx, req.(*CreatePersonalTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _UAA_ListPersonalTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UIDReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).ChangePassword(ctx, in)
+		return srv.(UAAServer).ListPersonalTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/ChangePassword",
+		FullMethod: "/teddy.srv.uaa.UAA/ListPersonalTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(UAAServer).ListPersonalTokens(ctx, req.(*UIDReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_UpdateSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSignInReq)
+func _UAA_RevokePersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonalTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).UpdateSignIn(ctx, in)
+		return srv.(UAAServer).RevokePersonalToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/UpdateSignIn",
+		FullMethod: "/teddy.srv.uaa.UAA/RevokePersonalToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).UpdateSignIn(ctx, req.(*UpdateSignInReq))
+		return srv.(UAAServer).RevokePersonalToken(ctx, req.(*PersonalTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_DeleteOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UIDReq)
+func _UAA_VerifyPersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPersonalTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).DeleteOne(ctx, in)
+		return srv.(UAAServer).VerifyPersonalToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/DeleteOne",
+		FullMethod: "/teddy.srv.uaa.UAA/VerifyPersonalToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).DeleteOne(ctx, req.(*UIDReq))
+		return srv.(UAAServer).VerifyPersonalToken(ctx, req.(*VerifyPersonalTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_DoLockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UIDReq)
+func _UAA_LinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).DoLockAccount(ctx, in)
+		return srv.(UAAServer).LinkOAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/DoLockAccount",
+		FullMethod: "/teddy.srv.uaa.UAA/LinkOAuth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).DoLockAccount(ctx, req.(*UIDReq))
+		return srv.(UAAServer).LinkOAuth(ctx, req.(*LinkOAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UAA_DoCredentialsExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UIDReq)
+func _UAA_UnlinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkOAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UAAServer).DoCredentialsExpired(ctx, in)
+		return srv.(UAAServer).UnlinkOAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/teddy.srv.uaa.UAA/DoCredentialsExpired",
+		FullMethod: "/teddy.srv.uaa.UAA/UnlinkOAuth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UAAServer).DoCredentialsExpired(ctx, req.(*UIDReq))
+		return srv.(UAAServer).UnlinkOAuth(ctx, req.(*UnlinkOAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1105,6 +5117,14 @@ var _UAA_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _UAA_ChangePassword_Handler,
 		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UAA_ResetPassword_Handler,
+		},
+		{
+			MethodName: "CheckPassword",
+			Handler:    _UAA_CheckPassword_Handler,
+		},
 		{
 			MethodName: "UpdateSignIn",
 			Handler:    _UAA_UpdateSignIn_Handler,
@@ -1117,75 +5137,381 @@ var _UAA_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DoLockAccount",
 			Handler:    _UAA_DoLockAccount_Handler,
 		},
+		{
+			MethodName: "DoUnlockAccount",
+			Handler:    _UAA_DoUnlockAccount_Handler,
+		},
 		{
 			MethodName: "DoCredentialsExpired",
 			Handler:    _UAA_DoCredentialsExpired_Handler,
 		},
+		{
+			MethodName: "DoRenewCredentials",
+			Handler:    _UAA_DoRenewCredentials_Handler,
+		},
+		{
+			MethodName: "IssueRefreshToken",
+			Handler:    _UAA_IssueRefreshToken_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _UAA_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _UAA_RevokeToken_Handler,
+		},
+		{
+			MethodName: "RevokeAllTokens",
+			Handler:    _UAA_RevokeAllTokens_Handler,
+		},
+		{
+			MethodName: "IsTokenRevoked",
+			Handler:    _UAA_IsTokenRevoked_Handler,
+		},
+		{
+			MethodName: "UseToken",
+			Handler:    _UAA_UseToken_Handler,
+		},
+		{
+			MethodName: "GetRoles",
+			Handler:    _UAA_GetRoles_Handler,
+		},
+		{
+			MethodName: "SetRoles",
+			Handler:    _UAA_SetRoles_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UAA_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UAA_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _UAA_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _UAA_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _UAA_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTOTP",
+			Handler:    _UAA_VerifyTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _UAA_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _UAA_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _UAA_GetProfile_Handler,
+		},
+		{
+			MethodName: "BatchGetProfiles",
+			Handler:    _UAA_BatchGetProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UAA_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UAA_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "StartEmailChange",
+			Handler:    _UAA_StartEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _UAA_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "RequestDeletion",
+			Handler:    _UAA_RequestDeletion_Handler,
+		},
+		{
+			MethodName: "CancelDeletion",
+			Handler:    _UAA_CancelDeletion_Handler,
+		},
+		{
+			MethodName: "GetDeletion",
+			Handler:    _UAA_GetDeletion_Handler,
+		},
+		{
+			MethodName: "CreateClient",
+			Handler:    _UAA_CreateClient_Handler,
+		},
+		{
+			MethodName: "GetClients",
+			Handler:    _UAA_GetClients_Handler,
+		},
+		{
+			MethodName: "DeleteClient",
+			Handler:    _UAA_DeleteClient_Handler,
+		},
+		{
+			MethodName: "VerifyClient",
+			Handler:    _UAA_VerifyClient_Handler,
+		},
+		{
+			MethodName: "GetClient",
+			Handler:    _UAA_GetClient_Handler,
+		},
+		{
+			MethodName: "CreatePersonalToken",
+			Handler:    _UAA_CreatePersonalToken_Handler,
+		},
+		{
+			MethodName: "ListPersonalTokens",
+			Handler:    _UAA_ListPersonalTokens_Handler,
+		},
+		{
+			MethodName: "RevokePersonalToken",
+			Handler:    _UAA_RevokePersonalToken_Handler,
+		},
+		{
+			MethodName: "VerifyPersonalToken",
+			Handler:    _UAA_VerifyPersonalToken_Handler,
+		},
+		{
+			MethodName: "LinkOAuth",
+			Handler:    _UAA_LinkOAuth_Handler,
+		},
+		{
+			MethodName: "UnlinkOAuth",
+			Handler:    _UAA_UnlinkOAuth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "teddy-backend/internal/proto/uaa/uaa.proto",
 }
 
 func init() {
-	proto.RegisterFile("teddy-backend/internal/proto/uaa/uaa.proto", fileDescriptor_uaa_dfbdc074a1357c45)
-}
-
-var fileDescriptor_uaa_dfbdc074a1357c45 = []byte{
-	// 894 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0x8e, 0xe2, 0x9f, 0xc4, 0x27, 0xb1, 0x9b, 0x72, 0x9d, 0xa1, 0x09, 0xc3, 0x66, 0x08, 0x1b,
-	0xe0, 0x02, 0x9b, 0x0c, 0xa4, 0x37, 0x43, 0x97, 0x61, 0x75, 0xe2, 0xa0, 0x0d, 0x16, 0x34, 0x86,
-	0xba, 0xec, 0x62, 0x17, 0x03, 0x18, 0x89, 0x95, 0x35, 0x4b, 0xa2, 0x46, 0x52, 0xe9, 0xd4, 0x67,
-	0xd9, 0xc3, 0xec, 0x11, 0xf6, 0x48, 0x03, 0x49, 0xd3, 0xb6, 0xe4, 0xc4, 0x46, 0x2f, 0x12, 0xf0,
-	0x1c, 0x9e, 0xef, 0xe3, 0xe1, 0xe1, 0xf7, 0x59, 0xf0, 0x53, 0x14, 0x8b, 0x59, 0x71, 0xe7, 0x05,
-	0x34, 0x1d, 0x7d, 0x9c, 0xf1, 0x92, 0x16, 0x0c, 0xc7, 0x23, 0x41, 0xc2, 0xb0, 0xfc, 0xfe, 0x0e,
-	0x07, 0x73, 0x92, 0x85, 0xa3, 0x80, 0xa6, 0x29, 0xcd, 0x46, 0x39, 0xa3, 0x82, 0x8e, 0x0a, 0x8c,
-	0xe5, 0x9f, 0xa7, 0x22, 0xd4, 0x55, 0x95, 0x1e, 0x67, 0xf7, 0x5e, 0x81, 0xb1, 0xf3, 0x62, 0x8d,
-	0x2d, 0xa2, 0x09, 0xce, 0x22, 0x8d, 0xba, 0x2b, 0xde, 0x8f, 0x72, 0x51, 0xe6, 0x84, 0x8f, 0x48,
-	0x9a, 0x8b, 0x52, 0xff, 0xd7, 0x1c, 0xce, 0x8f, 0xbb, 0x41, 0x22, 0x4e, 0x09, 0x17, 0x38, 0xcd,
-	0x57, 0x2b, 0x0d, 0x76, 0xff, 0x6d, 0xc2, 0xc1, 0x38, 0x08, 0x68, 0x91, 0x09, 0x74, 0x02, 0x8d,
-	0x22, 0x0e, 0x6d, 0x6b, 0x60, 0x0d, 0x3b, 0xbe, 0x5c, 0x22, 0x07, 0x0e, 0x0b, 0x4e, 0x58, 0x86,
-	0x53, 0x62, 0xef, 0xab, 0xf4, 0x32, 0x46, 0xcf, 0xa0, 0x45, 0x52, 0x1c, 0x27, 0x76, 0x43, 0x6d,
-	0xe8, 0x40, 0x66, 0xf3, 0x19, 0xcd, 0x88, 0xdd, 0xd4, 0x59, 0x15, 0x48, 0x9e, 0x1c, 0x73, 0xfe,
-	0x81, 0xb2, 0xd0, 0x6e, 0x0d, 0xac, 0xe1, 0xb1, 0xbf, 0x8c, 0x25, 0x82, 0xd1, 0x84, 0x70, 0xbb,
-	0x3d, 0x68, 0x48, 0x84, 0x0a, 0xd0, 0x05, 0x74, 0x28, 0x2e, 0xc4, 0xec, 0xf6, 0x6a, 0xc2, 0xed,
-	0x83, 0x41, 0x63, 0x78, 0x74, 0xfa, 0xad, 0x57, 0x19, 0x96, 0xb7, 0x68, 0xdb, 0xbb, 0x31, 0x75,
-	0x97, 0x99, 0x60, 0xa5, 0xbf, 0xc2, 0xa1, 0x3e, 0xb4, 0x13, 0x1a, 0xcc, 0x49, 0x68, 0x77, 0x06,
-	0xd6, 0xf0, 0xd0, 0x5f, 0x44, 0xc8, 0x03, 0x14, 0x30, 0x12, 0x92, 0x4c, 0xc4, 0x38, 0xe1, 0x97,
-	0x7f, 0xe7, 0x31, 0x23, 0xa1, 0x0d, 0xaa, 0xe6, 0x81, 0x1d, 0xf4, 0x12, 0x20, 0x60, 0x04, 0x0b,
-	0x32, 0xc1, 0x82, 0xd8, 0x47, 0x03, 0x6b, 0x78, 0x74, 0xea, 0x78, 0x11, 0xa5, 0x51, 0x42, 0x3c,
-	0x33, 0x6b, 0xef, 0x57, 0x33, 0x5a, 0x7f, 0xad, 0x5a, 0x62, 0x8b, 0x3c, 0x34, 0xd8, 0xe3, 0xdd,
-	0xd8, 0x55, 0x35, 0x72, 0xe1, 0x38, 0xc1, 0x5c, 0xbc, 0x8b, 0xa3, 0xec, 0x2a, 0xbb, 0x9a, 0xda,
-	0x5d, 0x35, 0xd3, 0x4a, 0x0e, 0x9d, 0x43, 0x6f, 0x15, 0x4b, 0x1a, 0xbb, 0xb7, 0xf3, 0x8c, 0x1a,
-	0xc2, 0x39, 0x83, 0x5e, 0x75, 0x88, 0x52, 0x0a, 0x73, 0x52, 0x1a, 0x29, 0xcc, 0x49, 0x29, 0x9f,
-	0xe9, 0x1e, 0x27, 0x85, 0xd1, 0x81, 0x0e, 0x5e, 0xee, 0xff, 0x60, 0xb9, 0xdf, 0x41, 0xf3, 0x1d,
-	0x65, 0x02, 0x21, 0x68, 0x2a, 0xa1, 0x68, 0x90, 0x5a, 0x4b, 0x1e, 0xcc, 0x03, 0x85, 0x39, 0xf4,
-	0xe5, 0xd2, 0x75, 0xa0, 0x7d, 0x7b, 0x35, 0xf1, 0xc9, 0x5f, 0x9b, 0x72, 0x73, 0xff, 0x80, 0xce,
-	0x6b, 0x22, 0xc6, 0x49, 0x22, 0xb7, 0x11, 0x34, 0x73, 0x1c, 0x69, 0xba, 0xae, 0xaf, 0xd6, 0x32,
-	0xc7, 0xe3, 0x8f, 0xba, 0x87, 0xae, 0xaf, 0xd6, 0xe8, 0x39, 0xb4, 0x38, 0x65, 0x82, 0xdb, 0x0d,
-	0xa5, 0x92, 0xcf, 0x6a, 0x2a, 0x91, 0xad, 0xf9, 0xba, 0xc2, 0x7d, 0xae, 0xf8, 0x6f, 0x32, 0x22,
-	0xf9, 0xbf, 0x84, 0x4e, 0xce, 0xe2, 0x2c, 0x88, 0x73, 0x9c, 0x2c, 0x9a, 0x58, 0x25, 0xdc, 0x57,
-	0x00, 0xa6, 0x15, 0x9e, 0xa3, 0x53, 0x38, 0xc4, 0x5a, 0x6d, 0xdc, 0xb6, 0xd4, 0x31, 0xfd, 0x87,
-	0xc5, 0xe8, 0x2f, 0xeb, 0xdc, 0x7f, 0x2c, 0x78, 0xea, 0x93, 0x28, 0xe6, 0x82, 0xb0, 0xb7, 0x94,
-	0xa5, 0x58, 0xdd, 0x6a, 0xa9, 0x76, 0x6b, 0x5d, 0xed, 0xdb, 0x7c, 0xb6, 0xee, 0x1d, 0x6d, 0xb5,
-	0x95, 0x77, 0xfa, 0xc6, 0x83, 0xca, 0x6d, 0x6f, 0xf6, 0x8c, 0x0b, 0xfb, 0xc6, 0x85, 0x2d, 0x93,
-	0x57, 0xe1, 0x79, 0x07, 0x0e, 0x02, 0x9a, 0x09, 0x1c, 0x08, 0xf7, 0x4f, 0x38, 0x31, 0xdd, 0xdd,
-	0x8c, 0x0b, 0x31, 0x7b, 0xbc, 0xb9, 0x6f, 0xa0, 0xab, 0x2c, 0x35, 0x65, 0xf4, 0x3e, 0x0e, 0x09,
-	0x5b, 0x74, 0x58, 0x4d, 0xca, 0x36, 0x8d, 0xf1, 0x4c, 0x9b, 0x26, 0x76, 0xaf, 0xe1, 0xe4, 0x37,
-	0xc2, 0xe2, 0xf7, 0xa5, 0x99, 0xd2, 0xae, 0xf1, 0x57, 0x2e, 0xbd, 0x5f, 0xbd, 0xb4, 0x5b, 0xc0,
-	0xd3, 0x8b, 0x19, 0xce, 0x22, 0x32, 0x5d, 0x64, 0x76, 0xd3, 0x0d, 0xe0, 0x88, 0x26, 0xe1, 0xb4,
-	0xca, 0xb8, 0x9e, 0x92, 0x15, 0x19, 0xf9, 0x30, 0xad, 0x0e, 0x7a, 0x3d, 0xe5, 0x52, 0x78, 0x72,
-	0xab, 0xac, 0xa9, 0x8d, 0xb3, 0xfb, 0xd0, 0x1e, 0xec, 0xc7, 0xf9, 0xe2, 0xac, 0xfd, 0x38, 0x47,
-	0x1e, 0x34, 0xe5, 0xaf, 0xaf, 0xe2, 0xde, 0xee, 0x4f, 0x55, 0x77, 0xfa, 0x5f, 0x0b, 0x1a, 0xb7,
-	0xe3, 0x31, 0xfa, 0x19, 0xda, 0x5a, 0x8a, 0xc8, 0xae, 0x89, 0x6e, 0x69, 0x16, 0xe7, 0x8b, 0x47,
-	0x76, 0x78, 0xee, 0xee, 0xa1, 0x33, 0x45, 0x70, 0x93, 0x91, 0x87, 0x08, 0xb4, 0x1b, 0x9c, 0x47,
-	0xf4, 0xec, 0xee, 0xa1, 0xb7, 0x2b, 0xa1, 0x9c, 0x97, 0x5a, 0xc8, 0x68, 0x50, 0xab, 0xde, 0xd0,
-	0xf9, 0x16, 0xbe, 0x6b, 0x78, 0xb2, 0xe2, 0x53, 0xd2, 0x43, 0x5f, 0x3f, 0x42, 0x67, 0x84, 0xb9,
-	0x85, 0xed, 0x17, 0xe8, 0x69, 0x69, 0x2d, 0x5f, 0xb2, 0x4e, 0x56, 0x57, 0xde, 0xd6, 0xd6, 0x7a,
-	0x55, 0x65, 0x6d, 0x5c, 0x74, 0x43, 0x78, 0x4e, 0x7f, 0xe3, 0x1d, 0x2f, 0xe5, 0xb7, 0xd9, 0xdd,
-	0x43, 0x6f, 0xe0, 0x78, 0x5d, 0x30, 0xe8, 0xab, 0x1a, 0x57, 0x4d, 0x4d, 0x5b, 0x98, 0xce, 0xa0,
-	0x33, 0x21, 0x09, 0x11, 0x44, 0xbe, 0xe1, 0xe7, 0x75, 0x1a, 0xf5, 0x6b, 0xba, 0x05, 0xfd, 0x0a,
-	0xba, 0x13, 0x7a, 0x4d, 0x83, 0xb9, 0xf9, 0xce, 0x7f, 0x32, 0xc3, 0x6b, 0x78, 0x36, 0xa1, 0x17,
-	0x9b, 0xdf, 0xc5, 0x4f, 0x25, 0x3a, 0x6f, 0xfd, 0xde, 0x28, 0x30, 0xbe, 0x6b, 0xab, 0x8d, 0x17,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x37, 0xae, 0x08, 0x3d, 0x09, 0x00, 0x00,
+	proto.RegisterFile("teddy-backend/internal/proto/uaa/uaa.proto", fileDescriptor_uaa_d539f0198393248f)
+}
+
+var fileDescriptor_uaa_d539f0198393248f = []byte{
+	// 3358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x73, 0x1b, 0xc7,
+	0xb1, 0x27, 0x16, 0x1f, 0x04, 0x1a, 0xfc, 0x58, 0x0d, 0x29, 0x1a, 0x82, 0x65, 0x99, 0x5a, 0xcb,
+	0x2e, 0x49, 0xf5, 0x4c, 0xd9, 0xf2, 0x7b, 0x2e, 0x97, 0x9f, 0xde, 0x93, 0x41, 0x60, 0x49, 0x42,
+	0x02, 0x01, 0x7a, 0x01, 0x48, 0x51, 0xca, 0x31, 0xbc, 0xda, 0x1d, 0x82, 0x1b, 0x2e, 0x77, 0xe1,
+	0xdd, 0x01, 0x65, 0xe6, 0x90, 0xca, 0x21, 0x95, 0x4b, 0x2a, 0x97, 0x54, 0xe5, 0x9c, 0xaa, 0x5c,
+	0x92, 0x73, 0xaa, 0xf2, 0x2f, 0xe5, 0x9e, 0x7b, 0x0e, 0xa9, 0x99, 0xd9, 0x5d, 0xec, 0x17, 0x16,
+	0x84, 0x95, 0xca, 0x41, 0xe2, 0xcc, 0x6c, 0x4f, 0x4f, 0x4f, 0x77, 0x4f, 0x77, 0xcf, 0x6f, 0x00,
+	0x0f, 0x09, 0xd6, 0xf5, 0xab, 0x8f, 0x5f, 0xab, 0xda, 0x39, 0xb6, 0xf4, 0x47, 0x86, 0x45, 0xb0,
+	0x63, 0xa9, 0xe6, 0xa3, 0x89, 0x63, 0x13, 0xfb, 0xd1, 0x54, 0x55, 0xe9, 0xbf, 0x3d, 0xd6, 0x43,
+	0xeb, 0x8c, 0x76, 0xcf, 0x75, 0x2e, 0xf7, 0xa6, 0xaa, 0x5a, 0xff, 0x6c, 0x6c, 0x90, 0xb3, 0xe9,
+	0xeb, 0x3d, 0xcd, 0xbe, 0x78, 0x34, 0xb6, 0x4d, 0xd5, 0x1a, 0xf3, 0x59, 0xaf, 0xa7, 0xa7, 0x8f,
+	0x26, 0xe4, 0x6a, 0x82, 0xdd, 0x47, 0xf8, 0x62, 0x42, 0xae, 0xf8, 0xff, 0x9c, 0x47, 0xfd, 0x7f,
+	0x17, 0x4f, 0x22, 0xc6, 0x05, 0x76, 0x89, 0x7a, 0x31, 0x99, 0xb5, 0xf8, 0x64, 0xc9, 0x85, 0xaa,
+	0xec, 0x38, 0xb6, 0xd3, 0xc2, 0x44, 0x35, 0x4c, 0xf4, 0x18, 0x4a, 0x0e, 0x56, 0x5d, 0xdb, 0xaa,
+	0xe5, 0x76, 0x73, 0xf7, 0x37, 0x1e, 0xd7, 0xf7, 0x22, 0x02, 0xee, 0x31, 0x5a, 0x85, 0x51, 0x28,
+	0x1e, 0x25, 0xfa, 0x04, 0x8a, 0x53, 0x8b, 0x18, 0x66, 0x4d, 0xd8, 0xcd, 0xdd, 0xaf, 0x3e, 0xae,
+	0xef, 0x8d, 0x6d, 0x7b, 0x6c, 0xe2, 0x3d, 0x5f, 0x88, 0xbd, 0x81, 0xbf, 0xa6, 0xc2, 0x09, 0xa5,
+	0x5f, 0x95, 0x60, 0xb5, 0xa1, 0x69, 0xf6, 0xd4, 0x22, 0x48, 0x84, 0xfc, 0xd4, 0xd0, 0xd9, 0x72,
+	0x15, 0x85, 0x36, 0x51, 0x1d, 0xca, 0x53, 0x97, 0xaa, 0xec, 0x02, 0x33, 0x96, 0x15, 0x25, 0xe8,
+	0xa3, 0x6d, 0x28, 0xe2, 0x0b, 0xd5, 0x30, 0x6b, 0x79, 0xf6, 0x81, 0x77, 0xe8, 0xe8, 0xe4, 0xcc,
+	0xb6, 0x70, 0xad, 0xc0, 0x47, 0x59, 0x87, 0xf2, 0x99, 0xa8, 0xae, 0xfb, 0xc6, 0x76, 0xf4, 0x5a,
+	0x71, 0x37, 0x77, 0x7f, 0x4d, 0x09, 0xfa, 0x74, 0x86, 0x63, 0x9b, 0xd8, 0xad, 0x95, 0x76, 0xf3,
+	0x74, 0x06, 0xeb, 0xa0, 0x26, 0x54, 0x6c, 0x75, 0x4a, 0xce, 0x86, 0xed, 0x96, 0x5b, 0x5b, 0xdd,
+	0xcd, 0xdf, 0xaf, 0x3e, 0xfe, 0x30, 0xa6, 0x00, 0x4f, 0xec, 0xbd, 0x9e, 0x4f, 0x27, 0x5b, 0xc4,
+	0xb9, 0x52, 0x66, 0xf3, 0xd0, 0x0e, 0x94, 0x4c, 0x5b, 0x3b, 0xc7, 0x7a, 0xad, 0xb2, 0x9b, 0xbb,
+	0x5f, 0x56, 0xbc, 0x1e, 0xda, 0x03, 0xa4, 0x39, 0x58, 0xc7, 0x16, 0x31, 0x54, 0xd3, 0x95, 0x7f,
+	0x98, 0x18, 0x0e, 0xd6, 0x6b, 0xc0, 0x68, 0x52, 0xbe, 0xa0, 0x2f, 0x01, 0x34, 0x07, 0xab, 0x04,
+	0xb7, 0x54, 0x82, 0x6b, 0xd5, 0x85, 0xba, 0x0d, 0x51, 0xd3, 0xb9, 0xd3, 0x89, 0xee, 0xcf, 0x5d,
+	0x5b, 0x3c, 0x77, 0x46, 0x8d, 0x24, 0x58, 0x33, 0x55, 0x97, 0xf4, 0x8d, 0xb1, 0xd5, 0xb6, 0xda,
+	0x27, 0xb5, 0x75, 0xa6, 0xd3, 0xc8, 0x18, 0xda, 0x87, 0x8d, 0x59, 0x9f, 0xb2, 0xa9, 0x6d, 0x2c,
+	0x5c, 0x23, 0x36, 0x03, 0x3d, 0x81, 0x2a, 0xd7, 0xcc, 0x90, 0x39, 0xcf, 0xe6, 0x42, 0x06, 0x61,
+	0x72, 0xaa, 0xcd, 0x8b, 0xa9, 0x4b, 0x9a, 0x67, 0xaa, 0x35, 0xc6, 0x27, 0xbe, 0x99, 0x45, 0xae,
+	0xcd, 0xe4, 0x17, 0xf4, 0x10, 0x44, 0xf2, 0xc6, 0x3e, 0x50, 0x35, 0x62, 0x3b, 0xb2, 0xa5, 0xbe,
+	0x36, 0xb1, 0x5e, 0xbb, 0xc1, 0xa8, 0x13, 0xe3, 0xe8, 0x1e, 0xac, 0x33, 0xbf, 0x7a, 0x81, 0x1d,
+	0xe3, 0xd4, 0xc0, 0x7a, 0x0d, 0x31, 0xc2, 0xe8, 0x60, 0xfd, 0x09, 0x6c, 0x44, 0x9d, 0x80, 0xba,
+	0xf2, 0x39, 0xbe, 0xf2, 0x5d, 0xf9, 0x1c, 0x5f, 0x51, 0x37, 0xbb, 0x54, 0xcd, 0xa9, 0xef, 0xc7,
+	0xbc, 0xf3, 0xa5, 0xf0, 0x45, 0x4e, 0xfa, 0x2f, 0x28, 0xf4, 0x6d, 0x87, 0x20, 0x04, 0x05, 0xe6,
+	0xe8, 0x7c, 0x12, 0x6b, 0x53, 0x3e, 0xaa, 0xab, 0xb1, 0x39, 0x65, 0x85, 0x36, 0xa5, 0x3a, 0x94,
+	0x86, 0xed, 0x96, 0x82, 0xbf, 0x4f, 0x1e, 0x17, 0xe9, 0x3b, 0xd8, 0xe8, 0xd8, 0xda, 0xb9, 0xe7,
+	0x98, 0xa9, 0x34, 0x71, 0x5d, 0x0b, 0x4b, 0xe9, 0x5a, 0x7a, 0x05, 0x37, 0x9b, 0x09, 0xff, 0x4c,
+	0x5f, 0x28, 0xdd, 0x2c, 0xc2, 0x3c, 0xb3, 0x48, 0xbf, 0x2e, 0x40, 0xe5, 0x10, 0x93, 0x86, 0x69,
+	0x52, 0x7e, 0x08, 0x0a, 0x13, 0x75, 0xcc, 0x95, 0xb1, 0xae, 0xb0, 0x36, 0x1d, 0x73, 0x8d, 0x5f,
+	0x70, 0x0d, 0xae, 0x2b, 0xac, 0x8d, 0x1e, 0x40, 0xd1, 0xb5, 0x1d, 0xe2, 0xd6, 0xf2, 0xec, 0x8c,
+	0x6e, 0xc5, 0xce, 0x28, 0x55, 0xac, 0xc2, 0x29, 0xe8, 0x74, 0x7a, 0xb6, 0xbd, 0xc8, 0xc0, 0xda,
+	0xe8, 0xd3, 0xe0, 0x84, 0x16, 0x59, 0x90, 0xbb, 0x15, 0x9b, 0xbf, 0x6f, 0xdb, 0xe6, 0x81, 0x61,
+	0x12, 0xec, 0x04, 0x87, 0xb7, 0x9d, 0x7a, 0x78, 0x4b, 0x8b, 0xa6, 0xa7, 0x9d, 0xeb, 0x27, 0x50,
+	0xe5, 0x27, 0x55, 0x3f, 0x70, 0xec, 0x8b, 0xda, 0xea, 0x62, 0x5b, 0x84, 0xc8, 0xd1, 0x17, 0x50,
+	0xf1, 0xba, 0x03, 0xbb, 0x56, 0x5e, 0x38, 0x77, 0x46, 0x1c, 0x3d, 0xb3, 0x6c, 0xe9, 0xca, 0x32,
+	0x67, 0x96, 0xad, 0xfe, 0xff, 0xe1, 0xd8, 0x30, 0xb0, 0x6b, 0xb0, 0x90, 0x43, 0x84, 0x9e, 0xc6,
+	0xc6, 0x89, 0x83, 0x4f, 0x8d, 0x1f, 0x58, 0x3c, 0xab, 0x28, 0x5e, 0x4f, 0x7a, 0xc0, 0xbc, 0xa0,
+	0x67, 0x61, 0xea, 0x05, 0xb7, 0xa1, 0x32, 0x71, 0x0c, 0x4b, 0x33, 0x26, 0xaa, 0xe9, 0xf9, 0xd6,
+	0x6c, 0x40, 0xfa, 0x0e, 0xc0, 0x77, 0x18, 0x77, 0x82, 0x1e, 0x43, 0x59, 0xe5, 0x8e, 0xef, 0xd6,
+	0x72, 0xcc, 0x19, 0x76, 0xd2, 0x03, 0xb6, 0x12, 0xd0, 0xa1, 0x3b, 0x00, 0xc4, 0x26, 0xaa, 0xd9,
+	0xa4, 0x5d, 0xe6, 0x57, 0x05, 0x25, 0x34, 0x22, 0xfd, 0x35, 0x07, 0x37, 0x14, 0x3c, 0x36, 0x5c,
+	0x82, 0x9d, 0xae, 0xed, 0x5c, 0xa8, 0xcc, 0x37, 0xb3, 0xb2, 0x52, 0x38, 0xd3, 0xf0, 0xc4, 0x14,
+	0xf4, 0xd1, 0x8e, 0x9f, 0xb1, 0x98, 0x07, 0x1e, 0xad, 0xf8, 0x39, 0x6b, 0xc7, 0xcf, 0x59, 0x45,
+	0x7f, 0x9c, 0x75, 0x93, 0xc1, 0xa7, 0x94, 0x12, 0x7c, 0xf6, 0x2b, 0xb0, 0xaa, 0xd9, 0x16, 0x51,
+	0x35, 0xf2, 0xac, 0x50, 0xce, 0x89, 0x02, 0x15, 0x5a, 0xf4, 0x85, 0xee, 0x35, 0xa6, 0xe4, 0x8c,
+	0xca, 0x7c, 0x0f, 0xd6, 0x59, 0x5e, 0x3a, 0x71, 0xec, 0x4b, 0x43, 0xc7, 0x8e, 0x27, 0x78, 0x74,
+	0x90, 0x4a, 0xef, 0x67, 0x2f, 0x5f, 0x7a, 0xbf, 0x1f, 0xd9, 0x75, 0x61, 0x5e, 0x2e, 0x2e, 0x86,
+	0x73, 0xf1, 0xb5, 0xe4, 0xf7, 0x84, 0xfe, 0x4d, 0x0e, 0x44, 0x36, 0x74, 0x15, 0x8a, 0x5e, 0x99,
+	0xe6, 0x8f, 0xa8, 0x5a, 0x88, 0xa9, 0x7a, 0x03, 0x04, 0x63, 0xe2, 0x6d, 0x41, 0x30, 0x26, 0xe8,
+	0x3e, 0x6c, 0x6a, 0xea, 0x84, 0x68, 0x67, 0x6a, 0x20, 0x4c, 0x81, 0x09, 0x13, 0x1f, 0x96, 0xa6,
+	0x70, 0x23, 0x1a, 0x98, 0x16, 0x0b, 0xb2, 0x0b, 0x55, 0xdb, 0xd4, 0x4f, 0xa2, 0xb2, 0x84, 0x87,
+	0x28, 0x85, 0x85, 0xdf, 0x9c, 0x44, 0x1d, 0x23, 0x3c, 0x24, 0x1d, 0x50, 0x9b, 0xb9, 0x98, 0x84,
+	0x57, 0x4d, 0xc6, 0xd4, 0x18, 0x1f, 0x21, 0xc9, 0xe7, 0x2b, 0x10, 0x9b, 0x67, 0x58, 0x3b, 0xcf,
+	0xe6, 0x93, 0xa1, 0x3a, 0xc9, 0x86, 0xcd, 0x21, 0x2b, 0x01, 0xf8, 0x51, 0x5d, 0xbc, 0x7d, 0xae,
+	0x6b, 0x21, 0xd0, 0xf5, 0x1e, 0x14, 0x68, 0x69, 0x59, 0xcb, 0x2f, 0x8c, 0x08, 0x8c, 0x4e, 0x3a,
+	0x83, 0xed, 0xb6, 0xeb, 0x4e, 0xb1, 0x82, 0x4f, 0x1d, 0xec, 0x9e, 0x0d, 0xec, 0x73, 0x6c, 0xa5,
+	0x8b, 0x2d, 0x42, 0xfe, 0xe7, 0xc4, 0xf0, 0x96, 0xa2, 0xcd, 0x84, 0x9d, 0x6f, 0x43, 0x85, 0x3a,
+	0x65, 0x63, 0x8c, 0x2d, 0xe2, 0x79, 0xe9, 0x6c, 0x40, 0xfa, 0x25, 0xac, 0x85, 0x17, 0xa1, 0x6e,
+	0x4b, 0x68, 0xc3, 0x5b, 0x83, 0x77, 0x68, 0xc5, 0x84, 0x59, 0x80, 0x66, 0xd5, 0xcc, 0xe2, 0x04,
+	0x19, 0xa2, 0xa6, 0xeb, 0xbb, 0xd8, 0x75, 0x0d, 0xdb, 0x6a, 0xfb, 0x66, 0x9e, 0x0d, 0x48, 0x63,
+	0xd8, 0x8c, 0x6f, 0x32, 0x5d, 0x84, 0xb7, 0xdd, 0xe8, 0x6f, 0x73, 0xb0, 0xa3, 0xd8, 0x44, 0x25,
+	0x31, 0xa5, 0xba, 0x13, 0xf4, 0x09, 0xac, 0x7a, 0xe1, 0x8f, 0x2d, 0x39, 0x3f, 0x4a, 0xfa, 0x64,
+	0xe8, 0x29, 0xac, 0x39, 0x21, 0x2e, 0x9e, 0x46, 0xde, 0x8d, 0x4d, 0x8b, 0x2c, 0x14, 0x99, 0x40,
+	0x03, 0xd2, 0x86, 0x82, 0x2f, 0xed, 0x73, 0x1c, 0xb6, 0x2d, 0xdd, 0x60, 0x6e, 0xb6, 0x41, 0xcf,
+	0xda, 0xc2, 0xcc, 0xda, 0x51, 0x3b, 0xe4, 0x97, 0xb2, 0x83, 0x14, 0x93, 0x99, 0x6b, 0x28, 0x32,
+	0x16, 0xb5, 0x55, 0x31, 0x6e, 0xab, 0x0b, 0xa8, 0x0e, 0xdd, 0xff, 0x98, 0xc0, 0xd2, 0xef, 0x72,
+	0x70, 0xa3, 0xed, 0x7a, 0xcb, 0x51, 0x55, 0xe9, 0xd7, 0x5d, 0xf5, 0x73, 0x28, 0x1b, 0xf4, 0xf8,
+	0xe8, 0x0d, 0x72, 0x8d, 0x35, 0x03, 0xda, 0xe8, 0xf6, 0x0b, 0xf1, 0xed, 0xef, 0x01, 0x8a, 0x8b,
+	0xe3, 0x4e, 0x50, 0x0d, 0x56, 0x1d, 0xde, 0x65, 0x32, 0x95, 0x15, 0xbf, 0x2b, 0x7d, 0x09, 0x1b,
+	0xb2, 0xe5, 0xd8, 0xa6, 0x39, 0xe8, 0x0d, 0x4e, 0x18, 0xed, 0x0e, 0x94, 0x5c, 0xac, 0x39, 0x98,
+	0x78, 0xe2, 0x7b, 0x3d, 0xb6, 0x03, 0x27, 0xf0, 0xed, 0xa9, 0x63, 0x48, 0x7f, 0xcb, 0x41, 0x95,
+	0x4e, 0x6b, 0xda, 0x3a, 0x4e, 0x3f, 0xf8, 0x08, 0x0a, 0x9a, 0xad, 0xfb, 0xd9, 0x96, 0xb5, 0xa9,
+	0x89, 0xb5, 0x33, 0xd5, 0x34, 0xb1, 0x35, 0xc6, 0xcf, 0x88, 0xe1, 0x9d, 0x8d, 0xc8, 0x18, 0xea,
+	0xc0, 0x56, 0xd0, 0x97, 0x67, 0xa6, 0x29, 0x2c, 0x54, 0x53, 0xda, 0x34, 0xef, 0x0c, 0x16, 0xfd,
+	0x33, 0x28, 0x3d, 0xa0, 0xc5, 0x81, 0x66, 0x5f, 0x62, 0xe7, 0x8a, 0x8a, 0xee, 0xb2, 0x6d, 0x6f,
+	0x43, 0x91, 0x8a, 0xc7, 0x6b, 0x90, 0x8a, 0xc2, 0x3b, 0xd2, 0xdf, 0x05, 0x58, 0x3d, 0x71, 0xec,
+	0x53, 0xc3, 0xc4, 0x29, 0xdb, 0xbb, 0x0d, 0x95, 0x53, 0xc3, 0x71, 0x49, 0xa8, 0xa2, 0x98, 0x0d,
+	0xd0, 0x60, 0x6d, 0xaa, 0xbc, 0xed, 0x27, 0x65, 0xbf, 0x4f, 0x67, 0xaa, 0x97, 0x2a, 0x51, 0x9d,
+	0xa1, 0x63, 0xfa, 0x46, 0x0c, 0x06, 0xe8, 0x4a, 0xaf, 0x0d, 0xdb, 0x93, 0x98, 0x36, 0xa9, 0xb3,
+	0xbc, 0x36, 0x1c, 0x72, 0xa6, 0xab, 0x57, 0xb5, 0xd2, 0x42, 0x2d, 0x04, 0xb4, 0xe8, 0x63, 0x28,
+	0x8d, 0xb1, 0x45, 0xeb, 0x86, 0x55, 0x56, 0xe8, 0xde, 0x8c, 0x9d, 0xfe, 0x43, 0xf6, 0x51, 0xf1,
+	0x88, 0xbc, 0x8b, 0xaf, 0x6a, 0x62, 0x56, 0x97, 0x56, 0x14, 0xaf, 0x17, 0xbb, 0x8c, 0x56, 0x96,
+	0xba, 0x8c, 0xde, 0x83, 0xf5, 0x89, 0x63, 0x5c, 0xaa, 0x04, 0x1f, 0x18, 0xd8, 0xd4, 0xdd, 0x1a,
+	0x30, 0x05, 0x47, 0x07, 0xa5, 0xa7, 0xb0, 0x7e, 0x88, 0x89, 0xa7, 0xea, 0x74, 0x67, 0xba, 0x0d,
+	0x95, 0x4b, 0x03, 0xbf, 0xc1, 0xce, 0x30, 0x38, 0x48, 0xb3, 0x01, 0xe9, 0x10, 0xb6, 0xf6, 0x55,
+	0xa2, 0x9d, 0xcd, 0xb8, 0xb8, 0xde, 0x7d, 0x64, 0x6a, 0xe8, 0xbe, 0x55, 0x59, 0x7b, 0x01, 0xa3,
+	0x67, 0xb0, 0x9d, 0x64, 0xc4, 0xeb, 0xd4, 0x89, 0xd7, 0x9f, 0x53, 0xa7, 0xfa, 0xd2, 0x07, 0x74,
+	0xd2, 0x37, 0x20, 0xf2, 0x9c, 0x1c, 0xda, 0xd8, 0x27, 0xb0, 0xea, 0x7d, 0x9f, 0x13, 0xc8, 0x7d,
+	0x5a, 0x9f, 0x8c, 0x5a, 0xe5, 0x94, 0xab, 0x4e, 0x60, 0xbb, 0xf0, 0x7a, 0xd2, 0x9f, 0x05, 0x58,
+	0xed, 0xf3, 0x93, 0xcf, 0x7c, 0xdc, 0xd7, 0x96, 0x60, 0xe8, 0xbe, 0xfa, 0x84, 0x44, 0x12, 0xce,
+	0xc7, 0x73, 0x53, 0x21, 0x3d, 0x37, 0x15, 0x63, 0xb9, 0x29, 0x06, 0x66, 0x94, 0x96, 0x02, 0x33,
+	0xbc, 0x8b, 0x4b, 0x43, 0x23, 0xc6, 0x25, 0x9f, 0xbf, 0x7a, 0xbd, 0x8b, 0xcb, 0x6c, 0x46, 0x2c,
+	0x4a, 0x97, 0x97, 0x8a, 0xd2, 0x07, 0x20, 0x76, 0x0c, 0x97, 0x78, 0xca, 0x0a, 0xec, 0xe9, 0x85,
+	0xcd, 0x79, 0xf6, 0xf4, 0xc8, 0x95, 0x80, 0x4e, 0x7a, 0x02, 0xe0, 0x0f, 0xce, 0x73, 0xd1, 0x59,
+	0x6c, 0x16, 0xe2, 0xb1, 0xf9, 0x7f, 0xa0, 0xda, 0xc7, 0x44, 0xb1, 0x3d, 0xd7, 0x4c, 0x4e, 0x0f,
+	0x20, 0x2d, 0x21, 0x04, 0x69, 0x49, 0x77, 0xa1, 0xe2, 0xcd, 0xe1, 0x61, 0x8a, 0x93, 0xe4, 0xc2,
+	0x24, 0x5f, 0xc0, 0x06, 0x2f, 0xc2, 0x65, 0x5a, 0xa2, 0xcf, 0x65, 0xce, 0x6b, 0x7d, 0x21, 0x54,
+	0xeb, 0x4b, 0xaf, 0x60, 0xab, 0x4f, 0x54, 0x87, 0xb0, 0x89, 0xbc, 0x80, 0x5e, 0xba, 0xf4, 0x4c,
+	0x87, 0xf4, 0xa4, 0x36, 0xdc, 0x6c, 0xda, 0xd6, 0xa9, 0xe1, 0x5c, 0x2c, 0x64, 0x7e, 0x07, 0xc0,
+	0x36, 0xf5, 0x86, 0xae, 0x3b, 0xd8, 0x75, 0x3d, 0xac, 0x21, 0x34, 0x22, 0x7d, 0x0b, 0x3b, 0x69,
+	0xac, 0xdc, 0x09, 0x3d, 0xdf, 0x3a, 0xbd, 0x82, 0xf1, 0xb4, 0xc6, 0xda, 0xe1, 0x52, 0x49, 0xb8,
+	0x56, 0xa9, 0x24, 0x99, 0x80, 0x14, 0xfc, 0xfd, 0x14, 0xbb, 0xa4, 0x85, 0x4d, 0x4c, 0xe6, 0xda,
+	0x37, 0x4b, 0x09, 0xf7, 0x61, 0xd3, 0xc1, 0xf4, 0xd2, 0x85, 0x2d, 0x62, 0x68, 0xf4, 0xc6, 0xce,
+	0xd4, 0x51, 0x56, 0xe2, 0xc3, 0xd2, 0x5f, 0x72, 0xb0, 0xe6, 0xaf, 0xd3, 0x27, 0x78, 0x92, 0x8a,
+	0x20, 0xf9, 0x1b, 0x13, 0x42, 0x1b, 0xfb, 0x1c, 0xca, 0xf4, 0x2f, 0x3b, 0x40, 0xd7, 0x28, 0x19,
+	0x7c, 0x5a, 0x2a, 0xb6, 0x4a, 0x08, 0x05, 0x9c, 0x5d, 0x76, 0xdc, 0xf3, 0x4a, 0xd0, 0xa7, 0x2e,
+	0x4b, 0x0f, 0x1a, 0x43, 0x85, 0xfd, 0x43, 0x1f, 0x0c, 0x48, 0x7f, 0x12, 0x60, 0xd3, 0xd3, 0x96,
+	0x2f, 0x71, 0x3a, 0x36, 0xe5, 0x78, 0xea, 0x53, 0x09, 0x17, 0x79, 0x01, 0x1e, 0x12, 0x22, 0x47,
+	0x5f, 0xc1, 0xba, 0xab, 0x9d, 0x61, 0x7d, 0x6a, 0x62, 0xfd, 0x9a, 0x5b, 0x8b, 0x4e, 0x08, 0x74,
+	0x55, 0x98, 0xa3, 0xab, 0xe2, 0x12, 0xba, 0xfa, 0x14, 0x8a, 0x2e, 0xc1, 0x13, 0x0e, 0x2b, 0x27,
+	0xcb, 0xe5, 0xb0, 0xdd, 0x14, 0x4e, 0x29, 0xfd, 0x23, 0x07, 0x55, 0x76, 0x61, 0x6f, 0x9a, 0x06,
+	0x8d, 0x94, 0x75, 0x28, 0x6b, 0xac, 0xd5, 0xf6, 0xb5, 0x14, 0xf4, 0x03, 0x53, 0x0b, 0x21, 0x53,
+	0xd3, 0x8a, 0x4b, 0xb3, 0x27, 0x98, 0x83, 0x61, 0x15, 0xc5, 0xeb, 0xc5, 0x22, 0x6e, 0xe1, 0x2d,
+	0xe0, 0xe3, 0xe2, 0xb2, 0xf0, 0xb1, 0x83, 0x75, 0xc3, 0xc1, 0x1a, 0x19, 0x3a, 0x86, 0x0f, 0xb0,
+	0x47, 0xc6, 0x24, 0x15, 0x36, 0x9b, 0x6c, 0x35, 0xbe, 0x67, 0x2f, 0xd5, 0x26, 0xbc, 0x78, 0xb6,
+	0x35, 0x21, 0xb2, 0xb5, 0xf8, 0x12, 0xf9, 0x94, 0x25, 0xbe, 0x05, 0x31, 0xba, 0x04, 0x0b, 0xda,
+	0x25, 0xae, 0x4a, 0x2f, 0x77, 0xc6, 0x1f, 0x37, 0x42, 0x66, 0x50, 0x3c, 0xca, 0x50, 0x41, 0x2b,
+	0x84, 0x0b, 0x5a, 0xe9, 0x29, 0x54, 0x39, 0xa5, 0xeb, 0x5d, 0xb0, 0x8a, 0x06, 0xc1, 0x17, 0x7e,
+	0x32, 0xc8, 0xe2, 0xcc, 0x09, 0xa5, 0x07, 0x3e, 0x03, 0x8e, 0xeb, 0x66, 0x98, 0x5d, 0x92, 0x61,
+	0x93, 0x07, 0xe8, 0x99, 0xba, 0xb2, 0xbc, 0x64, 0x9e, 0xc8, 0x7f, 0x10, 0x60, 0xfd, 0x04, 0x3b,
+	0xae, 0x6d, 0xa9, 0x26, 0xbf, 0x0c, 0x2d, 0xce, 0xfb, 0xbe, 0x59, 0xf2, 0xa9, 0x66, 0x29, 0x64,
+	0x78, 0x5c, 0x71, 0x59, 0x8f, 0x0b, 0xe5, 0xe7, 0xd2, 0x52, 0xd7, 0x3e, 0x0f, 0x94, 0x1c, 0xba,
+	0x58, 0x67, 0xb3, 0x57, 0xaf, 0x07, 0x4a, 0xfa, 0xf4, 0xd2, 0xef, 0x73, 0xb0, 0xc3, 0x7d, 0x25,
+	0xa2, 0x9d, 0xb9, 0x97, 0x92, 0x65, 0x8e, 0x20, 0x5e, 0xe6, 0xfe, 0x11, 0x2e, 0x3a, 0x34, 0x78,
+	0x27, 0x55, 0x26, 0xe6, 0xc6, 0x21, 0xf4, 0xa0, 0xfa, 0xf8, 0x76, 0xbc, 0x02, 0x8c, 0x4c, 0xe0,
+	0xa4, 0xe9, 0x0f, 0x11, 0xd2, 0x11, 0xa0, 0x08, 0xb5, 0x5f, 0xdb, 0x44, 0x7c, 0x79, 0x01, 0x7f,
+	0xee, 0xcd, 0xff, 0x0d, 0xe2, 0x35, 0x94, 0xc7, 0xfd, 0xcd, 0x07, 0x8d, 0xe8, 0x7d, 0x73, 0x87,
+	0x3b, 0x76, 0x62, 0x6e, 0x20, 0x6f, 0x2e, 0x2c, 0xef, 0x0f, 0xb0, 0xd6, 0x31, 0xac, 0xf3, 0x00,
+	0xdf, 0x4c, 0xae, 0xf0, 0xf6, 0x88, 0xe7, 0x36, 0x14, 0x2f, 0xb0, 0x33, 0xf6, 0xf3, 0x01, 0xef,
+	0x48, 0x47, 0xb0, 0x31, 0xb4, 0xcc, 0x7f, 0xc3, 0xda, 0x0f, 0xff, 0x98, 0x87, 0x6a, 0xe8, 0x15,
+	0x15, 0x21, 0xd8, 0x18, 0x76, 0x9f, 0x77, 0x7b, 0x2f, 0xbb, 0x23, 0x45, 0x6e, 0xf4, 0x7b, 0x5d,
+	0x71, 0x85, 0x8e, 0x35, 0x9a, 0xcd, 0xde, 0xb0, 0x3b, 0x18, 0x75, 0x7a, 0xcd, 0xe7, 0x72, 0x4b,
+	0xcc, 0xa1, 0x77, 0x60, 0xab, 0xa9, 0xc8, 0x2d, 0xb9, 0x3b, 0x68, 0x37, 0x3a, 0xfd, 0x91, 0xfc,
+	0x93, 0x93, 0xb6, 0x22, 0xb7, 0x44, 0x01, 0xd5, 0x60, 0xfb, 0x78, 0xd8, 0x1f, 0x8c, 0x9a, 0x47,
+	0x8d, 0xee, 0xa1, 0x3c, 0x3a, 0x69, 0xf4, 0xfb, 0x2f, 0x7b, 0x4a, 0x4b, 0xcc, 0xa3, 0x6d, 0x10,
+	0x9b, 0x8d, 0x93, 0x41, 0xf3, 0xa8, 0x31, 0x52, 0xe4, 0xaf, 0x87, 0x8c, 0xbe, 0x80, 0x76, 0x00,
+	0xf9, 0x34, 0xa3, 0x41, 0xaf, 0x37, 0xea, 0x1f, 0xf5, 0x94, 0x81, 0x58, 0x44, 0x37, 0xe1, 0x46,
+	0x64, 0xbc, 0xd3, 0xeb, 0x1e, 0x8a, 0x25, 0x74, 0x1b, 0x6a, 0xc1, 0xf0, 0x71, 0xbb, 0xdf, 0x6f,
+	0x77, 0x0f, 0x47, 0xcd, 0x4e, 0xa3, 0xdf, 0x97, 0xfb, 0xe2, 0x2a, 0x95, 0x2a, 0x32, 0xa9, 0xd9,
+	0x3b, 0x3e, 0xee, 0x75, 0xc5, 0x32, 0xda, 0x82, 0xcd, 0xe0, 0x83, 0x22, 0x0f, 0xfb, 0x72, 0x4b,
+	0xac, 0x50, 0x51, 0x7b, 0x8d, 0xe1, 0xe0, 0x68, 0xd4, 0x66, 0xfb, 0x18, 0xbc, 0x1a, 0x0d, 0x1a,
+	0xcf, 0xe5, 0xae, 0x08, 0xe8, 0x16, 0xdc, 0xe4, 0x5f, 0x4e, 0x94, 0xde, 0x8b, 0x76, 0x4b, 0x56,
+	0x46, 0x9d, 0x76, 0x97, 0x6e, 0xbc, 0x4a, 0xe5, 0xea, 0x34, 0xfa, 0x54, 0x13, 0x87, 0xed, 0xee,
+	0xe8, 0x58, 0x1e, 0x1c, 0xf5, 0x5a, 0xe2, 0x1a, 0x1d, 0x3e, 0x96, 0x95, 0x43, 0x79, 0xd4, 0xed,
+	0x0d, 0x46, 0x8d, 0x4e, 0xa7, 0xf7, 0x52, 0x6e, 0x89, 0xeb, 0x74, 0x5d, 0x45, 0x66, 0x9c, 0x82,
+	0x2d, 0x6f, 0x50, 0x7d, 0x36, 0x8f, 0x1a, 0x9d, 0x8e, 0x4c, 0x15, 0xc4, 0x64, 0xd9, 0xa4, 0xb2,
+	0x28, 0x72, 0xab, 0xad, 0xc8, 0xcd, 0xc1, 0x68, 0xa8, 0xb4, 0x47, 0xed, 0xee, 0x8b, 0x46, 0xa7,
+	0xdd, 0x12, 0xc5, 0x87, 0x1f, 0x01, 0xcc, 0x9e, 0x70, 0xd0, 0x2a, 0xe4, 0x1b, 0xdd, 0x57, 0xe2,
+	0x0a, 0x6d, 0xbc, 0x92, 0xfb, 0x62, 0x0e, 0x95, 0x40, 0xe8, 0xf6, 0x44, 0xe1, 0xe1, 0x03, 0x28,
+	0xf1, 0x1b, 0x30, 0xfd, 0x74, 0xdc, 0xa0, 0x86, 0xab, 0x40, 0xf1, 0x65, 0x8f, 0x36, 0x73, 0xa8,
+	0x0a, 0xab, 0x9e, 0x5d, 0x45, 0xe1, 0xf1, 0x3f, 0xdf, 0x83, 0xfc, 0xb0, 0xd1, 0x40, 0x4f, 0xa1,
+	0xc4, 0x1f, 0x2f, 0x50, 0x2d, 0x71, 0x97, 0xf6, 0x1e, 0xc1, 0xea, 0xb7, 0xe6, 0x7c, 0x71, 0x27,
+	0xd2, 0x0a, 0x7a, 0xc2, 0x18, 0xf4, 0x2c, 0x9c, 0xc6, 0x80, 0xbf, 0x9f, 0xd4, 0xe7, 0x14, 0xac,
+	0xd2, 0x0a, 0xea, 0xce, 0xde, 0x08, 0xf6, 0xaf, 0xf8, 0xd3, 0x06, 0xda, 0x4d, 0x40, 0x7a, 0xb1,
+	0x97, 0x8f, 0x0c, 0x7e, 0x1d, 0xd8, 0xf4, 0xc9, 0xf7, 0xaf, 0xd8, 0xc9, 0x40, 0xef, 0xcf, 0x61,
+	0xe7, 0x9f, 0x9b, 0x0c, 0x6e, 0xcf, 0xfd, 0x7b, 0x48, 0x80, 0xa0, 0xc7, 0x99, 0xc5, 0xdf, 0x0a,
+	0x32, 0x45, 0xdb, 0x88, 0xbd, 0x00, 0xc7, 0x37, 0x9a, 0x00, 0xfc, 0xeb, 0x3b, 0x89, 0xd8, 0x2c,
+	0xd3, 0x9f, 0x59, 0x48, 0x2b, 0xe8, 0x19, 0xac, 0x47, 0x80, 0xfa, 0x94, 0x6d, 0x46, 0x61, 0xfc,
+	0x6c, 0x5e, 0x11, 0xb0, 0x3e, 0xc1, 0x2b, 0x0e, 0xe5, 0x67, 0xf0, 0x3a, 0x82, 0xb5, 0x30, 0x6c,
+	0x8f, 0xee, 0xc4, 0x58, 0xc5, 0x30, 0xfd, 0x0c, 0x4e, 0x4f, 0xa0, 0xc2, 0xaa, 0x53, 0x4c, 0x7d,
+	0x2b, 0x0e, 0xf4, 0xf0, 0xb7, 0xe7, 0x4c, 0x39, 0xd6, 0x5b, 0x76, 0xe8, 0x15, 0x1a, 0xbd, 0x17,
+	0xe3, 0x10, 0x7d, 0xa1, 0xce, 0xe0, 0xb4, 0x0f, 0x9b, 0x2d, 0x7b, 0x68, 0x99, 0x21, 0x5e, 0x4b,
+	0x4b, 0xf3, 0x02, 0xb6, 0x5b, 0x76, 0xf2, 0xc5, 0x1a, 0xdd, 0x8b, 0x2b, 0x3a, 0xed, 0x51, 0x3b,
+	0x83, 0xaf, 0x0c, 0xa8, 0x65, 0x2b, 0xd8, 0xc2, 0x6f, 0x42, 0x33, 0x97, 0x17, 0xef, 0x25, 0xdc,
+	0x48, 0x3c, 0x7d, 0xa0, 0x0f, 0x62, 0x5c, 0xd2, 0x1e, 0x47, 0xea, 0x59, 0xf0, 0xbb, 0xb4, 0x82,
+	0x7e, 0x06, 0x28, 0x89, 0xff, 0x27, 0x7c, 0x22, 0xce, 0x34, 0xfe, 0x0b, 0x97, 0xf4, 0x27, 0x04,
+	0x69, 0x05, 0x1d, 0x40, 0x35, 0x04, 0xe8, 0x27, 0x4c, 0x1c, 0x05, 0xfb, 0xb3, 0x4d, 0xcc, 0x69,
+	0x1b, 0xa6, 0x57, 0x76, 0xfc, 0x18, 0x1d, 0x6e, 0x44, 0x91, 0xea, 0xc4, 0xf1, 0x4e, 0xe0, 0xea,
+	0xf5, 0xbb, 0x0b, 0x28, 0xd8, 0x26, 0xbf, 0x82, 0xb2, 0xff, 0x02, 0x80, 0xe2, 0x55, 0x7c, 0xe8,
+	0x69, 0x20, 0x43, 0xb4, 0xff, 0x83, 0xf2, 0xa1, 0x07, 0xd4, 0xcc, 0xdb, 0x57, 0x2d, 0xa1, 0x72,
+	0x0f, 0xa1, 0x61, 0xda, 0x29, 0xfb, 0x38, 0x4f, 0x42, 0x80, 0x10, 0x00, 0x94, 0xc9, 0xe3, 0x08,
+	0xd6, 0xc2, 0x88, 0xd5, 0x3c, 0x31, 0xe2, 0x81, 0x27, 0x8e, 0x72, 0x49, 0x2b, 0xa8, 0x05, 0xeb,
+	0x5c, 0x3f, 0xde, 0x38, 0xba, 0x35, 0x07, 0xe6, 0x5a, 0x10, 0xf2, 0xb6, 0x38, 0x97, 0x1e, 0x39,
+	0xc3, 0x4e, 0x20, 0xd6, 0x8f, 0xe2, 0xd5, 0x02, 0x98, 0xbd, 0x39, 0xcc, 0xdb, 0x59, 0xdc, 0x37,
+	0xa3, 0xaf, 0x14, 0xd2, 0x0a, 0x3a, 0x86, 0xaa, 0x87, 0x09, 0x31, 0x36, 0x71, 0x45, 0x87, 0x1e,
+	0x26, 0xea, 0xc9, 0x04, 0x19, 0x43, 0xff, 0x99, 0xd1, 0x80, 0xe7, 0xa6, 0x85, 0xdc, 0xe6, 0x67,
+	0xac, 0x26, 0x54, 0x5b, 0x86, 0x4b, 0x7f, 0x81, 0x74, 0x0d, 0x26, 0xf3, 0xb4, 0xf3, 0x0a, 0xde,
+	0x51, 0xf0, 0x18, 0x5b, 0xd8, 0x61, 0x67, 0x38, 0x24, 0xe9, 0x5b, 0xef, 0xb1, 0xc5, 0x7e, 0x78,
+	0xe1, 0xbf, 0x67, 0xdc, 0x4e, 0x96, 0x1f, 0x33, 0x98, 0xba, 0x3e, 0x07, 0x95, 0x66, 0x31, 0x4a,
+	0x8c, 0x03, 0xe4, 0x48, 0x8a, 0xff, 0x80, 0x26, 0x09, 0xc5, 0xd7, 0x3f, 0x58, 0x48, 0xc3, 0x84,
+	0x7c, 0x06, 0xeb, 0x11, 0xcc, 0x3c, 0x91, 0x5c, 0xe3, 0x88, 0x7a, 0x86, 0xa8, 0x07, 0x50, 0x0d,
+	0xe1, 0xa2, 0x89, 0x78, 0x17, 0xc5, 0x4c, 0x33, 0x6c, 0x72, 0x02, 0x62, 0x1c, 0x25, 0x4d, 0x6c,
+	0x39, 0x05, 0x46, 0xcd, 0x70, 0x15, 0x0d, 0x50, 0x12, 0xd1, 0x4c, 0xa6, 0xb7, 0x34, 0xfc, 0xb4,
+	0xfe, 0xe1, 0x35, 0xa8, 0x98, 0x2a, 0x5f, 0xd0, 0x30, 0x1d, 0x81, 0x35, 0xd1, 0xdd, 0x84, 0x9b,
+	0xc4, 0x61, 0xcf, 0xfa, 0x9d, 0x74, 0xa1, 0x7d, 0x12, 0x69, 0x05, 0x35, 0x60, 0xa3, 0xa9, 0x5a,
+	0x1a, 0x36, 0x03, 0xb6, 0x4b, 0x47, 0xff, 0x03, 0xa8, 0x1e, 0x62, 0xb2, 0x68, 0xfe, 0x62, 0x51,
+	0xbe, 0x86, 0xb5, 0x30, 0x48, 0x94, 0x48, 0x95, 0x31, 0x90, 0xaa, 0xfe, 0x7e, 0xe6, 0x77, 0x3f,
+	0x12, 0x1c, 0x62, 0xc2, 0x87, 0x5c, 0x34, 0x67, 0x0b, 0xf5, 0xf8, 0x59, 0x0c, 0x41, 0x49, 0xec,
+	0xa4, 0x71, 0x84, 0xd7, 0x17, 0x2b, 0x9d, 0x7a, 0x91, 0x92, 0x3a, 0xb0, 0x16, 0x46, 0x8d, 0x12,
+	0x9b, 0x8b, 0x41, 0x4a, 0xf5, 0x0c, 0xcc, 0x8a, 0xd5, 0x3e, 0x95, 0x60, 0x5f, 0x99, 0x02, 0x65,
+	0xb3, 0x39, 0x85, 0xad, 0x14, 0x58, 0x03, 0x7d, 0x98, 0xaa, 0xd8, 0x38, 0x2a, 0x50, 0xff, 0xe8,
+	0x3a, 0x64, 0x4c, 0x85, 0x27, 0x80, 0x68, 0x36, 0x8b, 0x7c, 0x9a, 0x9b, 0x07, 0xef, 0x66, 0x21,
+	0x1c, 0x6e, 0xc0, 0xd1, 0xcb, 0x61, 0x51, 0xc9, 0xdf, 0xcf, 0x9a, 0x9b, 0x6d, 0xa0, 0x6f, 0x60,
+	0x2b, 0x05, 0xfd, 0x48, 0xe8, 0x22, 0x1d, 0x21, 0xa9, 0x67, 0xc2, 0x32, 0xcc, 0x11, 0x2b, 0x01,
+	0x56, 0x82, 0xde, 0x4d, 0x64, 0xfa, 0x19, 0x92, 0x91, 0x11, 0x67, 0x0e, 0xa0, 0x1a, 0x42, 0x3d,
+	0x12, 0x11, 0x30, 0x8a, 0x88, 0xcc, 0xe7, 0xb3, 0x5f, 0xfc, 0x69, 0x7e, 0xaa, 0xaa, 0xaf, 0x4b,
+	0x4c, 0x05, 0x9f, 0xfd, 0x6b, 0x00, 0x79, 0x65, 0x5c, 0x8a, 0x21, 0x2f, 0x00, 0x00,
 }
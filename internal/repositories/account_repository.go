@@ -2,9 +2,17 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/bson/primitive"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"regexp"
+	"strings"
 	"teddy-backend/internal/models"
 	"teddy-backend/internal/proto/uaa"
-	"upper.io/db.v3"
+	"time"
 )
 
 type AccountRepository interface {
@@ -13,116 +21,421 @@ type AccountRepository interface {
 	FindAccountByUsername(username string) (*models.Account, error)
 	FindAccountByEmail(email string) (*models.Account, error)
 	FindAccountByPhone(phone string) (*models.Account, error)
-	FindAll(page, size uint, sorts []*uaa.Sort) ([]*models.Account, error)
+	FindAccountByOAuth(provider string, oauthUID string) (*models.Account, error)
+	FindAll(filter *AccountFilter, page, size uint, sorts []*uaa.Sort) ([]*models.Account, uint64, error)
 	DeleteOne(uid string) error
 	UpdateOne(uid string, account map[string]interface{}) error
+	UpdateTOTPCounter(uid string, counter int64) error
+	PullRecoveryCode(uid string, code string) error
+	VerifyEmail(uid string, email string) error
+	ConfirmEmailChange(uid string, oldAddress bool) (*models.Account, error)
+	ChangeEmail(uid string, email string) error
 }
 
-func NewAccountRepository(sess db.Database) (AccountRepository, error) {
-	return &accountRepository{
-		ctx:        context.Background(),
-		sess:       sess,
-		collection: sess.Collection("account"),
-	}, nil
+// AccountFilter narrows FindAll, zero fields don't filter.
+type AccountFilter struct {
+	Role               string
+	Locked             *bool
+	CredentialsExpired *bool
+	CreatedFrom        *time.Time
+	CreatedTo          *time.Time
+	LastSignInFrom     *time.Time
+	LastSignInTo       *time.Time
+	// Prefix of username or email
+	Prefix string
+}
+
+func NewAccountRepository(client *mongo.Client) (AccountRepository, error) {
+	repo := &accountRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("account"),
+	}
+
+	// Emails stored before they were normalized would fail the unique index
+	err := repo.normalizeEmails()
+	if err != nil {
+		return nil, err
+	}
+
+	// For login lookups and the admin listing
+	_, err = repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"username", 1}},
+		},
+		{
+			// Accounts without email are left out
+			Keys: bson.D{{"email", 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.D{{"email", bson.D{{"$gt", ""}}}}),
+		},
+		{
+			Keys: bson.D{{"phone", 1}},
+		},
+		{
+			Keys: bson.D{{"roles", 1}, {"create_date", -1}},
+		},
+		{
+			Keys: bson.D{{"locked", 1}, {"locked_until", 1}},
+		},
+		{
+			Keys: bson.D{{"credentials_expired", 1}},
+		},
+		{
+			Keys: bson.D{{"create_date", -1}},
+		},
+		{
+			Keys: bson.D{{"last_sign_in_time", -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
 }
 
 type accountRepository struct {
-	ctx        context.Context
-	sess       db.Database
-	collection db.Collection
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
 }
 
-func (repo *accountRepository) InsertAccount(account *models.Account) error {
-	err := repo.collection.InsertReturning(account)
+// NormalizeEmail is the form emails are stored and looked up in, addresses
+// differing in case only are the same account.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizeEmails lowercases emails stored before, and leaves each email to
+// one account: the verified one, else the oldest. The others have it moved
+// to duplicate_email, unverified, for administrators to sort out.
+func (repo *accountRepository) normalizeEmails() error {
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"email", bson.D{{"$gt", ""}}}}}},
+		bson.D{{"$sort", bson.D{{"email_verified", -1}, {"create_date", 1}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"$toLower", "$email"}}},
+			{"accounts", bson.D{{"$push", bson.D{{"_id", "$_id"}, {"email", "$email"}}}}},
+		}}},
+		bson.D{{"$match", bson.D{{"$or", bson.A{
+			bson.D{{"accounts.1", bson.D{{"$exists", true}}}},
+			bson.D{{"accounts.email", bson.D{{"$regex", "[A-Z]"}}}},
+		}}}}},
+	}
+	cur, err := repo.collections.Aggregate(repo.ctx, pipeline)
 	if err != nil {
 		return err
 	}
+	type emailGroup struct {
+		Accounts []struct {
+			UID   string `bson:"_id"`
+			Email string `bson:"email"`
+		} `bson:"accounts"`
+	}
+	var groups []emailGroup
+	defer cur.Close(repo.ctx)
+	for cur.Next(repo.ctx) {
+		var group emailGroup
+		err := cur.Decode(&group)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, group)
+	}
+	err = cur.Err()
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		// Duplicates go first, so the kept one can take the lowercase email
+		for _, acc := range group.Accounts[1:] {
+			_, err := repo.collections.UpdateOne(repo.ctx, bson.D{{"_id", acc.UID}}, bson.D{
+				{"$set", bson.D{{"duplicate_email", acc.Email}, {"email_verified", false}}},
+				{"$unset", bson.D{{"email", ""}}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		kept := group.Accounts[0]
+		if email := NormalizeEmail(kept.Email); email != kept.Email {
+			_, err := repo.collections.UpdateOne(repo.ctx, bson.D{{"_id", kept.UID}},
+				bson.D{{"$set", bson.D{{"email", email}}}})
+			if err != nil {
+				return duplicateKeyError(err)
+			}
+		}
+	}
 	return nil
 }
 
-func (repo *accountRepository) FindOne(principal string) (*models.Account, error) {
-	var account models.Account
-	result := repo.collection.Find(db.Or(
-		db.Cond{"id": principal},
-		db.Cond{"username": principal},
-		db.Cond{"email": principal},
-		db.Cond{"phone": principal},
-	))
-	err := result.One(&account)
-	if err != nil {
-		return nil, err
+// ErrDuplicateKey means a unique field, e.g. email, is taken by another
+// account.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+func duplicateKeyError(err error) error {
+	if wes, ok := err.(mongo.WriteErrors); ok {
+		for _, we := range wes {
+			if we.Code == 11000 {
+				return ErrDuplicateKey
+			}
+		}
 	}
-	return &account, nil
+	return err
 }
 
-func (repo *accountRepository) FindAccountByUsername(username string) (*models.Account, error) {
-	var account models.Account
-	result := repo.collection.Find(
-		db.Cond{"username": username},
-	)
-	err := result.One(&account)
+func (repo *accountRepository) InsertAccount(account *models.Account) error {
+	account.Email = NormalizeEmail(account.Email)
+	_, err := repo.collections.InsertOne(repo.ctx, account)
 	if err != nil {
-		return nil, err
+		return duplicateKeyError(err)
 	}
-	return &account, nil
+	return nil
 }
 
-func (repo *accountRepository) FindAccountByEmail(email string) (*models.Account, error) {
+func (repo *accountRepository) findOneByFilter(filter interface{}) (*models.Account, error) {
 	var account models.Account
-	result := repo.collection.Find(
-		db.Cond{"email": email},
-	)
-	err := result.One(&account)
+	err := repo.collections.FindOne(repo.ctx, filter).Decode(&account)
 	if err != nil {
 		return nil, err
 	}
 	return &account, nil
 }
 
+func (repo *accountRepository) FindOne(principal string) (*models.Account, error) {
+	filter := bson.D{{"$or", bson.A{
+		bson.D{{"_id", principal}},
+		bson.D{{"username", principal}},
+		bson.D{{"email", NormalizeEmail(principal)}},
+		bson.D{{"phone", principal}},
+	}}}
+	return repo.findOneByFilter(filter)
+}
+
+func (repo *accountRepository) FindAccountByUsername(username string) (*models.Account, error) {
+	return repo.findOneByFilter(bson.D{{"username", username}})
+}
+
+func (repo *accountRepository) FindAccountByEmail(email string) (*models.Account, error) {
+	return repo.findOneByFilter(bson.D{{"email", NormalizeEmail(email)}})
+}
+
 func (repo *accountRepository) FindAccountByPhone(phone string) (*models.Account, error) {
-	var account models.Account
-	result := repo.collection.Find(
-		db.Cond{"phone": phone},
-	)
-	err := result.One(&account)
-	if err != nil {
-		return nil, err
+	return repo.findOneByFilter(bson.D{{"phone", phone}})
+}
+
+func (repo *accountRepository) FindAccountByOAuth(provider string, oauthUID string) (*models.Account, error) {
+	return repo.findOneByFilter(bson.D{{fmt.Sprintf("oauth_uids.%s", provider), oauthUID}})
+}
+
+func timeRange(from, to *time.Time) bson.D {
+	var r bson.D
+	if from != nil {
+		r = append(r, bson.E{Key: "$gte", Value: *from})
 	}
-	return &account, nil
+	if to != nil {
+		r = append(r, bson.E{Key: "$lt", Value: *to})
+	}
+	return r
 }
 
-func (repo *accountRepository) FindAll(page, size uint, sorts []*uaa.Sort) ([]*models.Account, error) {
-	var itemsSorts = make([]interface{}, 0, len(sorts))
+func buildAccountFilter(filter *AccountFilter) bson.D {
+	result := bson.D{}
+	if filter == nil {
+		return result
+	}
+
+	// Conditions with their own $or are joined by $and
+	var and bson.A
+	if filter.Role != "" {
+		result = append(result, bson.E{Key: "roles", Value: filter.Role})
+	}
+	if filter.Locked != nil {
+		// A lock with until in the past has expired
+		active := bson.A{
+			bson.D{{"locked_until", time.Time{}}},
+			bson.D{{"locked_until", bson.D{{"$gt", time.Now()}}}},
+		}
+		if *filter.Locked {
+			result = append(result, bson.E{Key: "locked", Value: true})
+			and = append(and, bson.D{{"$or", active}})
+		} else {
+			and = append(and, bson.D{{"$or", bson.A{
+				bson.D{{"locked", false}},
+				bson.D{{"$nor", active}},
+			}}})
+		}
+	}
+	if filter.CredentialsExpired != nil {
+		result = append(result, bson.E{Key: "credentials_expired", Value: *filter.CredentialsExpired})
+	}
+	if r := timeRange(filter.CreatedFrom, filter.CreatedTo); len(r) != 0 {
+		result = append(result, bson.E{Key: "create_date", Value: r})
+	}
+	if r := timeRange(filter.LastSignInFrom, filter.LastSignInTo); len(r) != 0 {
+		result = append(result, bson.E{Key: "last_sign_in_time", Value: r})
+	}
+	if filter.Prefix != "" {
+		pattern := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Prefix)}
+		and = append(and, bson.D{{"$or", bson.A{
+			bson.D{{"username", pattern}},
+			bson.D{{"email", pattern}},
+		}}})
+	}
+	if len(and) != 0 {
+		result = append(result, bson.E{Key: "$and", Value: and})
+	}
+	return result
+}
+
+func (repo *accountRepository) FindAll(filter *AccountFilter, page, size uint,
+	sorts []*uaa.Sort) ([]*models.Account, uint64, error) {
+	bsonFilter := buildAccountFilter(filter)
+
+	totalCount, err := repo.collections.CountDocuments(repo.ctx, bsonFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var itemsSorts = make(bson.D, 0, len(sorts))
 	if len(sorts) != 0 {
 		for _, sort := range sorts {
 			if sort.Asc {
-				itemsSorts = append(itemsSorts, sort.Name)
+				itemsSorts = append(itemsSorts, bson.E{Key: sort.Name, Value: 1})
 			} else {
-				itemsSorts = append(itemsSorts, "-"+sort.Name)
+				itemsSorts = append(itemsSorts, bson.E{Key: sort.Name, Value: -1})
 			}
 		}
 	}
 
+	opts := options.Find().SetSkip(int64(size * page)).SetLimit(int64(size))
+	if len(itemsSorts) != 0 {
+		opts.SetSort(itemsSorts)
+	}
+	cur, err := repo.collections.Find(repo.ctx, bsonFilter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(repo.ctx)
 	accounts := make([]*models.Account, 0, size)
-	err := repo.collection.Find().OrderBy(itemsSorts...).Paginate(size).Page(page).All(accounts)
+	for cur.Next(repo.ctx) {
+		var account models.Account
+		err := cur.Decode(&account)
+		if err != nil {
+			return nil, 0, err
+		}
+		accounts = append(accounts, &account)
+	}
+	err = cur.Err()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return accounts, nil
+	return accounts, uint64(totalCount), nil
 }
 
 func (repo *accountRepository) DeleteOne(uid string) error {
-	err := repo.collection.Find(db.Cond{"id": uid}).Delete()
+	filter := bson.D{{"_id", uid}}
+	dr, err := repo.collections.DeleteOne(repo.ctx, filter)
 	if err != nil {
-		return nil
+		return err
+	} else if dr.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
 	}
-	return err
+	return nil
 }
 
 func (repo *accountRepository) UpdateOne(uid string, fields map[string]interface{}) error {
-	err := repo.collection.Find(db.Cond{"id": uid}).Update(fields)
+	filter := bson.D{{"_id", uid}}
+	var bsonFields = make(bson.D, 0, len(fields))
+	for k, v := range fields {
+		bsonFields = append(bsonFields, bson.E{Key: k, Value: v})
+	}
+	update := bson.D{{"$set", bsonFields}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// VerifyEmail marks email verified, mongo.ErrNoDocuments means it isn't the
+// email of the account any more.
+func (repo *accountRepository) VerifyEmail(uid string, email string) error {
+	filter := bson.D{{"_id", uid}, {"email", NormalizeEmail(email)}}
+	update := bson.D{{"$set", bson.D{{"email_verified", true}, {"update_date", time.Now()}}}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ConfirmEmailChange marks one address of the pending change confirmed and
+// returns the updated account, mongo.ErrNoDocuments means nothing pending.
+func (repo *accountRepository) ConfirmEmailChange(uid string, oldAddress bool) (*models.Account, error) {
+	field := "email_change.new_confirmed"
+	if oldAddress {
+		field = "email_change.old_confirmed"
+	}
+	filter := bson.D{{"_id", uid}, {"email_change.expire_time", bson.D{{"$gt", time.Now()}}}}
+	update := bson.D{{"$set", bson.D{{field, true}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var account models.Account
+	err := repo.collections.FindOneAndUpdate(repo.ctx, filter, update, opts).Decode(&account)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// ChangeEmail switches to the pending email as verified. mongo.ErrNoDocuments
+// means the change isn't pending any more, ErrDuplicateKey that the email has
+// been taken meanwhile.
+func (repo *accountRepository) ChangeEmail(uid string, email string) error {
+	filter := bson.D{{"_id", uid}, {"email_change.email", email}}
+	update := bson.D{
+		{"$set", bson.D{{"email", NormalizeEmail(email)}, {"email_verified", true}, {"update_date", time.Now()}}},
+		{"$unset", bson.D{{"email_change", ""}}},
+	}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return duplicateKeyError(err)
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// UpdateTOTPCounter only moves the counter forward, mongo.ErrNoDocuments
+// means the code has been used already.
+func (repo *accountRepository) UpdateTOTPCounter(uid string, counter int64) error {
+	filter := bson.D{{"_id", uid}, {"totp_last_counter", bson.D{{"$lt", counter}}}}
+	update := bson.D{{"$set", bson.D{{"totp_last_counter", counter}}}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// PullRecoveryCode removes a hashed recovery code, mongo.ErrNoDocuments
+// means the account doesn't have it.
+func (repo *accountRepository) PullRecoveryCode(uid string, code string) error {
+	filter := bson.D{{"_id", uid}, {"recovery_codes", code}}
+	update := bson.D{{"$pull", bson.D{{"recovery_codes", code}}}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
 	if err != nil {
 		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
 	}
 	return nil
 }
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+// AuditFilter fields left empty don't filter, time range is [From, To).
+type AuditFilter struct {
+	Actor  string
+	Target string
+	Type   string
+	From   *time.Time
+	To     *time.Time
+}
+
+// AuditRepository is append only, there is no way to change an event.
+type AuditRepository interface {
+	InsertEvent(event *models.AuditEvent) error
+	FindAll(filter *AuditFilter, page, size uint) ([]*models.AuditEvent, uint64, error)
+}
+
+func NewAuditRepository(client *mongo.Client) (AuditRepository, error) {
+	repo := &auditRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("audit_event"),
+	}
+
+	_, err := repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"time", -1}},
+		},
+		{
+			Keys: bson.D{{"actor", 1}, {"time", -1}},
+		},
+		{
+			Keys: bson.D{{"target", 1}, {"time", -1}},
+		},
+		{
+			Keys: bson.D{{"type", 1}, {"time", -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type auditRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *auditRepository) InsertEvent(event *models.AuditEvent) error {
+	_, err := repo.collections.InsertOne(repo.ctx, event)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func buildAuditFilter(filter *AuditFilter) bson.D {
+	bsonFilter := bson.D{}
+	if filter == nil {
+		return bsonFilter
+	}
+	if filter.Actor != "" {
+		bsonFilter = append(bsonFilter, bson.E{Key: "actor", Value: filter.Actor})
+	}
+	if filter.Target != "" {
+		bsonFilter = append(bsonFilter, bson.E{Key: "target", Value: filter.Target})
+	}
+	if filter.Type != "" {
+		bsonFilter = append(bsonFilter, bson.E{Key: "type", Value: filter.Type})
+	}
+	if r := timeRange(filter.From, filter.To); len(r) != 0 {
+		bsonFilter = append(bsonFilter, bson.E{Key: "time", Value: r})
+	}
+	return bsonFilter
+}
+
+// FindAll returns a page of events matching filter, most recent first, along
+// with the count of all matching.
+func (repo *auditRepository) FindAll(filter *AuditFilter, page, size uint) ([]*models.AuditEvent, uint64, error) {
+	bsonFilter := buildAuditFilter(filter)
+
+	totalCount, err := repo.collections.CountDocuments(repo.ctx, bsonFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().SetSkip(int64(size * page)).SetLimit(int64(size)).
+		SetSort(bson.D{{"time", -1}})
+	cur, err := repo.collections.Find(repo.ctx, bsonFilter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(repo.ctx)
+	events := make([]*models.AuditEvent, 0, size)
+	for cur.Next(repo.ctx) {
+		var event models.AuditEvent
+		err := cur.Decode(&event)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, &event)
+	}
+	err = cur.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, uint64(totalCount), nil
+}
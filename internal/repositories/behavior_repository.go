@@ -21,6 +21,7 @@ type BehaviorRepository interface {
 	CountByInfo(ctx mongo.SessionContext, infoID primitive.ObjectID) (uint64, error)
 	CountByUser(ctx mongo.SessionContext, uid string) (uint64, error)
 	Delete(ctx mongo.SessionContext, uid string, infoID primitive.ObjectID) error
+	DeleteByUser(ctx mongo.SessionContext, uid string) error
 }
 
 func NewThumbUpRepository(client *mongo.Client) (BehaviorRepository, error) {
@@ -246,3 +247,11 @@ func (repo *behaviorRepository) Delete(ctx mongo.SessionContext, uid string, inf
 		return nil
 	}
 }
+
+func (repo *behaviorRepository) DeleteByUser(ctx mongo.SessionContext, uid string) error {
+	_, err := repo.collections.DeleteMany(ctx, bson.D{{"uid", uid}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+type DeletionRepository interface {
+	InsertDeletion(deletion *models.AccountDeletion) error
+	FindOne(uid string) (*models.AccountDeletion, error)
+	UpdateOne(uid string, fields map[string]interface{}) error
+	DeletePending(uid string) error
+	ClaimDue(lease time.Duration) (*models.AccountDeletion, error)
+}
+
+func NewDeletionRepository(client *mongo.Client) (DeletionRepository, error) {
+	repo := &deletionRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("account_deletion"),
+	}
+
+	_, err := repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"done", 1}, {"scheduled_date", 1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type deletionRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+// InsertDeletion fails with ErrDuplicateKey when the account has one already.
+func (repo *deletionRepository) InsertDeletion(deletion *models.AccountDeletion) error {
+	_, err := repo.collections.InsertOne(repo.ctx, deletion)
+	if err != nil {
+		return duplicateKeyError(err)
+	}
+	return nil
+}
+
+func (repo *deletionRepository) FindOne(uid string) (*models.AccountDeletion, error) {
+	var deletion models.AccountDeletion
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", uid}}).Decode(&deletion)
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+func (repo *deletionRepository) UpdateOne(uid string, fields map[string]interface{}) error {
+	filter := bson.D{{"_id", uid}}
+	var bsonFields = make(bson.D, 0, len(fields))
+	for k, v := range fields {
+		bsonFields = append(bsonFields, bson.E{Key: k, Value: v})
+	}
+	update := bson.D{{"$set", bsonFields}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeletePending removes a deletion still in its grace period, mongo.ErrNoDocuments
+// means there is none or it has started.
+func (repo *deletionRepository) DeletePending(uid string) error {
+	filter := bson.D{{"_id", uid}, {"scheduled_date", bson.D{{"$gt", time.Now()}}}}
+	dr, err := repo.collections.DeleteOne(repo.ctx, filter)
+	if err != nil {
+		return err
+	} else if dr.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ClaimDue takes a due deletion for the lease, so other instances leave it
+// alone meanwhile. mongo.ErrNoDocuments means nothing is due.
+func (repo *deletionRepository) ClaimDue(lease time.Duration) (*models.AccountDeletion, error) {
+	now := time.Now()
+	filter := bson.D{
+		{"done", false},
+		{"scheduled_date", bson.D{{"$lte", now}}},
+		{"next_attempt", bson.D{{"$lte", now}}},
+		{"lease_until", bson.D{{"$lte", now}}},
+	}
+	update := bson.D{{"$set", bson.D{{"lease_until", now.Add(lease)}}}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{"scheduled_date", 1}}).
+		SetReturnDocument(options.After)
+
+	var deletion models.AccountDeletion
+	err := repo.collections.FindOneAndUpdate(repo.ctx, filter, update, opts).Decode(&deletion)
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
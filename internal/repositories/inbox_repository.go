@@ -15,6 +15,7 @@ type InBoxRepository interface {
 	FindInBoxItem(uid string, id string) (models.InBoxItem, error)
 	FindInBoxUnreadCount(uid string) (int64, error)
 	DeleteAllInBoxItem(uid string) error
+	DeleteInBox(uid string) error
 	DeleteInBoxItems(uid string, ids []string) error
 	UpdateInBoxItems(uid string, ids []string, fields map[string]interface{}) error
 }
@@ -154,6 +155,14 @@ func (repo *inboxRepository) DeleteAllInBoxItem(uid string) error {
 	return nil
 }
 
+func (repo *inboxRepository) DeleteInBox(uid string) error {
+	_, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"uid", uid}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (repo *inboxRepository) DeleteInBoxItems(uid string, ids []string) error {
 	filter := bson.D{{"uid", uid}}
 	update := bson.D{{
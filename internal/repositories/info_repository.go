@@ -5,6 +5,7 @@ import (
 	"github.com/mongodb/mongo-go-driver/bson"
 	"github.com/mongodb/mongo-go-driver/bson/primitive"
 	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
 	"teddy-backend/internal/models"
 	"teddy-backend/internal/proto/content"
 	"time"
@@ -17,6 +18,7 @@ type InfoRepository interface {
 	FindOne(ctx mongo.SessionContext, id primitive.ObjectID) (*models.Info, error)
 	FindAll(ctx mongo.SessionContext, uid, country string, startTime, endTime *time.Time, tags []*models.TypeAndTag,
 		page, size uint64, sorts []*content.Sort) ([]*models.Info, uint64, error)
+	FindIDsByUID(ctx mongo.SessionContext, uid string) ([]primitive.ObjectID, error)
 	Delete(ctx mongo.SessionContext, id primitive.ObjectID) error
 	Update(ctx mongo.SessionContext, id primitive.ObjectID, fields map[string]interface{}) error
 }
@@ -170,6 +172,27 @@ func (repo *infoRepository) FindAll(ctx mongo.SessionContext, uid, country strin
 	return repo.internalFindInfo(ctx, uid, country, startTime, endTime, tags, page, size, sorts)
 }
 
+func (repo *infoRepository) FindIDsByUID(ctx mongo.SessionContext, uid string) ([]primitive.ObjectID, error) {
+	cur, err := repo.collections.Find(ctx, bson.D{{"uid", uid}},
+		options.Find().SetProjection(bson.D{{"_id", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var ids []primitive.ObjectID
+	for cur.Next(ctx) {
+		var info models.Info
+		if err := cur.Decode(&info); err != nil {
+			return nil, err
+		}
+		ids = append(ids, info.ID)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (repo *infoRepository) Delete(ctx mongo.SessionContext, id primitive.ObjectID) error {
 	filter := bson.D{{"_id", id}}
 	dr, err := repo.collections.DeleteOne(ctx, filter)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/mongodb/mongo-go-driver/bson"
 	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
 	"teddy-backend/internal/models"
 	"time"
 )
@@ -11,7 +12,8 @@ import (
 type KeyValuePairRepository interface {
 	InsertKeyValuePair(kv *models.KeyValuePair) error
 	FindKeyValuePairByKey(key string) (models.KeyValuePair, error)
-	FindKeyValuePairByKeyAndValueAndExpire(key string, value string, time time.Time) (models.KeyValuePair, error)
+	TakeKeyValuePair(key string, value string, maxAttempts int64, time time.Time) (models.KeyValuePair, error)
+	IncrKeyValuePairAttempts(key string, time time.Time) (models.KeyValuePair, error)
 	DeleteKeyValuePairByKey(key string) error
 	DeleteKeyValuePairLT(time time.Time) error
 }
@@ -30,8 +32,11 @@ type keyValuePairRepository struct {
 	collections *mongo.Collection
 }
 
-func (repo *keyValuePairRepository) FindKeyValuePairByKeyAndValueAndExpire(key string,
-	value string, time time.Time) (models.KeyValuePair, error) {
+// TakeKeyValuePair deletes and returns the pair of key if its value matches,
+// it isn't expired and has been guessed less than maxAttempts times. Only
+// one of concurrent callers gets it.
+func (repo *keyValuePairRepository) TakeKeyValuePair(key string, value string, maxAttempts int64,
+	time time.Time) (models.KeyValuePair, error) {
 	var kvp models.KeyValuePair
 	filter := bson.D{
 		{"key", key},
@@ -39,9 +44,32 @@ func (repo *keyValuePairRepository) FindKeyValuePairByKeyAndValueAndExpire(key s
 		{"expire_time", bson.D{
 			{"$gt", time},
 		}},
+		{"attempts", bson.D{
+			{"$lt", maxAttempts},
+		}},
 	}
 
-	err := repo.collections.FindOne(repo.ctx, filter).Decode(&kvp)
+	err := repo.collections.FindOneAndDelete(repo.ctx, filter).Decode(&kvp)
+	if err != nil {
+		return models.KeyValuePair{}, err
+	}
+	return kvp, nil
+}
+
+// IncrKeyValuePairAttempts counts a wrong guess of the pair of key not
+// expired, and returns the pair counted.
+func (repo *keyValuePairRepository) IncrKeyValuePairAttempts(key string, time time.Time) (models.KeyValuePair, error) {
+	var kvp models.KeyValuePair
+	filter := bson.D{
+		{"key", key},
+		{"expire_time", bson.D{
+			{"$gt", time},
+		}},
+	}
+	update := bson.D{{"$inc", bson.D{{"attempts", 1}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := repo.collections.FindOneAndUpdate(repo.ctx, filter, update, opts).Decode(&kvp)
 	if err != nil {
 		return models.KeyValuePair{}, err
 	}
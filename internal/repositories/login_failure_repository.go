@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+type LoginFailureRepository interface {
+	Count(key string) (int, error)
+	Increment(key string, window time.Duration) (int, error)
+	Reset(key string) error
+}
+
+func NewLoginFailureRepository(client *mongo.Client) (LoginFailureRepository, error) {
+	repo := &loginFailureRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("login_failure"),
+	}
+
+	_, err := repo.collections.Indexes().CreateOne(repo.ctx, mongo.IndexModel{
+		Keys:    bson.D{{"expire_time", 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type loginFailureRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *loginFailureRepository) Count(key string) (int, error) {
+	var failure models.LoginFailure
+	filter := bson.D{{"_id", key}, {"expire_time", bson.D{{"$gt", time.Now()}}}}
+	err := repo.collections.FindOne(repo.ctx, filter).Decode(&failure)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return failure.Count, nil
+}
+
+// Increment adds one failure and returns the count in current window, a new
+// window starts when the last one has passed.
+func (repo *loginFailureRepository) Increment(key string, window time.Duration) (int, error) {
+	now := time.Now()
+
+	// TTL monitor removes expired documents lazily
+	_, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"_id", key}, {"expire_time", bson.D{{"$lte", now}}}})
+	if err != nil {
+		return 0, err
+	}
+
+	var failure models.LoginFailure
+	update := bson.D{
+		{"$inc", bson.D{{"count", 1}}},
+		{"$setOnInsert", bson.D{{"expire_time", now.Add(window)}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = repo.collections.FindOneAndUpdate(repo.ctx, bson.D{{"_id", key}}, update, opts).Decode(&failure)
+	if err != nil {
+		return 0, err
+	}
+	return failure.Count, nil
+}
+
+func (repo *loginFailureRepository) Reset(key string) error {
+	_, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"_id", key}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
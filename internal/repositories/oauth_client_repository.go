@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+)
+
+type OAuthClientRepository interface {
+	InsertClient(client *models.OAuthClient) error
+	FindOne(id string) (*models.OAuthClient, error)
+	FindAll() ([]*models.OAuthClient, error)
+	DeleteOne(id string) error
+}
+
+func NewOAuthClientRepository(client *mongo.Client) (OAuthClientRepository, error) {
+	repo := &oauthClientRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("oauth_client"),
+	}
+	return repo, nil
+}
+
+type oauthClientRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *oauthClientRepository) InsertClient(client *models.OAuthClient) error {
+	_, err := repo.collections.InsertOne(repo.ctx, client)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *oauthClientRepository) FindOne(id string) (*models.OAuthClient, error) {
+	var client models.OAuthClient
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", id}}).Decode(&client)
+	if err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+func (repo *oauthClientRepository) FindAll() ([]*models.OAuthClient, error) {
+	cur, err := repo.collections.Find(repo.ctx, bson.D{}, options.Find().SetSort(bson.D{{"create_date", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(repo.ctx)
+	var clients []*models.OAuthClient
+	for cur.Next(repo.ctx) {
+		var client models.OAuthClient
+		err := cur.Decode(&client)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, &client)
+	}
+	err = cur.Err()
+	if err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+// DeleteOne returns mongo.ErrNoDocuments when the client doesn't exist.
+func (repo *oauthClientRepository) DeleteOne(id string) error {
+	dr, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return err
+	} else if dr.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+type PersonalTokenRepository interface {
+	InsertToken(token *models.PersonalToken) error
+	FindByHash(hash string) (*models.PersonalToken, error)
+	FindActiveByUID(uid string) ([]*models.PersonalToken, error)
+	CountActiveByUID(uid string) (int64, error)
+	Touch(id string, lastUsedTime time.Time) error
+	DeleteOne(uid, id string) error
+	DeleteByUID(uid string) error
+}
+
+func NewPersonalTokenRepository(client *mongo.Client) (PersonalTokenRepository, error) {
+	repo := &personalTokenRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("personal_token"),
+	}
+
+	_, err := repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"expire_time", 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			Keys:    bson.D{{"hash", 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{"uid", 1}, {"create_date", -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type personalTokenRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *personalTokenRepository) InsertToken(token *models.PersonalToken) error {
+	_, err := repo.collections.InsertOne(repo.ctx, token)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByHash returns mongo.ErrNoDocuments for expired tokens not removed yet.
+func (repo *personalTokenRepository) FindByHash(hash string) (*models.PersonalToken, error) {
+	var token models.PersonalToken
+	filter := bson.D{{"hash", hash}, {"expire_time", bson.D{{"$gt", time.Now()}}}}
+	err := repo.collections.FindOne(repo.ctx, filter).Decode(&token)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindActiveByUID returns tokens not expired, most recently created first.
+func (repo *personalTokenRepository) FindActiveByUID(uid string) ([]*models.PersonalToken, error) {
+	filter := bson.D{{"uid", uid}, {"expire_time", bson.D{{"$gt", time.Now()}}}}
+	cur, err := repo.collections.Find(repo.ctx, filter, options.Find().SetSort(bson.D{{"create_date", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(repo.ctx)
+	var tokens []*models.PersonalToken
+	for cur.Next(repo.ctx) {
+		var token models.PersonalToken
+		err := cur.Decode(&token)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+	err = cur.Err()
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (repo *personalTokenRepository) CountActiveByUID(uid string) (int64, error) {
+	filter := bson.D{{"uid", uid}, {"expire_time", bson.D{{"$gt", time.Now()}}}}
+	return repo.collections.CountDocuments(repo.ctx, filter)
+}
+
+func (repo *personalTokenRepository) Touch(id string, lastUsedTime time.Time) error {
+	update := bson.D{{"$set", bson.D{{"last_used_time", lastUsedTime}}}}
+	_, err := repo.collections.UpdateOne(repo.ctx, bson.D{{"_id", id}}, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteOne only deletes a token of uid, mongo.ErrNoDocuments is returned
+// otherwise.
+func (repo *personalTokenRepository) DeleteOne(uid, id string) error {
+	dr, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"_id", id}, {"uid", uid}})
+	if err != nil {
+		return err
+	} else if dr.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (repo *personalTokenRepository) DeleteByUID(uid string) error {
+	_, err := repo.collections.DeleteMany(repo.ctx, bson.D{{"uid", uid}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+type ProfileRepository interface {
+	FindOne(uid string) (*models.AccountProfile, error)
+	FindByUIDs(uids []string) ([]*models.AccountProfile, error)
+	UpsertOne(uid string, fields map[string]interface{}) (*models.AccountProfile, error)
+	DeleteOne(uid string) error
+}
+
+func NewProfileRepository(client *mongo.Client) (ProfileRepository, error) {
+	return &profileRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("profile"),
+	}, nil
+}
+
+type profileRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *profileRepository) FindOne(uid string) (*models.AccountProfile, error) {
+	var profile models.AccountProfile
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", uid}}).Decode(&profile)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (repo *profileRepository) FindByUIDs(uids []string) ([]*models.AccountProfile, error) {
+	cur, err := repo.collections.Find(repo.ctx, bson.D{{"_id", bson.D{{"$in", uids}}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(repo.ctx)
+	profiles := make([]*models.AccountProfile, 0, len(uids))
+	for cur.Next(repo.ctx) {
+		var profile models.AccountProfile
+		err := cur.Decode(&profile)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &profile)
+	}
+	err = cur.Err()
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// UpsertOne sets fields of the profile, which is created with defaults
+// on first update.
+func (repo *profileRepository) UpsertOne(uid string, fields map[string]interface{}) (*models.AccountProfile, error) {
+	var bsonFields = make(bson.D, 0, len(fields)+1)
+	for k, v := range fields {
+		bsonFields = append(bsonFields, bson.E{Key: k, Value: v})
+	}
+	bsonFields = append(bsonFields, bson.E{Key: "update_date", Value: time.Now()})
+
+	var defaults = bson.D{}
+	if _, ok := fields[models.ProfileGender]; !ok {
+		defaults = append(defaults, bson.E{Key: models.ProfileGender, Value: models.UNKNOWN})
+	}
+	if _, ok := fields["private_fields"]; !ok {
+		defaults = append(defaults, bson.E{Key: "private_fields", Value: models.DefaultPrivateFields})
+	}
+
+	update := bson.D{{"$set", bsonFields}}
+	if len(defaults) != 0 {
+		update = append(update, bson.E{Key: "$setOnInsert", Value: defaults})
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var profile models.AccountProfile
+	err := repo.collections.FindOneAndUpdate(repo.ctx, bson.D{{"_id", uid}}, update, opts).Decode(&profile)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (repo *profileRepository) DeleteOne(uid string) error {
+	_, err := repo.collections.DeleteOne(repo.ctx, bson.D{{"_id", uid}})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+)
+
+type RefreshTokenRepository interface {
+	InsertRefreshToken(token *models.RefreshToken) error
+	FindOne(id string) (*models.RefreshToken, error)
+	MarkUsed(id string) error
+	RevokeFamily(family string) error
+	RevokeByUID(uid string) error
+}
+
+func NewRefreshTokenRepository(client *mongo.Client) (RefreshTokenRepository, error) {
+	repo := &refreshTokenRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("refresh_token"),
+	}
+
+	// Expired tokens are useless, let mongodb clean them
+	_, err := repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"expire_time", 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			Keys: bson.D{{"family", 1}},
+		},
+		{
+			Keys: bson.D{{"uid", 1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type refreshTokenRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *refreshTokenRepository) InsertRefreshToken(token *models.RefreshToken) error {
+	_, err := repo.collections.InsertOne(repo.ctx, token)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *refreshTokenRepository) FindOne(id string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", id}}).Decode(&token)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkUsed returns mongo.ErrNoDocuments when the token was already used or
+// revoked, so only one of concurrent rotations can win.
+func (repo *refreshTokenRepository) MarkUsed(id string) error {
+	filter := bson.D{{"_id", id}, {"used", false}, {"revoked", false}}
+	update := bson.D{{"$set", bson.D{{"used", true}}}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (repo *refreshTokenRepository) RevokeFamily(family string) error {
+	filter := bson.D{{"family", family}}
+	update := bson.D{{"$set", bson.D{{"revoked", true}}}}
+	_, err := repo.collections.UpdateMany(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *refreshTokenRepository) RevokeByUID(uid string) error {
+	filter := bson.D{{"uid", uid}, {"revoked", false}}
+	update := bson.D{{"$set", bson.D{{"revoked", true}}}}
+	_, err := repo.collections.UpdateMany(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
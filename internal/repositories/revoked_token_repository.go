@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+)
+
+type RevokedTokenRepository interface {
+	InsertRevokedToken(token *models.RevokedToken) (bool, error)
+	IsRevoked(jti string) (bool, error)
+}
+
+func NewRevokedTokenRepository(client *mongo.Client) (RevokedTokenRepository, error) {
+	repo := &revokedTokenRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("revoked_token"),
+	}
+
+	_, err := repo.collections.Indexes().CreateOne(repo.ctx, mongo.IndexModel{
+		Keys:    bson.D{{"expire_time", 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type revokedTokenRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+// InsertRevokedToken is idempotent, revoke a token twice is not an error.
+// It reports whether the token wasn't revoked before, which only one of
+// concurrent calls sees.
+func (repo *revokedTokenRepository) InsertRevokedToken(token *models.RevokedToken) (bool, error) {
+	filter := bson.D{{"_id", token.JTI}}
+	update := bson.D{{"$setOnInsert", token}}
+	result, err := repo.collections.UpdateOne(repo.ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedID != nil, nil
+}
+
+func (repo *revokedTokenRepository) IsRevoked(jti string) (bool, error) {
+	var token models.RevokedToken
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", jti}}).Decode(&token)
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -149,7 +149,7 @@ func (repo *segmentRepository) FindAll(ctx mongo.SessionContext,
 
 func (repo *segmentRepository) DeleteByInfoID(ctx mongo.SessionContext, infoID primitive.ObjectID) error {
 	filter := bson.D{{"infoID", infoID}}
-	dr, err := repo.collections.DeleteOne(ctx, filter)
+	dr, err := repo.collections.DeleteMany(ctx, filter)
 	if err != nil {
 		return err
 	} else if dr.DeletedCount == 0 {
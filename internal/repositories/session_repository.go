@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"teddy-backend/internal/models"
+	"time"
+)
+
+type SessionRepository interface {
+	InsertSession(session *models.Session) error
+	FindOne(id string) (*models.Session, error)
+	FindActiveByUID(uid string) ([]*models.Session, error)
+	Touch(id string, fields map[string]interface{}) error
+	Revoke(id string) error
+	RevokeByUID(uid string) error
+}
+
+func NewSessionRepository(client *mongo.Client) (SessionRepository, error) {
+	repo := &sessionRepository{
+		ctx:         context.Background(),
+		client:      client,
+		collections: client.Database("teddy").Collection("session"),
+	}
+
+	// Revoked sessions are kept until expired, access tokens of them are
+	// checked against it
+	_, err := repo.collections.Indexes().CreateMany(repo.ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"expire_time", 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			Keys: bson.D{{"uid", 1}, {"last_active_date", -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+type sessionRepository struct {
+	ctx         context.Context
+	client      *mongo.Client
+	collections *mongo.Collection
+}
+
+func (repo *sessionRepository) InsertSession(session *models.Session) error {
+	_, err := repo.collections.InsertOne(repo.ctx, session)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *sessionRepository) FindOne(id string) (*models.Session, error) {
+	var session models.Session
+	err := repo.collections.FindOne(repo.ctx, bson.D{{"_id", id}}).Decode(&session)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindActiveByUID returns sessions neither revoked nor expired, most recently
+// active first.
+func (repo *sessionRepository) FindActiveByUID(uid string) ([]*models.Session, error) {
+	filter := bson.D{
+		{"uid", uid},
+		{"revoked", false},
+		{"expire_time", bson.D{{"$gt", time.Now()}}},
+	}
+	cur, err := repo.collections.Find(repo.ctx, filter, options.Find().SetSort(bson.D{{"last_active_date", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(repo.ctx)
+	var sessions []*models.Session
+	for cur.Next(repo.ctx) {
+		var session models.Session
+		err := cur.Decode(&session)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &session)
+	}
+	err = cur.Err()
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Touch updates an active session, mongo.ErrNoDocuments is returned when it
+// has been revoked.
+func (repo *sessionRepository) Touch(id string, fields map[string]interface{}) error {
+	var bsonFields = make(bson.D, 0, len(fields))
+	for k, v := range fields {
+		bsonFields = append(bsonFields, bson.E{Key: k, Value: v})
+	}
+	filter := bson.D{{"_id", id}, {"revoked", false}}
+	ur, err := repo.collections.UpdateOne(repo.ctx, filter, bson.D{{"$set", bsonFields}})
+	if err != nil {
+		return err
+	} else if ur.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (repo *sessionRepository) Revoke(id string) error {
+	filter := bson.D{{"_id", id}}
+	update := bson.D{{"$set", bson.D{{"revoked", true}}}}
+	_, err := repo.collections.UpdateOne(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *sessionRepository) RevokeByUID(uid string) error {
+	filter := bson.D{{"uid", uid}, {"revoked", false}}
+	update := bson.D{{"$set", bson.D{{"revoked", true}}}}
+	_, err := repo.collections.UpdateMany(repo.ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	return nil
+}
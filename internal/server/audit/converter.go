@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/audit"
+	"teddy-backend/internal/repositories"
+)
+
+func copyFromEventToPBEvent(event *models.AuditEvent, pbevent *audit.Event) error {
+	if event == nil || pbevent == nil {
+		return nil
+	}
+	pbevent.Id = event.ID
+	pbevent.Type = event.Type
+	pbevent.Actor = event.Actor
+	pbevent.Target = event.Target
+	pbevent.Ip = event.IP
+	pbevent.Detail = event.Detail
+
+	tmp, err := ptypes.TimestampProto(event.Time)
+	if err != nil {
+		return err
+	}
+	pbevent.Time = tmp
+	return nil
+}
+
+func auditFilterFromQueryReq(req *audit.QueryReq) (*repositories.AuditFilter, error) {
+	filter := &repositories.AuditFilter{
+		Actor:  req.Actor,
+		Target: req.Target,
+		Type:   req.Type,
+	}
+	if req.From != nil {
+		from, err := ptypes.Timestamp(req.From)
+		if err != nil {
+			return nil, ErrTimeRangeInvalid
+		}
+		filter.From = &from
+	}
+	if req.To != nil {
+		to, err := ptypes.Timestamp(req.To)
+		if err != nil {
+			return nil, ErrTimeRangeInvalid
+		}
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, ErrTimeRangeInvalid
+	}
+	return filter, nil
+}
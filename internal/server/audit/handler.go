@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/audit"
+	"teddy-backend/internal/repositories"
+	"time"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInternal = status.Error(codes.Internal, "internal")
+
+func NewAuditServer(repo repositories.AuditRepository) (audit.AuditServer, error) {
+	instance := &auditHandler{
+		repo: repo,
+	}
+	return instance, nil
+}
+
+type auditHandler struct {
+	repo repositories.AuditRepository
+}
+
+// Record appends the event, time is now unless given.
+func (h *auditHandler) Record(ctx context.Context, req *audit.Event) (*empty.Empty, error) {
+	if err := validateEvent(req); err != nil {
+		return nil, err
+	}
+
+	event := models.AuditEvent{
+		ID:     xid.New().String(),
+		Type:   req.GetType(),
+		Actor:  req.GetActor(),
+		Target: req.GetTarget(),
+		IP:     req.GetIp(),
+		Time:   time.Now(),
+		Detail: req.GetDetail(),
+	}
+	if req.GetTime() != nil {
+		var err error
+		if event.Time, err = ptypes.Timestamp(req.GetTime()); err != nil {
+			return nil, ErrTimeInvalid
+		}
+	}
+
+	if err := h.repo.InsertEvent(&event); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+func (h *auditHandler) Query(ctx context.Context, req *audit.QueryReq) (*audit.QueryResp, error) {
+	filter, err := auditFilterFromQueryReq(req)
+	if err != nil {
+		return nil, err
+	}
+	size := req.GetSize()
+	if size == 0 {
+		size = DefaultPageSize
+	} else if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	events, totalCount, err := h.repo.FindAll(filter, uint(req.GetPage()), uint(size))
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	resp := audit.QueryResp{TotalCount: totalCount}
+	for _, v := range events {
+		var pbEvent audit.Event
+		copyFromEventToPBEvent(v, &pbEvent)
+		resp.Items = append(resp.Items, &pbEvent)
+	}
+	return &resp, nil
+}
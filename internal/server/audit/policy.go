@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"strconv"
+	"strings"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/audit"
+	"teddy-backend/pkg/grpcadapter"
+)
+
+// NewPolicyAdapterServer records edits of policies made through adapter,
+// reads are passed through as is.
+func NewPolicyAdapterServer(adapter grpcadapter.PolicyAdapterServer,
+	server audit.AuditServer) grpcadapter.PolicyAdapterServer {
+	return &policyAdapter{
+		PolicyAdapterServer: adapter,
+		server:              server,
+	}
+}
+
+type policyAdapter struct {
+	grpcadapter.PolicyAdapterServer
+	server audit.AuditServer
+}
+
+// record targets the subject of the rule, i.e. the role or the account.
+func (a *policyAdapter) record(ctx context.Context, eventType, ptype string, rule []string,
+	detail map[string]string) {
+	if detail == nil {
+		detail = make(map[string]string)
+	}
+	detail["ptype"] = ptype
+	target := ""
+	if len(rule) != 0 {
+		target = rule[0]
+		detail["rule"] = strings.Join(rule, ", ")
+	}
+	Record(ctx, a.server, &audit.Event{
+		Type:   eventType,
+		Target: target,
+		Detail: detail,
+	})
+}
+
+func (a *policyAdapter) SavePolicy(ctx context.Context, req *grpcadapter.Policies) (*empty.Empty, error) {
+	resp, err := a.PolicyAdapterServer.SavePolicy(ctx, req)
+	if err == nil {
+		Record(ctx, a.server, &audit.Event{
+			Type: models.AuditPolicySave,
+			Detail: map[string]string{
+				"count": strconv.Itoa(len(req.Policies)),
+			},
+		})
+	}
+	return resp, err
+}
+
+func (a *policyAdapter) AddPolicy(ctx context.Context, req *grpcadapter.AddPolicyReq) (*empty.Empty, error) {
+	resp, err := a.PolicyAdapterServer.AddPolicy(ctx, req)
+	if err == nil {
+		a.record(ctx, models.AuditPolicyAdd, req.Ptype, req.Rule, nil)
+	}
+	return resp, err
+}
+
+func (a *policyAdapter) RemovePolicy(ctx context.Context, req *grpcadapter.RemovePolicyReq) (*empty.Empty, error) {
+	resp, err := a.PolicyAdapterServer.RemovePolicy(ctx, req)
+	if err == nil {
+		a.record(ctx, models.AuditPolicyRemove, req.Ptype, req.Rule, nil)
+	}
+	return resp, err
+}
+
+// RemoveFilteredPolicy records the filter, the rules removed aren't known.
+func (a *policyAdapter) RemoveFilteredPolicy(ctx context.Context,
+	req *grpcadapter.RemoveFilteredPolicyReq) (*empty.Empty, error) {
+	resp, err := a.PolicyAdapterServer.RemoveFilteredPolicy(ctx, req)
+	if err == nil {
+		target := ""
+		if req.FieldIndex == 0 && len(req.FieldValues) != 0 {
+			target = req.FieldValues[0]
+		}
+		Record(ctx, a.server, &audit.Event{
+			Type:   models.AuditPolicyRemove,
+			Target: target,
+			Detail: map[string]string{
+				"ptype":        req.Ptype,
+				"field_index":  strconv.FormatInt(req.FieldIndex, 10),
+				"field_values": strings.Join(req.FieldValues, ", "),
+			},
+		})
+	}
+	return resp, err
+}
+
+func (a *policyAdapter) UpdatePolicy(ctx context.Context, req *grpcadapter.UpdatePolicyReq) (*empty.Empty, error) {
+	resp, err := a.PolicyAdapterServer.UpdatePolicy(ctx, req)
+	if err == nil {
+		a.record(ctx, models.AuditPolicyUpdate, req.Ptype, req.NewRule, map[string]string{
+			"old_rule": strings.Join(req.OldRule, ", "),
+		})
+	}
+	return resp, err
+}
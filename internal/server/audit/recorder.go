@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/proto/audit"
+)
+
+// Record appends event with actor and ip of the call in ctx unless set. The
+// action audited has already happened, so failures are only logged.
+func Record(ctx context.Context, server audit.AuditServer, event *audit.Event) {
+	actor, ip := components.ActorFromContext(ctx)
+	if event.Actor == "" {
+		event.Actor = actor
+	}
+	if event.Ip == "" {
+		event.Ip = ip
+	}
+	if _, err := server.Record(ctx, event); err != nil {
+		log.Errorf("record audit event %s of %s error: %v", event.Type, event.Target, err)
+	}
+}
@@ -0,0 +1,18 @@
+package audit
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"teddy-backend/internal/proto/audit"
+)
+
+var ErrTypeEmpty = status.Error(codes.InvalidArgument, "event type can't be empty")
+var ErrTimeInvalid = status.Error(codes.InvalidArgument, "time invalid")
+var ErrTimeRangeInvalid = status.Error(codes.InvalidArgument, "time range invalid")
+
+func validateEvent(req *audit.Event) error {
+	if req.Type == "" {
+		return ErrTypeEmpty
+	}
+	return nil
+}
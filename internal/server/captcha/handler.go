@@ -3,10 +3,11 @@ package captcha
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"github.com/dchest/captcha"
 	"github.com/mongodb/mongo-go-driver/mongo"
 	log "github.com/sirupsen/logrus"
-	"math/rand"
+	"math/big"
 	"teddy-backend/internal/models"
 	captchaProto "teddy-backend/internal/proto/captcha"
 	"teddy-backend/internal/repositories"
@@ -15,6 +16,8 @@ import (
 
 const (
 	Expiration = 10 * time.Minute
+	// A code is burnt after this many wrong guesses, a new one must be sent
+	MaxAttempts = 5
 )
 
 func NewCaptchaServer(repo repositories.KeyValuePairRepository) (captchaProto.CaptchaServer, error) {
@@ -106,23 +109,26 @@ func (h *captchaHandler) GetRandomById(ctx context.Context, req *captchaProto.Ge
 		return nil, err
 	}
 
-	s := ""
-	for {
-		for i := 0; i < int(req.Len); i++ {
-			s += (string)(rand.Intn(10) + 48)
+	// Codes are secrets like password reset ones, never use math/rand
+	code := make([]byte, req.Len)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
 		}
+		code[i] = byte('0' + n.Int64())
+	}
+	s := string(code)
 
-		if _, err := h.repo.FindKeyValuePairByKey(s); err != nil {
-			if err == mongo.ErrNoDocuments {
-				break
-			} else {
-				log.Error(err)
-				return nil, ErrInternal
-			}
-		}
+	// Only the latest code of an id is valid
+	err := h.repo.DeleteKeyValuePairByKey(req.Id)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
 	}
 
-	err := h.repo.InsertKeyValuePair(&models.KeyValuePair{
+	err = h.repo.InsertKeyValuePair(&models.KeyValuePair{
 		Key:        req.Id,
 		Value:      s,
 		ExpireTime: time.Now().Add(Expiration),
@@ -145,9 +151,12 @@ func (h *captchaHandler) Verify(ctx context.Context, req *captchaProto.VerifyReq
 	}
 
 	if req.Type == captchaProto.CaptchaType_RANDOM_BY_ID {
+		// Code is one-time, taken atomically so concurrent guesses can't both
+		// use it
 		now := time.Now()
-		if _, err := h.repo.FindKeyValuePairByKeyAndValueAndExpire(req.Id, req.Code, now); err != nil {
+		if _, err := h.repo.TakeKeyValuePair(req.Id, req.Code, MaxAttempts, now); err != nil {
 			if err == mongo.ErrNoDocuments {
+				h.countWrongGuess(req.Id, now)
 				return nil, ErrCaptchaNotFount
 			} else {
 				log.Error(err)
@@ -163,3 +172,20 @@ func (h *captchaHandler) Verify(ctx context.Context, req *captchaProto.VerifyReq
 
 	return &resp, nil
 }
+
+// countWrongGuess counts a wrong guess of the code of id, which is burnt once
+// it has been guessed too many times.
+func (h *captchaHandler) countWrongGuess(id string, now time.Time) {
+	kvp, err := h.repo.IncrKeyValuePairAttempts(id, now)
+	if err == mongo.ErrNoDocuments {
+		return
+	} else if err != nil {
+		log.Error(err)
+		return
+	}
+	if kvp.Attempts >= MaxAttempts {
+		if err := h.repo.DeleteKeyValuePairByKey(id); err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -52,3 +52,55 @@ func copyFromValueToPBValue(value *models.Value, pbValue *content.ValueResp) err
 	pbValue.Value = value.Value
 	return nil
 }
+
+// copyFromInfoToPBInfo copies fields stored in the info, counts and behaviors
+// are left out.
+func copyFromInfoToPBInfo(info *models.Info, pbInfo *content.InfoResp) error {
+	if info == nil || pbInfo == nil {
+		return nil
+	}
+	pbInfo.InfoID = info.ID.Hex()
+	pbInfo.Uid = info.UID
+	pbInfo.Title = info.Title
+	pbInfo.Author = info.Author
+	pbInfo.Summary = info.Summary
+	pbInfo.Country = info.Country
+	pbInfo.CoverResources = info.CoverResources
+	pbInfo.Valid = info.Valid
+	pbInfo.WatchCount = info.WatchCount
+	pbInfo.CanReview = info.CanReview
+	pbInfo.Archived = info.Archived
+	pbInfo.LatestSegmentID = info.LatestSegmentID.Hex()
+	pbInfo.SegmentCount = info.SegmentCount
+	for _, v := range info.Tags {
+		pbInfo.Tags = append(pbInfo.Tags, &content.TagAndType{
+			Tag:  v.Tag,
+			Type: v.Type,
+		})
+	}
+
+	tmp, err := ptypes.TimestampProto(info.ContentTime)
+	if err != nil {
+		return err
+	}
+	pbInfo.ContentTime = tmp
+
+	tmp, err = ptypes.TimestampProto(info.PublishTime)
+	if err != nil {
+		return err
+	}
+	pbInfo.PublishTime = tmp
+
+	tmp, err = ptypes.TimestampProto(info.LastReviewTime)
+	if err != nil {
+		return err
+	}
+	pbInfo.LastReviewTime = tmp
+
+	tmp, err = ptypes.TimestampProto(info.LatestModifyTime)
+	if err != nil {
+		return err
+	}
+	pbInfo.LastModifyTime = tmp
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 )
 
 var ErrInternal = status.Error(codes.Internal, "internal")
+var ErrUIDEmpty = status.Error(codes.InvalidArgument, "uid can't be empty")
 var ErrBehaviorExists = status.Error(codes.AlreadyExists, "behavior exists")
 var ErrSegmentExists = status.Error(codes.AlreadyExists, "segment exists")
 var ErrSegmentNotExists = status.Error(codes.NotFound, "segment not exists")
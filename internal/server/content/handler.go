@@ -1297,3 +1297,114 @@ func (h *contentHandler) GetInfoFavorite(ctx context.Context, req *content.InfoI
 func (h *contentHandler) DeleteFavorite(ctx context.Context, req *content.InfoIDWithUIDReq) (*empty.Empty, error) {
 	return h._behaviorDelete(ctx, h.favoriteRepo, req)
 }
+
+// DeleteUserData removes what the user published, with all segments, and the
+// thumbs and favorites of the user. It can be retried until it succeeds.
+func (h *contentHandler) DeleteUserData(ctx context.Context, req *content.UIDReq) (*empty.Empty, error) {
+	var resp empty.Empty
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.client.UseSession(ctx, func(sessionContext mongo.SessionContext) error {
+		for _, repo := range []repositories.BehaviorRepository{h.thumbUpRepo, h.thumbDownRepo, h.favoriteRepo} {
+			if err := repo.DeleteByUser(sessionContext, req.Uid); err != nil {
+				return err
+			}
+		}
+
+		infoIDs, err := h.infoRepo.FindIDsByUID(sessionContext, req.Uid)
+		if err != nil {
+			return err
+		}
+		for _, infoID := range infoIDs {
+			// Segments first, so a retry finds the info again
+			err := h.segRepo.DeleteByInfoID(sessionContext, infoID)
+			if err != nil && err != mongo.ErrNoDocuments {
+				return err
+			}
+			err = h.infoRepo.Delete(sessionContext, infoID)
+			if err != nil && err != mongo.ErrNoDocuments {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &resp, nil
+}
+
+// Page size used to go through all data of a user
+const exportPageSize = 100
+
+func (h *contentHandler) ExportUserData(ctx context.Context, req *content.UIDReq) (*content.UserDataResp, error) {
+	var resp content.UserDataResp
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.client.UseSession(ctx, func(sessionContext mongo.SessionContext) error {
+		for page := uint64(0); ; page++ {
+			infos, _, err := h.infoRepo.FindAll(sessionContext, req.Uid, "", nil, nil, nil,
+				page, exportPageSize, nil)
+			if err != nil {
+				return err
+			}
+			for _, info := range infos {
+				var pbInfo content.InfoResp
+				if err := copyFromInfoToPBInfo(info, &pbInfo); err != nil {
+					return err
+				}
+				resp.Infos = append(resp.Infos, &pbInfo)
+			}
+			if len(infos) < exportPageSize {
+				break
+			}
+		}
+
+		var err error
+		resp.ThumbUps, err = h._behaviorExportByUser(sessionContext, h.thumbUpRepo, req.Uid)
+		if err != nil {
+			return err
+		}
+		resp.ThumbDowns, err = h._behaviorExportByUser(sessionContext, h.thumbDownRepo, req.Uid)
+		if err != nil {
+			return err
+		}
+		resp.Favorites, err = h._behaviorExportByUser(sessionContext, h.favoriteRepo, req.Uid)
+		return err
+	})
+
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &resp, nil
+}
+
+func (h *contentHandler) _behaviorExportByUser(ctx mongo.SessionContext,
+	repo repositories.BehaviorRepository, uid string) ([]*content.InfoIDWithTime, error) {
+	var results []*content.InfoIDWithTime
+	for page := uint64(0); ; page++ {
+		items, err := repo.FindInfoByUser(ctx, uid, page, exportPageSize, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			results = append(results, &content.InfoIDWithTime{
+				InfoId: item.InfoId.Hex(),
+				Time: &timestamp.Timestamp{
+					Seconds: item.Time.Unix(),
+					Nanos:   int32(item.Time.Nanosecond()),
+				},
+			})
+		}
+		if len(items) < exportPageSize {
+			return results, nil
+		}
+	}
+}
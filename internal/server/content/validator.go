@@ -88,3 +88,10 @@ func validateSegmentOneReq(req *content.SegmentOneReq) error {
 func validateValueOneReq(req *content.ValueOneReq) error {
 	return nil
 }
+
+func validateUIDReq(req *content.UIDReq) error {
+	if req.Uid == "" {
+		return ErrUIDEmpty
+	}
+	return nil
+}
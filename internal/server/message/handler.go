@@ -10,29 +10,33 @@ import (
 	"teddy-backend/internal/models"
 	"teddy-backend/internal/proto/message"
 	"teddy-backend/internal/repositories"
+	"teddy-backend/pkg/sms"
 	"time"
 )
 
-func NewMessageServer(repo repositories.InBoxRepository, host string, port int, username string, password string) (message.MessageServer, error) {
+func NewMessageServer(repo repositories.InBoxRepository, host string, port int, username string, password string,
+	smsSender sms.Sender) (message.MessageServer, error) {
 	instance := &notifyHandler{
-		repo:     repo,
-		mailCh:   make(chan *messageWithErrChan),
-		host:     host,
-		port:     port,
-		username: username,
-		password: password,
+		repo:      repo,
+		smsSender: smsSender,
+		mailCh:    make(chan *messageWithErrChan),
+		host:      host,
+		port:      port,
+		username:  username,
+		password:  password,
 	}
 	instance.startMailSender()
 	return instance, nil
 }
 
 type notifyHandler struct {
-	repo     repositories.InBoxRepository
-	mailCh   chan *messageWithErrChan
-	host     string
-	port     int
-	username string
-	password string
+	repo      repositories.InBoxRepository
+	smsSender sms.Sender
+	mailCh    chan *messageWithErrChan
+	host      string
+	port      int
+	username  string
+	password  string
 
 	notifyChMap sync.Map
 }
@@ -117,6 +121,12 @@ func (h *notifyHandler) SendSMS(ctx context.Context, req *message.SendSMSReq) (*
 	if err := validateSendSMSReq(req); err != nil {
 		return nil, err
 	}
+
+	err := h.smsSender.Send(ctx, req.PhoneNumber, req.Content)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
 	return &resp, nil
 }
 
@@ -179,6 +189,44 @@ func (h *notifyHandler) GetInBox(ctx context.Context, req *message.GetInBoxReq)
 	return &resp, nil
 }
 
+func (h *notifyHandler) DeleteUserData(ctx context.Context, req *message.UIDReq) (*empty.Empty, error) {
+	var resp empty.Empty
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+	if err := h.repo.DeleteInBox(req.Uid); err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Page size used to go through the whole inbox
+const exportPageSize = 100
+
+func (h *notifyHandler) ExportUserData(ctx context.Context, req *message.UIDReq) (*message.GetInboxResp, error) {
+	var resp message.GetInboxResp
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+	for page := uint32(0); ; page++ {
+		items, err := h.repo.FindInBoxItems(req.Uid, models.ALL, page, exportPageSize, nil)
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
+		for i := range items {
+			var pbItem message.InBoxItem
+			copyFromInBoxItemToPBInBoxItem(&items[i], &pbItem)
+			resp.Items = append(resp.Items, &pbItem)
+		}
+		if len(items) < exportPageSize {
+			break
+		}
+	}
+	return &resp, nil
+}
+
 func (h *notifyHandler) GetNotify(req *message.GetNotifyReq, resp message.Message_GetNotifyServer) error {
 	if err := validateGetNotifyReq(req); err != nil {
 		return err
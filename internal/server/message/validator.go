@@ -1,6 +1,11 @@
 package message
 
-import "teddy-backend/internal/proto/message"
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"teddy-backend/internal/proto/message"
+	"teddy-backend/pkg/sms"
+)
 
 func validateSendEmailReq(req *message.SendEmailReq) error {
 	return nil
@@ -15,6 +20,11 @@ func validateSendNotifyReq(req *message.SendNotifyReq) error {
 }
 
 func validateSendSMSReq(req *message.SendSMSReq) error {
+	if !sms.IsE164(req.PhoneNumber) {
+		return status.Error(codes.InvalidArgument, "phone number must be E.164")
+	} else if req.Content == "" {
+		return status.Error(codes.InvalidArgument, "sms content must not be empty")
+	}
 	return nil
 }
 
@@ -25,3 +35,10 @@ func validateGetInBoxReq(req *message.GetInBoxReq) error {
 func validateGetNotifyReq(req *message.GetNotifyReq) error {
 	return nil
 }
+
+func validateUIDReq(req *message.UIDReq) error {
+	if req.Uid == "" {
+		return status.Error(codes.InvalidArgument, "uid must not be empty")
+	}
+	return nil
+}
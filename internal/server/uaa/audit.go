@@ -0,0 +1,33 @@
+package uaa
+
+import (
+	"context"
+	"teddy-backend/internal/models"
+	auditProto "teddy-backend/internal/proto/audit"
+	"teddy-backend/internal/server/audit"
+)
+
+// recordEvent records eventType done to target by the caller in ctx.
+func (h *accountHandler) recordEvent(ctx context.Context, eventType, target string, detail map[string]string) {
+	audit.Record(ctx, h.auditServer, &auditProto.Event{
+		Type:   eventType,
+		Target: target,
+		Detail: detail,
+	})
+}
+
+// recordLogin records a login attempt, made by the account itself when it
+// succeeds and by whoever knows the principal otherwise.
+func (h *accountHandler) recordLogin(ctx context.Context, success bool, target, ip string, detail map[string]string) {
+	event := &auditProto.Event{
+		Type:   models.AuditLoginFailure,
+		Target: target,
+		Ip:     ip,
+		Detail: detail,
+	}
+	if success {
+		event.Type = models.AuditLoginSuccess
+		event.Actor = target
+	}
+	audit.Record(ctx, h.auditServer, event)
+}
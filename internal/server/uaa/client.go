@@ -0,0 +1,180 @@
+package uaa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/grpcadapter"
+	"time"
+)
+
+func (h *accountHandler) CreateClient(ctx context.Context, req *uaa.CreateClientReq) (*uaa.CreateClientResp, error) {
+	if err := validateCreateClientReq(req); err != nil {
+		return nil, err
+	}
+
+	idBytes := make([]byte, 12)
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(idBytes); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if _, err := rand.Read(secretBytes); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	hash, err := h.hasher.Hash(secret)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var scopes []string
+	for _, scope := range req.GetScopes() {
+		if !components.ContainsString(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	var redirectURIs []string
+	for _, uri := range req.GetRedirectUris() {
+		if !components.ContainsString(redirectURIs, uri) {
+			redirectURIs = append(redirectURIs, uri)
+		}
+	}
+
+	now := time.Now()
+	client := models.OAuthClient{
+		ID:           hex.EncodeToString(idBytes),
+		Name:         req.GetName(),
+		Secret:       hash,
+		Scopes:       scopes,
+		RedirectURIs: redirectURIs,
+		CreateDate:   now,
+		UpdateDate:   now,
+	}
+	if err := h.clientRepo.InsertClient(&client); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	// Scopes are enforced as roles of the client subject
+	if err := h.bindRoles(gin_jwt.ClientSubjectPrefix+client.ID, scopes); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	resp := uaa.CreateClientResp{
+		Client: &uaa.OAuthClient{},
+		Secret: secret,
+	}
+	copyFromClientToPBClient(&client, resp.Client)
+	return &resp, nil
+}
+
+func (h *accountHandler) GetClients(ctx context.Context, req *empty.Empty) (*uaa.ClientsResp, error) {
+	clients, err := h.clientRepo.FindAll()
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.ClientsResp
+	for _, v := range clients {
+		var pbClient uaa.OAuthClient
+		copyFromClientToPBClient(v, &pbClient)
+		resp.Items = append(resp.Items, &pbClient)
+	}
+	return &resp, nil
+}
+
+// DeleteClient also revokes tokens of the client, which are checked against
+// the client existing.
+func (h *accountHandler) DeleteClient(ctx context.Context, req *uaa.ClientIDReq) (*empty.Empty, error) {
+	if err := validateClientIDReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.clientRepo.DeleteOne(req.GetClientId())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrClientNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	_, err = h.policy.RemoveFilteredPolicy(ctx, &grpcadapter.RemoveFilteredPolicyReq{
+		Sec:         "g",
+		Ptype:       "g",
+		FieldIndex:  0,
+		FieldValues: []string{gin_jwt.ClientSubjectPrefix + req.GetClientId()},
+	})
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp empty.Empty
+	return &resp, nil
+}
+
+func (h *accountHandler) VerifyClient(ctx context.Context, req *uaa.VerifyClientReq) (*uaa.OAuthClient, error) {
+	if err := validateVerifyClientReq(req); err != nil {
+		return nil, err
+	}
+
+	client, err := h.clientRepo.FindOne(req.GetClientId())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrClientInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if !h.hashMatches(client.Secret, req.GetSecret()) {
+		return nil, ErrClientInvalid
+	}
+
+	var resp uaa.OAuthClient
+	copyFromClientToPBClient(client, &resp)
+	return &resp, nil
+}
+
+// GetClient is a client without checking its secret, e.g. to check the
+// redirect uri of an authorization request.
+func (h *accountHandler) GetClient(ctx context.Context, req *uaa.ClientIDReq) (*uaa.OAuthClient, error) {
+	if err := validateClientIDReq(req); err != nil {
+		return nil, err
+	}
+
+	client, err := h.clientRepo.FindOne(req.GetClientId())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrClientNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.OAuthClient
+	copyFromClientToPBClient(client, &resp)
+	return &resp, nil
+}
+
+// isClientRevoked tells whether tokens of subject, a client subject, are no
+// longer valid since the client was deleted.
+func (h *accountHandler) isClientRevoked(subject string) (bool, error) {
+	_, err := h.clientRepo.FindOne(strings.TrimPrefix(subject, gin_jwt.ClientSubjectPrefix))
+	if err == mongo.ErrNoDocuments {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return false, nil
+}
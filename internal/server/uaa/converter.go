@@ -2,8 +2,12 @@ package uaa
 
 import (
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"teddy-backend/internal/components"
 	"teddy-backend/internal/models"
 	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/internal/repositories"
+	"time"
 )
 
 func copyFromAccountToPBAccount(acc *models.Account, pbacc *uaa.Account) error {
@@ -13,9 +17,13 @@ func copyFromAccountToPBAccount(acc *models.Account, pbacc *uaa.Account) error {
 	pbacc.Uid = acc.UID
 	pbacc.Username = acc.Username
 	pbacc.Email = acc.Email
+	pbacc.Phone = acc.Phone
+	pbacc.EmailVerified = acc.EmailVerified
 	pbacc.Password = acc.Password
 	pbacc.Locked = acc.Locked
 	pbacc.CredentialsExpired = acc.CredentialsExpired
+	pbacc.MustChangePassword = acc.MustChangePassword
+	pbacc.TwoFactorEnabled = acc.TwoFactorEnabled
 	pbacc.Roles = acc.Roles
 	pbacc.OauthUIDs = acc.OAuthUIds
 	pbacc.LastSignInIP = acc.LastSignInIP
@@ -37,5 +45,267 @@ func copyFromAccountToPBAccount(acc *models.Account, pbacc *uaa.Account) error {
 		return err
 	}
 	pbacc.LastSignInTime = tmp
+
+	tmp, err = ptypes.TimestampProto(acc.LockedUntil)
+	if err != nil {
+		return err
+	}
+	pbacc.LockedUntil = tmp
+	return nil
+}
+
+func copyFromProfileToPBProfile(profile *models.AccountProfile, pbprofile *uaa.Profile) error {
+	if profile == nil || pbprofile == nil {
+		return nil
+	}
+	pbprofile.Uid = profile.UID
+	pbprofile.Firstname = profile.Firstname
+	pbprofile.Lastname = profile.Lastname
+	pbprofile.AvatarUrl = profile.AvatarUrl
+	pbprofile.Bio = profile.Bio
+	pbprofile.Gender = uaa.Gender(profile.Gender)
+	pbprofile.Locale = profile.Locale
+	pbprofile.PrivateFields = profile.PrivateFields
+
+	tmp, err := ptypes.TimestampProto(profile.Birthday)
+	if err != nil {
+		return err
+	}
+	pbprofile.Birthday = tmp
+
+	tmp, err = ptypes.TimestampProto(profile.UpdateDate)
+	if err != nil {
+		return err
+	}
+	pbprofile.UpdateDate = tmp
+	return nil
+}
+
+// profileFieldsFromPBProfile picks the given fields of pbprofile, keyed
+// by their bson names.
+func profileFieldsFromPBProfile(pbprofile *uaa.Profile, fields []string) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		switch field {
+		case models.ProfileFirstname:
+			result[field] = pbprofile.Firstname
+		case models.ProfileLastname:
+			result[field] = pbprofile.Lastname
+		case models.ProfileAvatarUrl:
+			result[field] = pbprofile.AvatarUrl
+		case models.ProfileBio:
+			result[field] = pbprofile.Bio
+		case models.ProfileBirthday:
+			var birthday time.Time
+			if pbprofile.Birthday != nil {
+				tmp, err := ptypes.Timestamp(pbprofile.Birthday)
+				if err != nil {
+					return nil, err
+				}
+				birthday = tmp
+			}
+			result[field] = birthday
+		case models.ProfileGender:
+			result[field] = models.GenderType(pbprofile.Gender)
+		case models.ProfileLocale:
+			result[field] = pbprofile.Locale
+		case profilePrivateFields:
+			privateFields := make([]string, 0, len(pbprofile.PrivateFields))
+			for _, v := range pbprofile.PrivateFields {
+				if !components.ContainsString(privateFields, v) {
+					privateFields = append(privateFields, v)
+				}
+			}
+			result[field] = privateFields
+		default:
+			return nil, ErrProfileFieldInvalid
+		}
+	}
+	return result, nil
+}
+
+func copyFromSessionToPBSession(session *models.Session, pbsession *uaa.Session) error {
+	if session == nil || pbsession == nil {
+		return nil
+	}
+	pbsession.Id = session.ID
+	pbsession.Uid = session.UID
+	pbsession.Jti = session.JTI
+	pbsession.Ip = session.IP
+	pbsession.UserAgent = session.UserAgent
+
+	tmp, err := ptypes.TimestampProto(session.CreateDate)
+	if err != nil {
+		return err
+	}
+	pbsession.CreateDate = tmp
+
+	tmp, err = ptypes.TimestampProto(session.LastActiveDate)
+	if err != nil {
+		return err
+	}
+	pbsession.LastActiveDate = tmp
+
+	tmp, err = ptypes.TimestampProto(session.ExpireTime)
+	if err != nil {
+		return err
+	}
+	pbsession.ExpireTime = tmp
+	return nil
+}
+
+func copyFromDeletionToPBDeletion(deletion *models.AccountDeletion, pbdeletion *uaa.AccountDeletion) error {
+	if deletion == nil || pbdeletion == nil {
+		return nil
+	}
+	pbdeletion.Uid = deletion.UID
+	pbdeletion.Done = deletion.Done
+
+	tmp, err := ptypes.TimestampProto(deletion.RequestDate)
+	if err != nil {
+		return err
+	}
+	pbdeletion.RequestDate = tmp
+
+	tmp, err = ptypes.TimestampProto(deletion.ScheduledDate)
+	if err != nil {
+		return err
+	}
+	pbdeletion.ScheduledDate = tmp
+
+	if deletion.Done {
+		tmp, err = ptypes.TimestampProto(deletion.DoneDate)
+		if err != nil {
+			return err
+		}
+		pbdeletion.DoneDate = tmp
+	}
+
+	for _, v := range deletion.Steps {
+		pbstep := &uaa.DeletionStep{
+			Name:      v.Name,
+			Done:      v.Done,
+			Attempts:  v.Attempts,
+			LastError: v.LastError,
+		}
+		if v.Done {
+			tmp, err = ptypes.TimestampProto(v.DoneDate)
+			if err != nil {
+				return err
+			}
+			pbstep.DoneDate = tmp
+		}
+		pbdeletion.Steps = append(pbdeletion.Steps, pbstep)
+	}
+	return nil
+}
+
+func boolFilter(f uaa.BoolFilter) *bool {
+	var b bool
+	switch f {
+	case uaa.BoolFilter_YES:
+		b = true
+	case uaa.BoolFilter_NO:
+		b = false
+	default:
+		return nil
+	}
+	return &b
+}
+
+// timeRangeFromPB converts an optional [from, to) range, it fails when from
+// isn't before to.
+func timeRangeFromPB(pbfrom, pbto *timestamp.Timestamp) (from, to *time.Time, err error) {
+	if pbfrom != nil {
+		tmp, err := ptypes.Timestamp(pbfrom)
+		if err != nil {
+			return nil, nil, err
+		}
+		from = &tmp
+	}
+	if pbto != nil {
+		tmp, err := ptypes.Timestamp(pbto)
+		if err != nil {
+			return nil, nil, err
+		}
+		to = &tmp
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, nil, ErrTimeRangeInvalid
+	}
+	return from, to, nil
+}
+
+func accountFilterFromGetAllReq(req *uaa.GetAllReq) (*repositories.AccountFilter, error) {
+	filter := &repositories.AccountFilter{
+		Role:               req.Role,
+		Locked:             boolFilter(req.Locked),
+		CredentialsExpired: boolFilter(req.CredentialsExpired),
+		Prefix:             req.Prefix,
+	}
+	var err error
+	filter.CreatedFrom, filter.CreatedTo, err = timeRangeFromPB(req.CreatedFrom, req.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+	filter.LastSignInFrom, filter.LastSignInTo, err = timeRangeFromPB(req.LastSignInFrom, req.LastSignInTo)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func copyFromClientToPBClient(client *models.OAuthClient, pbclient *uaa.OAuthClient) error {
+	if client == nil || pbclient == nil {
+		return nil
+	}
+	pbclient.ClientId = client.ID
+	pbclient.Name = client.Name
+	pbclient.Scopes = client.Scopes
+	pbclient.RedirectUris = client.RedirectURIs
+
+	tmp, err := ptypes.TimestampProto(client.CreateDate)
+	if err != nil {
+		return err
+	}
+	pbclient.CreateDate = tmp
+
+	tmp, err = ptypes.TimestampProto(client.UpdateDate)
+	if err != nil {
+		return err
+	}
+	pbclient.UpdateDate = tmp
+	return nil
+}
+
+func copyFromPersonalTokenToPBPersonalToken(token *models.PersonalToken, pbtoken *uaa.PersonalToken) error {
+	if token == nil || pbtoken == nil {
+		return nil
+	}
+	pbtoken.Id = token.ID
+	pbtoken.Uid = token.UID
+	pbtoken.Name = token.Name
+	pbtoken.Scopes = token.Scopes
+
+	tmp, err := ptypes.TimestampProto(token.CreateDate)
+	if err != nil {
+		return err
+	}
+	pbtoken.CreateDate = tmp
+
+	tmp, err = ptypes.TimestampProto(token.ExpireTime)
+	if err != nil {
+		return err
+	}
+	pbtoken.ExpireTime = tmp
+
+	// Never used tokens have no last used time
+	if !token.LastUsedTime.IsZero() {
+		tmp, err = ptypes.TimestampProto(token.LastUsedTime)
+		if err != nil {
+			return err
+		}
+		pbtoken.LastUsedTime = tmp
+	}
 	return nil
 }
@@ -0,0 +1,248 @@
+package uaa
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"strconv"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/content"
+	"teddy-backend/internal/proto/message"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/internal/repositories"
+	"teddy-backend/pkg/grpcadapter"
+	"time"
+)
+
+const (
+	// How often due deletions are looked for
+	DeletionPollInterval = 30 * time.Second
+	// A claimed deletion is left to its instance for this long
+	DeletionLease = 5 * time.Minute
+	// Failed steps are retried after a doubling delay up to the max
+	DeletionRetryMin = time.Minute
+	DeletionRetryMax = time.Hour
+)
+
+func newAccountDeletion(uid string, grace time.Duration) *models.AccountDeletion {
+	now := time.Now()
+	deletion := &models.AccountDeletion{
+		UID:           uid,
+		RequestDate:   now,
+		ScheduledDate: now.Add(grace),
+		NextAttempt:   now,
+		LeaseUntil:    now,
+	}
+	for _, name := range models.DeletionSteps {
+		deletion.Steps = append(deletion.Steps, &models.DeletionStep{Name: name})
+	}
+	return deletion
+}
+
+// scheduleDeletion starts a deletion after grace, an existing one is kept but
+// brought forward when it would run later.
+func (h *accountHandler) scheduleDeletion(uid string, grace time.Duration) (*models.AccountDeletion, error) {
+	deletion := newAccountDeletion(uid, grace)
+	err := h.deletionRepo.InsertDeletion(deletion)
+	if err != repositories.ErrDuplicateKey {
+		return deletion, err
+	}
+
+	existing, err := h.deletionRepo.FindOne(uid)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.Done && existing.ScheduledDate.After(deletion.ScheduledDate) {
+		existing.ScheduledDate = deletion.ScheduledDate
+		err = h.deletionRepo.UpdateOne(uid, map[string]interface{}{
+			"scheduled_date": existing.ScheduledDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return existing, nil
+}
+
+func (h *accountHandler) RequestDeletion(ctx context.Context, req *uaa.RequestDeletionReq) (*uaa.AccountDeletion, error) {
+	if err := validateRequestDeletionReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	// Any bearer token of the account mustn't be enough to delete it
+	if len(acc.Password) != 0 {
+		if !h.hashMatches(acc.Password, req.GetPassword()) {
+			return nil, ErrPasswordNotCorrect
+		}
+	} else if !req.GetReauthenticated() {
+		return nil, ErrReauthRequired
+	}
+
+	deletion, err := h.scheduleDeletion(acc.UID, h.deletionGrace)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.AccountDeletion
+	if err := copyFromDeletionToPBDeletion(deletion, &resp); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &resp, nil
+}
+
+// CancelDeletion only works within the grace period.
+func (h *accountHandler) CancelDeletion(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.deletionRepo.DeletePending(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrDeletionNotPending
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+func (h *accountHandler) GetDeletion(ctx context.Context, req *uaa.UIDReq) (*uaa.AccountDeletion, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	deletion, err := h.deletionRepo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrDeletionNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.AccountDeletion
+	if err := copyFromDeletionToPBDeletion(deletion, &resp); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &resp, nil
+}
+
+func (h *accountHandler) runDeletions() {
+	for {
+		<-time.After(DeletionPollInterval)
+		for {
+			deletion, err := h.deletionRepo.ClaimDue(DeletionLease)
+			if err != nil {
+				if err != mongo.ErrNoDocuments {
+					log.Error(err)
+				}
+				break
+			}
+			h.runDeletion(deletion)
+		}
+	}
+}
+
+// runDeletion runs the steps left in order and stops at the first failure,
+// which is retried later. Every step must be safe to run again.
+func (h *accountHandler) runDeletion(deletion *models.AccountDeletion) {
+	fields := make(map[string]interface{})
+	failed := false
+	for i, step := range deletion.Steps {
+		if step.Done {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err := h.runDeletionStep(ctx, deletion.UID, step.Name)
+		cancel()
+
+		step.Attempts++
+		if err != nil {
+			log.Errorf("account %s deletion step %s failed: %v", deletion.UID, step.Name, err)
+			step.LastError = err.Error()
+		} else {
+			step.Done = true
+			step.DoneDate = time.Now()
+			step.LastError = ""
+		}
+		fields["steps."+strconv.Itoa(i)] = step
+		if err != nil {
+			failed = true
+			fields["next_attempt"] = time.Now().Add(deletionRetryDelay(step.Attempts))
+			break
+		}
+	}
+
+	if !failed {
+		fields["done"] = true
+		fields["done_date"] = time.Now()
+	}
+	// Let it be claimed again right away when due
+	fields["lease_until"] = time.Now()
+	if err := h.deletionRepo.UpdateOne(deletion.UID, fields); err != nil {
+		log.Error(err)
+	}
+}
+
+func (h *accountHandler) runDeletionStep(ctx context.Context, uid string, name string) error {
+	switch name {
+	case models.DeletionStepContent:
+		_, err := h.contentClient.DeleteUserData(ctx, &content.UIDReq{Uid: uid})
+		return err
+	case models.DeletionStepMessage:
+		_, err := h.messageClient.DeleteUserData(ctx, &message.UIDReq{Uid: uid})
+		return err
+	case models.DeletionStepPolicy:
+		// Role bindings and any policy given to the account itself
+		for _, ptype := range []string{"g", "p"} {
+			_, err := h.policy.RemoveFilteredPolicy(ctx, &grpcadapter.RemoveFilteredPolicyReq{
+				Sec:         ptype,
+				Ptype:       ptype,
+				FieldIndex:  0,
+				FieldValues: []string{uid},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case models.DeletionStepAccount:
+		if err := h.revokeAllSessions(uid); err != nil {
+			return err
+		}
+		if err := h.personalTokenRepo.DeleteByUID(uid); err != nil {
+			return err
+		}
+		if err := h.profileRepo.DeleteOne(uid); err != nil {
+			return err
+		}
+		if err := h.repo.DeleteOne(uid); err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+		return nil
+	}
+	log.Errorf("unknown account deletion step %s", name)
+	return nil
+}
+
+func deletionRetryDelay(attempts int64) time.Duration {
+	delay := DeletionRetryMin
+	for i := int64(1); i < attempts && delay < DeletionRetryMax; i++ {
+		delay *= 2
+	}
+	if delay > DeletionRetryMax {
+		delay = DeletionRetryMax
+	}
+	return delay
+}
@@ -0,0 +1,127 @@
+package uaa
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/internal/repositories"
+	"time"
+)
+
+// EmailChangeExpiration is how long both addresses have to confirm, the same
+// as the codes sent to them.
+const EmailChangeExpiration = 10 * time.Minute
+
+// VerifyEmail marks the email verified, caller has checked a code sent to it.
+func (h *accountHandler) VerifyEmail(ctx context.Context, req *uaa.VerifyEmailReq) (*empty.Empty, error) {
+	if err := validateVerifyEmailReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.repo.VerifyEmail(req.GetUid(), req.GetEmail())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrEmailMismatch
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+// StartEmailChange checks the password and keeps the new email pending, a
+// previous pending change is replaced. Account without email only needs the
+// new address to confirm.
+func (h *accountHandler) StartEmailChange(ctx context.Context, req *uaa.StartEmailChangeReq) (*uaa.Account, error) {
+	if err := validateStartEmailChangeReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if !h.hashMatches(acc.Password, req.GetPassword()) {
+		return nil, ErrPasswordNotCorrect
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+	email := repositories.NormalizeEmail(req.GetEmail())
+	if acc.Email == email {
+		return nil, ErrEmailUnchanged
+	}
+
+	// Checked again by the unique index on switch
+	_, err = h.repo.FindAccountByEmail(email)
+	if err == nil {
+		return nil, ErrEmailTaken
+	} else if err != mongo.ErrNoDocuments {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	now := time.Now()
+	acc.EmailChange = &models.EmailChange{
+		Email:        email,
+		OldConfirmed: acc.Email == "",
+		ExpireTime:   now.Add(EmailChangeExpiration),
+	}
+	err = h.repo.UpdateOne(acc.UID, map[string]interface{}{
+		"email_change": acc.EmailChange,
+		"update_date":  now,
+	})
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.Account
+	copyFromAccountToPBAccount(acc, &resp)
+	return &resp, nil
+}
+
+// ConfirmEmailChange records the confirmation of one address, caller has
+// checked the code sent to it. Email switches once both confirmed.
+func (h *accountHandler) ConfirmEmailChange(ctx context.Context,
+	req *uaa.ConfirmEmailChangeReq) (*uaa.ConfirmEmailChangeResp, error) {
+	if err := validateConfirmEmailChangeReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.ConfirmEmailChange(req.GetUid(), req.GetOldAddress())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrEmailChangeNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.ConfirmEmailChangeResp
+	if change := acc.EmailChange; change.OldConfirmed && change.NewConfirmed {
+		err := h.repo.ChangeEmail(acc.UID, change.Email)
+		if err == repositories.ErrDuplicateKey {
+			return nil, ErrEmailTaken
+		} else if err == mongo.ErrNoDocuments {
+			// Replaced by a new change meanwhile
+			return nil, ErrEmailChangeNotFound
+		} else if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+		acc.Email = change.Email
+		acc.EmailVerified = true
+		acc.EmailChange = nil
+		resp.Done = true
+	}
+
+	resp.Account = &uaa.Account{}
+	copyFromAccountToPBAccount(acc, resp.Account)
+	return &resp, nil
+}
@@ -1,15 +1,128 @@
 package uaa
 
-import "errors"
+import (
+	"errors"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
 
 var ErrPasswordEmpty = errors.New("password can't be empty")
 var ErrUsernameEmpty = errors.New("username can't be empty")
 var ErrRolesEmpty = errors.New("role can't be empty")
+var ErrRoleInvalid = status.Error(codes.InvalidArgument, "role invalid")
 var ErrEmailOrPhoneEmpty = errors.New("email or phone can't be empty")
+var ErrPhoneInvalid = errors.New("phone must be E.164")
 var ErrOldPasswordEmpty = errors.New("old password empty")
 var ErrNewPasswordEmpty = errors.New("new password empty")
+var ErrOAuthProviderEmpty = errors.New("oauth provider can't be empty")
+var ErrOAuthUIDEmpty = errors.New("oauth uid can't be empty")
+var ErrLockedUntilInvalid = errors.New("locked until must be in the future")
+var ErrRefreshTokenEmpty = errors.New("refresh token can't be empty")
+var ErrJTIEmpty = errors.New("jti can't be empty")
+var ErrExpireTimeEmpty = errors.New("expire time can't be empty")
+var ErrIssuedAtEmpty = errors.New("issued at can't be empty")
+var ErrTOTPCodeEmpty = errors.New("two factor code can't be empty")
+var ErrTOTPChallengeEmpty = errors.New("two factor challenge can't be empty")
+var ErrProfileEmpty = errors.New("profile can't be empty")
+var ErrProfileFieldsEmpty = errors.New("profile fields can't be empty")
+var ErrProfileFieldInvalid = errors.New("profile field invalid")
+var ErrUIDsEmpty = errors.New("uids can't be empty")
+var ErrTooManyUIDs = errors.New("too many uids")
+var ErrSessionIDEmpty = errors.New("session id can't be empty")
+var ErrEmailEmpty = errors.New("email can't be empty")
+var ErrEmailInvalid = status.Error(codes.InvalidArgument, "email invalid")
+var ErrEmailUnchanged = status.Error(codes.InvalidArgument, "email unchanged")
+var ErrSortInvalid = status.Error(codes.InvalidArgument, "sort invalid")
+var ErrTimeRangeInvalid = status.Error(codes.InvalidArgument, "time range invalid")
+var ErrClientNameEmpty = errors.New("client name can't be empty")
+var ErrClientIDEmpty = errors.New("client id can't be empty")
+var ErrClientSecretEmpty = errors.New("client secret can't be empty")
+var ErrPersonalTokenNameEmpty = errors.New("personal token name can't be empty")
+var ErrPersonalTokenIDEmpty = errors.New("personal token id can't be empty")
+var ErrPersonalTokenEmpty = errors.New("personal token can't be empty")
+var ErrPersonalTokenScopesEmpty = status.Error(codes.InvalidArgument, "personal token scopes can't be empty")
+var ErrPersonalTokenScopeInvalid = status.Error(codes.InvalidArgument, "personal token scope invalid")
+var ErrPersonalTokenExpireInvalid = status.Error(codes.OutOfRange, "personal token expire time invalid")
 
 var ErrAccountExist = errors.New("account exist")
-var UserNotFoundErr = errors.New("user not found")
+var UserNotFoundErr = status.Error(codes.NotFound, "user not found")
 var OldPasswordNotCorrectErr = errors.New("old password not correct")
 var PasswordModifyErr = errors.New("password modify error")
+var ErrInternal = status.Error(codes.Internal, "internal")
+var ErrRefreshTokenInvalid = status.Error(codes.Unauthenticated, "refresh token invalid")
+var ErrTOTPCodeInvalid = status.Error(codes.Unauthenticated, "two factor code invalid")
+var ErrTOTPNotEnrolled = status.Error(codes.FailedPrecondition, "two factor not enrolled")
+var ErrTOTPNotEnabled = status.Error(codes.FailedPrecondition, "two factor not enabled")
+var ErrTOTPAlreadyEnabled = status.Error(codes.AlreadyExists, "two factor already enabled")
+var ErrSessionNotFound = status.Error(codes.NotFound, "session not found")
+var ErrPasswordNotCorrect = status.Error(codes.Unauthenticated, "password not correct")
+var ErrEmailTaken = status.Error(codes.AlreadyExists, "email taken")
+var ErrEmailMismatch = status.Error(codes.FailedPrecondition, "email isn't of the account")
+var ErrEmailChangeNotFound = status.Error(codes.NotFound, "email change not found")
+var ErrDeletionNotFound = status.Error(codes.NotFound, "deletion not found")
+var ErrDeletionNotPending = status.Error(codes.FailedPrecondition, "deletion not pending")
+var ErrClientNotFound = status.Error(codes.NotFound, "client not found")
+var ErrPersonalTokenNotFound = status.Error(codes.NotFound, "personal token not found")
+var ErrPersonalTokenInvalid = status.Error(codes.Unauthenticated, "personal token invalid")
+var ErrTooManyPersonalTokens = status.Error(codes.ResourceExhausted, "too many personal tokens")
+var ErrOAuthNotLinked = status.Error(codes.NotFound, "oauth provider not linked")
+var ErrClientInvalid = status.Error(codes.Unauthenticated, "client id or secret not correct")
+var ErrTokenUsed = status.Error(codes.AlreadyExists, "token already used")
+
+var ErrCredentialsExpired = reasonError(codes.FailedPrecondition, uaa.ErrorReason_CREDENTIALS_EXPIRED,
+	"credentials expired")
+var ErrMustChangePassword = reasonError(codes.FailedPrecondition, uaa.ErrorReason_MUST_CHANGE_PASSWORD,
+	"must change password")
+var ErrCaptchaRequired = reasonError(codes.FailedPrecondition, uaa.ErrorReason_CAPTCHA_REQUIRED,
+	"captcha required")
+var ErrPasswordTooShort = reasonError(codes.InvalidArgument, uaa.ErrorReason_PASSWORD_TOO_SHORT,
+	"password too short")
+var ErrPasswordTooLong = reasonError(codes.InvalidArgument, uaa.ErrorReason_PASSWORD_TOO_LONG,
+	"password too long")
+var ErrPasswordMissingClasses = reasonError(codes.InvalidArgument, uaa.ErrorReason_PASSWORD_MISSING_CLASSES,
+	"password misses required character classes")
+var ErrPasswordTooCommon = reasonError(codes.InvalidArgument, uaa.ErrorReason_PASSWORD_TOO_COMMON,
+	"password too common")
+var ErrPasswordReused = reasonError(codes.InvalidArgument, uaa.ErrorReason_PASSWORD_REUSED,
+	"password used recently")
+var ErrOAuthIdentityTaken = reasonError(codes.AlreadyExists, uaa.ErrorReason_OAUTH_IDENTITY_TAKEN,
+	"oauth identity belongs to another account")
+var ErrOAuthProviderLinked = reasonError(codes.AlreadyExists, uaa.ErrorReason_OAUTH_PROVIDER_LINKED,
+	"another identity of the oauth provider linked")
+var ErrLastLoginMethod = reasonError(codes.FailedPrecondition, uaa.ErrorReason_LAST_LOGIN_METHOD,
+	"last login method can't be unlinked")
+var ErrMergeNotAllowed = reasonError(codes.FailedPrecondition, uaa.ErrorReason_MERGE_NOT_ALLOWED,
+	"account can't be merged")
+var ErrReauthRequired = reasonError(codes.FailedPrecondition, uaa.ErrorReason_REAUTH_REQUIRED,
+	"reauthentication required")
+var ErrTOTPChallengeUsed = reasonError(codes.Unauthenticated, uaa.ErrorReason_CHALLENGE_USED,
+	"two factor challenge already used")
+var ErrRedirectURIInvalid = reasonError(codes.InvalidArgument, uaa.ErrorReason_REDIRECT_URI_INVALID,
+	"redirect uri invalid")
+
+func reasonError(code codes.Code, reason uaa.ErrorReason, msg string) error {
+	st, err := status.New(code, msg).WithDetails(&uaa.ErrorDetail{
+		Reason: reason,
+	})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+func errAccountLocked(until time.Time) error {
+	detail := &uaa.ErrorDetail{
+		Reason: uaa.ErrorReason_ACCOUNT_LOCKED,
+	}
+	if !until.IsZero() {
+		detail.Until, _ = ptypes.TimestampProto(until)
+	}
+	st, err := status.New(codes.PermissionDenied, "account locked").WithDetails(detail)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "account locked")
+	}
+	return st.Err()
+}
@@ -7,36 +7,99 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/mongodb/mongo-go-driver/mongo"
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/crypto/bcrypt"
+	"strings"
 	"teddy-backend/internal/components"
 	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/audit"
+	"teddy-backend/internal/proto/content"
+	"teddy-backend/internal/proto/message"
 	"teddy-backend/internal/proto/uaa"
 	"teddy-backend/internal/repositories"
+	"teddy-backend/pkg/grpcadapter"
+	"teddy-backend/pkg/password"
 	"time"
 )
 
-func NewAccountServer(repo repositories.AccountRepository,
-	uidGen components.UidGenerator) (uaa.UAAServer, error) {
+func NewAccountServer(repo repositories.AccountRepository, tokenRepo repositories.RefreshTokenRepository,
+	revokedRepo repositories.RevokedTokenRepository, failureRepo repositories.LoginFailureRepository,
+	profileRepo repositories.ProfileRepository, sessionRepo repositories.SessionRepository,
+	deletionRepo repositories.DeletionRepository, clientRepo repositories.OAuthClientRepository,
+	personalTokenRepo repositories.PersonalTokenRepository,
+	policy grpcadapter.PolicyAdapterServer,
+	auditServer audit.AuditServer, contentClient content.ContentClient, messageClient message.MessageClient,
+	uidGen components.UidGenerator, deletionGrace time.Duration,
+	passwordPolicy password.Policy, hasher password.Hasher) (uaa.UAAServer, error) {
 
-	return &accountHandler{
-		repo:   repo,
-		uidGen: uidGen,
-	}, nil
+	instance := &accountHandler{
+		repo:              repo,
+		tokenRepo:         tokenRepo,
+		revokedRepo:       revokedRepo,
+		failureRepo:       failureRepo,
+		profileRepo:       profileRepo,
+		sessionRepo:       sessionRepo,
+		deletionRepo:      deletionRepo,
+		clientRepo:        clientRepo,
+		personalTokenRepo: personalTokenRepo,
+		policy:            policy,
+		auditServer:       auditServer,
+		contentClient:     contentClient,
+		messageClient:     messageClient,
+		uidGen:            uidGen,
+		deletionGrace:     deletionGrace,
+		passwordPolicy:    passwordPolicy,
+		hasher:            hasher,
+	}
+	go instance.runDeletions()
+	return instance, nil
 }
 
 type accountHandler struct {
-	repo   repositories.AccountRepository
-	uidGen components.UidGenerator
+	repo              repositories.AccountRepository
+	tokenRepo         repositories.RefreshTokenRepository
+	revokedRepo       repositories.RevokedTokenRepository
+	failureRepo       repositories.LoginFailureRepository
+	profileRepo       repositories.ProfileRepository
+	sessionRepo       repositories.SessionRepository
+	deletionRepo      repositories.DeletionRepository
+	clientRepo        repositories.OAuthClientRepository
+	personalTokenRepo repositories.PersonalTokenRepository
+	policy            grpcadapter.PolicyAdapterServer
+	auditServer       audit.AuditServer
+	contentClient     content.ContentClient
+	messageClient     message.MessageClient
+	uidGen            components.UidGenerator
+	deletionGrace     time.Duration
+	passwordPolicy    password.Policy
+	hasher            password.Hasher
 }
 
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
 func (h *accountHandler) GetAll(ctx context.Context, req *uaa.GetAllReq) (*uaa.GetAllResp, error) {
-	accounts, err := h.repo.FindAll(req.Page, req.Size, req.Sorts)
+	if err := validateGetAllReq(req); err != nil {
+		return nil, err
+	}
+
+	filter, err := accountFilterFromGetAllReq(req)
+	if err != nil {
+		return nil, ErrTimeRangeInvalid
+	}
+	size := req.Size
+	if size == 0 {
+		size = DefaultPageSize
+	} else if size > MaxPageSize {
+		size = MaxPageSize
+	}
+	accounts, totalCount, err := h.repo.FindAll(filter, uint(req.Page), uint(size), req.Sorts)
 	if err != nil {
 		log.Error(err)
-		return nil, err
+		return nil, ErrInternal
 	}
 
-	var resp uaa.GetAllResp
+	resp := uaa.GetAllResp{TotalCount: totalCount}
 	for _, v := range accounts {
 		var pbAcc uaa.Account
 		copyFromAccountToPBAccount(v, &pbAcc)
@@ -73,7 +136,7 @@ func (h *accountHandler) RegisterByNormal(ctx context.Context, req *uaa.Register
 
 	var account models.Account
 	account.Username = req.GetUsername()
-	account.Roles = req.GetRoles()
+	account.Roles = []string{DefaultRole}
 	account.CreateDate = time.Now()
 	account.OAuthUIds = make(map[string]string)
 	account.CredentialsExpired = false
@@ -85,6 +148,7 @@ func (h *accountHandler) RegisterByNormal(ctx context.Context, req *uaa.Register
 			return nil, ErrAccountExist
 		}
 		account.Email = x.Email
+		account.EmailVerified = req.GetEmailVerified()
 	} else if x, ok := req.GetContact().(*uaa.RegisterNormalReq_Phone); ok {
 		_, err = h.repo.FindOne(req.GetPhone())
 		if err != mongo.ErrNoDocuments {
@@ -95,12 +159,16 @@ func (h *accountHandler) RegisterByNormal(ctx context.Context, req *uaa.Register
 		panic(errors.New("never happen"))
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.GetPassword()), bcrypt.DefaultCost)
+	if err := h.checkNewPassword(nil, req.GetPassword()); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := h.hasher.Hash(req.GetPassword())
 	if err != nil {
 		log.Error(err)
 		return nil, err
 	}
 	account.Password = hashedPassword
+	account.PasswordDate = account.CreateDate
 
 	uid, err := h.uidGen.NexID()
 	if err != nil {
@@ -110,18 +178,94 @@ func (h *accountHandler) RegisterByNormal(ctx context.Context, req *uaa.Register
 	account.UID = uid
 
 	err = h.repo.InsertAccount(&account)
-	if err != nil {
+	if err == repositories.ErrDuplicateKey {
+		return nil, ErrAccountExist
+	} else if err != nil {
 		log.Error(err)
 		return nil, err
 	}
 
+	err = h.bindRoles(account.UID, account.Roles)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
 	var resp uaa.Account
 	copyFromAccountToPBAccount(&account, &resp)
 	return &resp, nil
 }
 
 func (h *accountHandler) RegisterByOAuth(ctx context.Context, req *uaa.RegisterOAuthReq) (*uaa.Account, error) {
-	panic("implement me")
+	if err := validateRegisterOAuthReq(req); err != nil {
+		return nil, err
+	}
+
+	// Already registered, just return the bound account
+	acc, err := h.repo.FindAccountByOAuth(req.GetOauthProvider(), req.GetOauthUID())
+	if err == nil {
+		if err := h.checkLocked(acc); err != nil {
+			return nil, err
+		}
+		var resp uaa.Account
+		copyFromAccountToPBAccount(acc, &resp)
+		return &resp, nil
+	} else if err != mongo.ErrNoDocuments {
+		log.Error(err)
+		return nil, err
+	}
+
+	uid, err := h.uidGen.NexID()
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var account models.Account
+	account.UID = uid
+	account.Roles = []string{DefaultRole}
+	account.CreateDate = time.Now()
+	account.OAuthUIds = map[string]string{
+		req.GetOauthProvider(): req.GetOauthUID(),
+	}
+	account.CredentialsExpired = false
+	account.Locked = false
+
+	// Provider username may conflict with local one, fallback to a generated one
+	account.Username = req.GetUsername()
+	if account.Username == "" {
+		account.Username = strings.ToLower(req.GetOauthProvider()) + "_" + uid
+	} else if _, err := h.repo.FindAccountByUsername(account.Username); err != mongo.ErrNoDocuments {
+		account.Username = strings.ToLower(req.GetOauthProvider()) + "_" + uid
+	}
+
+	// Never take an email the provider hasn't verified, nor one owned by
+	// another account
+	if req.GetEmail() != "" && req.GetEmailVerified() {
+		if _, err := h.repo.FindAccountByEmail(req.GetEmail()); err == mongo.ErrNoDocuments {
+			account.Email = req.GetEmail()
+			account.EmailVerified = true
+		}
+	}
+
+	err = h.repo.InsertAccount(&account)
+	if err == repositories.ErrDuplicateKey {
+		// Email taken after the check above
+		return nil, ErrEmailTaken
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	err = h.bindRoles(account.UID, account.Roles)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.Account
+	copyFromAccountToPBAccount(&account, &resp)
+	return &resp, nil
 }
 
 func (h *accountHandler) VerifyPassword(ctx context.Context, req *uaa.VerifyAccountReq) (*uaa.Account, error) {
@@ -130,40 +274,193 @@ func (h *accountHandler) VerifyPassword(ctx context.Context, req *uaa.VerifyAcco
 	}
 
 	acc, err := h.repo.FindOne(req.GetPrincipal())
-	if err != nil {
+	if err != nil && err != mongo.ErrNoDocuments {
 		log.Error(err)
+		return nil, ErrInternal
+	}
+	principalKey, ipKey := loginFailureKeys(req, acc)
+
+	if !req.GetCaptchaVerified() {
+		required, err := h.captchaRequired(principalKey, ipKey)
+		if err != nil {
+			return nil, err
+		} else if required {
+			return nil, ErrCaptchaRequired
+		}
+	}
+
+	if acc == nil {
+		h.loginFailed(ctx, principalKey, ipKey, nil)
+		h.recordLogin(ctx, false, req.GetPrincipal(), req.GetIp(), map[string]string{"reason": "unknown principal"})
 		return nil, UserNotFoundErr
 	}
-	err = bcrypt.CompareHashAndPassword(acc.Password, []byte(req.GetPassword()))
+	match, rehash, err := h.hasher.Verify(acc.Password, req.GetPassword())
 	if err != nil {
 		log.Error(err)
+	}
+	if !match {
+		h.loginFailed(ctx, principalKey, ipKey, acc)
+		h.recordLogin(ctx, false, acc.UID, req.GetIp(), map[string]string{"reason": "password"})
 		return nil, UserNotFoundErr
 	}
+	// Hashes of older algorithm or parameters are upgraded while the
+	// password is known
+	if rehash {
+		h.rehashPassword(acc, req.GetPassword())
+	}
+	if err := h.failureRepo.Reset(principalKey); err != nil {
+		log.Error(err)
+	}
+
+	// Only tell account state to who knows the password
+	if err := h.checkLocked(acc); err != nil {
+		h.recordLogin(ctx, false, acc.UID, req.GetIp(), map[string]string{"reason": "locked"})
+		return nil, err
+	}
+	h.checkPasswordAge(acc)
+	if acc.MustChangePassword {
+		return nil, ErrMustChangePassword
+	} else if acc.CredentialsExpired {
+		return nil, ErrCredentialsExpired
+	}
+	detail := map[string]string{"factor": "password"}
+	if acc.TwoFactorEnabled {
+		detail["two_factor"] = "pending"
+	}
+	h.recordLogin(ctx, true, acc.UID, req.GetIp(), detail)
+
 	var resp uaa.Account
 	copyFromAccountToPBAccount(acc, &resp)
 	return &resp, nil
 }
 
+// DeleteOne deletes the account without grace period, data in other services
+// is deleted along with it in background.
 func (h *accountHandler) DeleteOne(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
 	if err := validateUIDReq(req); err != nil {
 		return nil, err
 	}
 
-	err := h.repo.DeleteOne(req.GetUid())
+	_, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	_, err = h.scheduleDeletion(req.GetUid(), 0)
 	if err != nil {
 		log.Error(err)
-		return nil, err
+		return nil, ErrInternal
 	}
 	var resp empty.Empty
 	return &resp, nil
 }
 
-func (h *accountHandler) DoLockAccount(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
-	panic("implement me")
+// checkLocked fails while the account is locked, timed locks are released
+// on the first check after they pass.
+func (h *accountHandler) checkLocked(acc *models.Account) error {
+	if !acc.Locked {
+		return nil
+	}
+
+	if acc.LockedUntil.IsZero() || acc.LockedUntil.After(time.Now()) {
+		return errAccountLocked(acc.LockedUntil)
+	}
+
+	err := h.repo.UpdateOne(acc.UID, map[string]interface{}{
+		"locked":       false,
+		"locked_until": time.Time{},
+	})
+	if err != nil {
+		log.Error(err)
+		return ErrInternal
+	}
+	acc.Locked = false
+	acc.LockedUntil = time.Time{}
+	return nil
+}
+
+func (h *accountHandler) updateAccountState(uid string, fields map[string]interface{}) (*empty.Empty, error) {
+	fields["update_date"] = time.Now()
+	err := h.repo.UpdateOne(uid, fields)
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+func (h *accountHandler) DoLockAccount(ctx context.Context, req *uaa.LockAccountReq) (*empty.Empty, error) {
+	if err := validateLockAccountReq(req); err != nil {
+		return nil, err
+	}
+
+	var lockedUntil time.Time
+	if req.GetLockedUntil() != nil {
+		var err error
+		lockedUntil, err = ptypes.Timestamp(req.GetLockedUntil())
+		if err != nil {
+			log.Error(err)
+			return nil, err
+		}
+	}
+
+	resp, err := h.updateAccountState(req.GetUid(), map[string]interface{}{
+		"locked":       true,
+		"locked_until": lockedUntil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	detail := map[string]string{}
+	if !lockedUntil.IsZero() {
+		detail["locked_until"] = lockedUntil.Format(time.RFC3339)
+	}
+	h.recordEvent(ctx, models.AuditAccountLock, req.GetUid(), detail)
+	return resp, nil
+}
+
+func (h *accountHandler) DoUnlockAccount(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateAccountState(req.GetUid(), map[string]interface{}{
+		"locked":       false,
+		"locked_until": time.Time{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.recordEvent(ctx, models.AuditAccountUnlock, req.GetUid(), nil)
+	return resp, nil
 }
 
-func (h *accountHandler) DoCredentialsExpired(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
-	panic("implement me")
+func (h *accountHandler) DoCredentialsExpired(ctx context.Context, req *uaa.CredentialsExpiredReq) (*empty.Empty, error) {
+	if err := validateCredentialsExpiredReq(req); err != nil {
+		return nil, err
+	}
+
+	return h.updateAccountState(req.GetUid(), map[string]interface{}{
+		"credentials_expired":  true,
+		"must_change_password": req.GetMustChangePassword(),
+	})
+}
+
+func (h *accountHandler) DoRenewCredentials(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	return h.updateAccountState(req.GetUid(), map[string]interface{}{
+		"credentials_expired":  false,
+		"must_change_password": false,
+	})
 }
 
 func (h *accountHandler) UpdateSignIn(ctx context.Context, req *uaa.UpdateSignInReq) (*empty.Empty, error) {
@@ -205,23 +502,76 @@ func (h *accountHandler) ChangePassword(ctx context.Context, req *uaa.ChangePass
 		log.Error(err)
 		return nil, UserNotFoundErr
 	}
-	err = bcrypt.CompareHashAndPassword(acc.Password, []byte(req.GetOldPassword()))
+	if !h.hashMatches(acc.Password, req.GetOldPassword()) {
+		return nil, OldPasswordNotCorrectErr
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+	resp, err := h.setPassword(acc, req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+	h.recordEvent(ctx, models.AuditPasswordChange, acc.UID, nil)
+	return resp, nil
+}
+
+func (h *accountHandler) ResetPassword(ctx context.Context, req *uaa.ResetPasswordReq) (*empty.Empty, error) {
+	if err := validateResetPasswordReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
 	if err != nil {
 		log.Error(err)
-		return nil, OldPasswordNotCorrectErr
+		return nil, UserNotFoundErr
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
 	}
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.GetNewPassword()), bcrypt.DefaultCost)
+	resp, err := h.setPassword(acc, req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+	h.recordEvent(ctx, models.AuditPasswordReset, acc.UID, nil)
+	return resp, nil
+}
+
+func (h *accountHandler) setPassword(acc *models.Account, pwd string) (*empty.Empty, error) {
+	if err := h.checkNewPassword(acc, pwd); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := h.hasher.Hash(pwd)
 	if err != nil {
 		log.Error(err)
 		return nil, PasswordModifyErr
 	}
+	// The current password joins the history, which keeps the size of the
+	// policy along with the new one
+	history := h.lastPasswords(acc)
+	if len(history) == h.passwordPolicy.HistorySize && len(history) != 0 {
+		history = history[:len(history)-1]
+	}
+
+	// A new password renews expired credentials and logs out all sessions
+	now := time.Now()
 	err = h.repo.UpdateOne(acc.UID, map[string]interface{}{
-		"password": hashedPassword,
+		"password":             hashedPassword,
+		"password_date":        now,
+		"password_history":     history,
+		"credentials_expired":  false,
+		"must_change_password": false,
+		"tokens_revoked_at":    now,
+		"update_date":          now,
 	})
 	if err != nil {
 		log.Error(err)
 		return nil, PasswordModifyErr
 	}
+	if err := h.revokeAllSessions(acc.UID); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
 	var resp empty.Empty
 	return &resp, nil
 }
@@ -0,0 +1,100 @@
+package uaa
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+const (
+	LoginFailureWindow = 15 * time.Minute
+	// Failures before image captcha is required, one IP may be shared by many users
+	PrincipalCaptchaThreshold = 3
+	IPCaptchaThreshold        = 20
+	// Failures before the account is locked for LoginLockDuration
+	LoginLockThreshold = 10
+	LoginLockDuration  = 15 * time.Minute
+)
+
+// loginFailureKeys keys failures of existing account by uid, so username,
+// email and phone share one counter.
+func loginFailureKeys(req *uaa.VerifyAccountReq, acc *models.Account) (string, string) {
+	principalKey := "principal:" + strings.ToLower(req.GetPrincipal())
+	if acc != nil {
+		principalKey = "uid:" + acc.UID
+	}
+	ipKey := ""
+	if req.GetIp() != "" {
+		ipKey = "ip:" + req.GetIp()
+	}
+	return principalKey, ipKey
+}
+
+// twoFactorFailureKey counts wrong second factors apart from passwords, so a
+// correct password doesn't reset them.
+func twoFactorFailureKey(uid string) string {
+	return "2fa:uid:" + uid
+}
+
+func (h *accountHandler) captchaRequired(principalKey, ipKey string) (bool, error) {
+	count, err := h.failureRepo.Count(principalKey)
+	if err != nil {
+		log.Error(err)
+		return false, ErrInternal
+	} else if count >= PrincipalCaptchaThreshold {
+		return true, nil
+	}
+
+	if ipKey == "" {
+		return false, nil
+	}
+	count, err = h.failureRepo.Count(ipKey)
+	if err != nil {
+		log.Error(err)
+		return false, ErrInternal
+	}
+	return count >= IPCaptchaThreshold, nil
+}
+
+// loginFailed counts a failure, and locks the account for a while when there
+// are too many. Errors are only logged, the login fails anyway.
+func (h *accountHandler) loginFailed(ctx context.Context, principalKey, ipKey string, acc *models.Account) {
+	if ipKey != "" {
+		if _, err := h.failureRepo.Increment(ipKey, LoginFailureWindow); err != nil {
+			log.Error(err)
+		}
+	}
+
+	count, err := h.failureRepo.Increment(principalKey, LoginFailureWindow)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if acc == nil || count < LoginLockThreshold {
+		return
+	}
+
+	// Never shorten a lock set by administrator
+	if acc.Locked && (acc.LockedUntil.IsZero() || acc.LockedUntil.After(time.Now().Add(LoginLockDuration))) {
+		return
+	}
+	log.Warnf("too many login failures, lock account %s for %v", acc.UID, LoginLockDuration)
+	err = h.repo.UpdateOne(acc.UID, map[string]interface{}{
+		"locked":       true,
+		"locked_until": time.Now().Add(LoginLockDuration),
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if err := h.failureRepo.Reset(principalKey); err != nil {
+		log.Error(err)
+	}
+	h.recordEvent(ctx, models.AuditAccountLock, acc.UID, map[string]string{
+		"reason":       "login failures",
+		"locked_until": time.Now().Add(LoginLockDuration).Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,170 @@
+package uaa
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+)
+
+// LinkOAuth adds a provider identity as a login method of the account. An
+// identity of another account is only taken over with merge, see canMerge.
+func (h *accountHandler) LinkOAuth(ctx context.Context, req *uaa.LinkOAuthReq) (*uaa.Account, error) {
+	if err := validateLinkOAuthReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+
+	provider := req.GetOauthProvider()
+	if linked, ok := acc.OAuthUIds[provider]; ok {
+		if linked != req.GetOauthUID() {
+			return nil, ErrOAuthProviderLinked
+		}
+		var resp uaa.Account
+		copyFromAccountToPBAccount(acc, &resp)
+		return &resp, nil
+	}
+
+	owner, err := h.repo.FindAccountByOAuth(provider, req.GetOauthUID())
+	if err == nil {
+		if !req.GetMerge() {
+			return nil, ErrOAuthIdentityTaken
+		}
+		if err := h.mergeAccount(ctx, acc, owner, provider); err != nil {
+			return nil, err
+		}
+	} else if err != mongo.ErrNoDocuments {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	if _, err := h.updateAccountState(acc.UID, map[string]interface{}{
+		"oauth_uids." + provider: req.GetOauthUID(),
+	}); err != nil {
+		return nil, err
+	}
+	if acc.OAuthUIds == nil {
+		acc.OAuthUIds = make(map[string]string)
+	}
+	acc.OAuthUIds[provider] = req.GetOauthUID()
+	h.recordEvent(ctx, models.AuditOAuthLink, acc.UID, map[string]string{
+		"provider": provider,
+	})
+
+	var resp uaa.Account
+	copyFromAccountToPBAccount(acc, &resp)
+	return &resp, nil
+}
+
+// UnlinkOAuth removes a provider identity, as long as the account can still
+// sign in with a password or another provider.
+func (h *accountHandler) UnlinkOAuth(ctx context.Context, req *uaa.UnlinkOAuthReq) (*uaa.Account, error) {
+	if err := validateUnlinkOAuthReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	provider := req.GetOauthProvider()
+	if _, ok := acc.OAuthUIds[provider]; !ok {
+		return nil, ErrOAuthNotLinked
+	}
+	if loginMethods(acc) <= 1 {
+		return nil, ErrLastLoginMethod
+	}
+
+	oauthUIDs := make(map[string]string, len(acc.OAuthUIds)-1)
+	for k, v := range acc.OAuthUIds {
+		if k != provider {
+			oauthUIDs[k] = v
+		}
+	}
+	if _, err := h.updateAccountState(acc.UID, map[string]interface{}{
+		"oauth_uids": oauthUIDs,
+	}); err != nil {
+		return nil, err
+	}
+	acc.OAuthUIds = oauthUIDs
+	h.recordEvent(ctx, models.AuditOAuthUnlink, acc.UID, map[string]string{
+		"provider": provider,
+	})
+
+	var resp uaa.Account
+	copyFromAccountToPBAccount(acc, &resp)
+	return &resp, nil
+}
+
+// loginMethods counts the ways acc signs in, its password and providers.
+func loginMethods(acc *models.Account) int {
+	count := len(acc.OAuthUIds)
+	if len(acc.Password) != 0 {
+		count++
+	}
+	return count
+}
+
+// canMerge tells whether owner, the account an identity belongs to, may be
+// merged by whoever just signed in with that identity. It must be reachable
+// only by the identity, so nothing else of it is taken over unchecked.
+func canMerge(owner *models.Account, provider string) bool {
+	if _, ok := owner.OAuthUIds[provider]; !ok || loginMethods(owner) != 1 {
+		return false
+	}
+	if owner.TwoFactorEnabled || owner.Locked {
+		return false
+	}
+	for _, role := range owner.Roles {
+		if role != DefaultRole {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeAccount moves the identity of provider from owner to acc, owner then
+// has no login method left and is scheduled for deletion with its data.
+func (h *accountHandler) mergeAccount(ctx context.Context, acc, owner *models.Account, provider string) error {
+	if owner.UID == acc.UID || !canMerge(owner, provider) {
+		return ErrMergeNotAllowed
+	}
+
+	if _, err := h.updateAccountState(owner.UID, map[string]interface{}{
+		"oauth_uids": map[string]string{},
+	}); err != nil {
+		return err
+	}
+	if err := h.revokeAllSessions(owner.UID); err != nil {
+		log.Error(err)
+		return ErrInternal
+	}
+	if err := h.personalTokenRepo.DeleteByUID(owner.UID); err != nil {
+		log.Error(err)
+		return ErrInternal
+	}
+	if _, err := h.scheduleDeletion(owner.UID, h.deletionGrace); err != nil {
+		log.Error(err)
+		return ErrInternal
+	}
+	h.recordEvent(ctx, models.AuditAccountMerge, acc.UID, map[string]string{
+		"provider":   provider,
+		"merged_uid": owner.UID,
+	})
+	return nil
+}
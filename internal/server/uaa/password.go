@@ -0,0 +1,138 @@
+package uaa
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/password"
+	"time"
+)
+
+func (h *accountHandler) CheckPassword(ctx context.Context, req *uaa.CheckPasswordReq) (*empty.Empty, error) {
+	if err := validateCheckPasswordReq(req); err != nil {
+		return nil, err
+	}
+
+	var acc *models.Account
+	if req.GetUid() != "" {
+		var err error
+		acc, err = h.repo.FindOne(req.GetUid())
+		if err == mongo.ErrNoDocuments {
+			return nil, UserNotFoundErr
+		} else if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+	}
+
+	if err := h.checkNewPassword(acc, req.GetPassword()); err != nil {
+		return nil, err
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+// checkNewPassword checks pwd against the policy, and against the last
+// passwords of acc unless acc is nil.
+func (h *accountHandler) checkNewPassword(acc *models.Account, pwd string) error {
+	if err := h.passwordPolicy.Check(pwd); err != nil {
+		return passwordPolicyError(err)
+	}
+	if acc == nil || h.passwordPolicy.HistorySize <= 0 {
+		return nil
+	}
+	for _, hash := range h.lastPasswords(acc) {
+		if h.hashMatches(hash, pwd) {
+			return ErrPasswordReused
+		}
+	}
+	return nil
+}
+
+// hashMatches tells whether hash is of pwd, broken hashes never match.
+func (h *accountHandler) hashMatches(hash []byte, pwd string) bool {
+	match, _, err := h.hasher.Verify(hash, pwd)
+	if err != nil {
+		log.Error(err)
+	}
+	return match
+}
+
+// rehashPassword stores pwd hashed with the current algorithm, the password
+// itself is unchanged so are its date and history.
+func (h *accountHandler) rehashPassword(acc *models.Account, pwd string) {
+	hash, err := h.hasher.Hash(pwd)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if err := h.repo.UpdateOne(acc.UID, map[string]interface{}{"password": hash}); err != nil {
+		log.Error(err)
+		return
+	}
+	acc.Password = hash
+}
+
+// lastPasswords returns hashes of the current and previous passwords of acc,
+// at most as many as the policy keeps.
+func (h *accountHandler) lastPasswords(acc *models.Account) [][]byte {
+	size := h.passwordPolicy.HistorySize
+	if size <= 0 {
+		return nil
+	}
+	hashes := make([][]byte, 0, size)
+	if len(acc.Password) != 0 {
+		hashes = append(hashes, acc.Password)
+	}
+	for _, hash := range acc.PasswordHistory {
+		if len(hashes) == size {
+			break
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// checkPasswordAge expires credentials of acc once its password is older
+// than the policy allows.
+func (h *accountHandler) checkPasswordAge(acc *models.Account) {
+	maxAge := h.passwordPolicy.MaxAge()
+	if maxAge == 0 || acc.CredentialsExpired || len(acc.Password) == 0 {
+		return
+	}
+
+	// Accounts from before password dates were recorded
+	changed := acc.PasswordDate
+	if changed.IsZero() {
+		changed = acc.CreateDate
+	}
+	if changed.Add(maxAge).After(time.Now()) {
+		return
+	}
+
+	acc.CredentialsExpired = true
+	err := h.repo.UpdateOne(acc.UID, map[string]interface{}{
+		"credentials_expired": true,
+		"update_date":         time.Now(),
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func passwordPolicyError(err error) error {
+	switch err {
+	case password.ErrTooShort:
+		return ErrPasswordTooShort
+	case password.ErrTooLong:
+		return ErrPasswordTooLong
+	case password.ErrMissingClasses:
+		return ErrPasswordMissingClasses
+	case password.ErrTooCommon:
+		return ErrPasswordTooCommon
+	}
+	return ErrInternal
+}
@@ -0,0 +1,183 @@
+package uaa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/rs/xid"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+const (
+	PersonalTokenExpiration    = 30 * 24 * time.Hour
+	PersonalTokenMaxExpiration = 366 * 24 * time.Hour
+	MaxPersonalTokens          = 50
+	// Last used times are only written this often
+	personalTokenTouchInterval = time.Minute
+)
+
+func hashPersonalToken(value string) string {
+	return hashRefreshToken(value)
+}
+
+func (h *accountHandler) CreatePersonalToken(ctx context.Context, req *uaa.CreatePersonalTokenReq) (*uaa.CreatePersonalTokenResp, error) {
+	if err := validateCreatePersonalTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expireTime := now.Add(PersonalTokenExpiration)
+	if req.GetExpireTime() != nil {
+		var err error
+		expireTime, err = ptypes.Timestamp(req.GetExpireTime())
+		if err != nil || !expireTime.After(now) || expireTime.After(now.Add(PersonalTokenMaxExpiration)) {
+			return nil, ErrPersonalTokenExpireInvalid
+		}
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var scopes []string
+	for _, scope := range req.GetScopes() {
+		if !components.ContainsString(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	count, err := h.personalTokenRepo.CountActiveByUID(acc.UID)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if count >= MaxPersonalTokens {
+		return nil, ErrTooManyPersonalTokens
+	}
+
+	valueBytes := make([]byte, 32)
+	if _, err := rand.Read(valueBytes); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	value := gin_jwt.PersonalTokenPrefix + base64.RawURLEncoding.EncodeToString(valueBytes)
+
+	token := models.PersonalToken{
+		ID:         xid.New().String(),
+		Hash:       hashPersonalToken(value),
+		UID:        acc.UID,
+		Name:       req.GetName(),
+		Scopes:     scopes,
+		CreateDate: now,
+		ExpireTime: expireTime,
+	}
+	if err := h.personalTokenRepo.InsertToken(&token); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	h.recordEvent(ctx, models.AuditPersonalTokenCreate, acc.UID, map[string]string{
+		"id":     token.ID,
+		"name":   token.Name,
+		"scopes": strings.Join(scopes, ", "),
+	})
+
+	resp := uaa.CreatePersonalTokenResp{
+		Token: &uaa.PersonalToken{},
+		Value: value,
+	}
+	copyFromPersonalTokenToPBPersonalToken(&token, resp.Token)
+	return &resp, nil
+}
+
+func (h *accountHandler) ListPersonalTokens(ctx context.Context, req *uaa.UIDReq) (*uaa.PersonalTokensResp, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.personalTokenRepo.FindActiveByUID(req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.PersonalTokensResp
+	for _, v := range tokens {
+		var pbToken uaa.PersonalToken
+		copyFromPersonalTokenToPBPersonalToken(v, &pbToken)
+		resp.Items = append(resp.Items, &pbToken)
+	}
+	return &resp, nil
+}
+
+// RevokePersonalToken deletes the token, it stops working once caches of
+// verified tokens expire.
+func (h *accountHandler) RevokePersonalToken(ctx context.Context, req *uaa.PersonalTokenReq) (*empty.Empty, error) {
+	if err := validatePersonalTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	err := h.personalTokenRepo.DeleteOne(req.GetUid(), req.GetId())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrPersonalTokenNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	h.recordEvent(ctx, models.AuditPersonalTokenRevoke, req.GetUid(), map[string]string{
+		"id": req.GetId(),
+	})
+
+	var resp empty.Empty
+	return &resp, nil
+}
+
+// VerifyPersonalToken resolves a token value to the token, as long as it
+// isn't revoked or expired and its account can still sign in.
+func (h *accountHandler) VerifyPersonalToken(ctx context.Context, req *uaa.VerifyPersonalTokenReq) (*uaa.PersonalToken, error) {
+	if err := validateVerifyPersonalTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	token, err := h.personalTokenRepo.FindByHash(hashPersonalToken(req.GetValue()))
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrPersonalTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	acc, err := h.repo.FindOne(token.UID)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrPersonalTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if token.LastUsedTime.Add(personalTokenTouchInterval).Before(now) {
+		if err := h.personalTokenRepo.Touch(token.ID, now); err != nil {
+			log.Error(err)
+		}
+		token.LastUsedTime = now
+	}
+
+	var resp uaa.PersonalToken
+	copyFromPersonalTokenToPBPersonalToken(token, &resp)
+	return &resp, nil
+}
@@ -0,0 +1,170 @@
+package uaa
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+)
+
+const (
+	MaxBatchProfiles = 100
+
+	profilePrivateFields = "private_fields"
+)
+
+var profileFields = []string{
+	models.ProfileFirstname,
+	models.ProfileLastname,
+	models.ProfileAvatarUrl,
+	models.ProfileBio,
+	models.ProfileBirthday,
+	models.ProfileGender,
+	models.ProfileLocale,
+}
+
+func defaultProfile(uid string) *models.AccountProfile {
+	return &models.AccountProfile{
+		UID:           uid,
+		Gender:        models.UNKNOWN,
+		PrivateFields: models.DefaultPrivateFields,
+	}
+}
+
+// hidePrivateFields clears what the owner keeps private from other viewers.
+func hidePrivateFields(pbprofile *uaa.Profile, viewerUID string) {
+	if pbprofile.Uid == viewerUID {
+		return
+	}
+	for _, field := range pbprofile.PrivateFields {
+		switch field {
+		case models.ProfileFirstname:
+			pbprofile.Firstname = ""
+		case models.ProfileLastname:
+			pbprofile.Lastname = ""
+		case models.ProfileAvatarUrl:
+			pbprofile.AvatarUrl = ""
+		case models.ProfileBio:
+			pbprofile.Bio = ""
+		case models.ProfileBirthday:
+			pbprofile.Birthday = nil
+		case models.ProfileGender:
+			pbprofile.Gender = uaa.Gender_UNKNOWN
+		case models.ProfileLocale:
+			pbprofile.Locale = ""
+		}
+	}
+	pbprofile.PrivateFields = nil
+}
+
+func (h *accountHandler) GetProfile(ctx context.Context, req *uaa.GetProfileReq) (*uaa.Profile, error) {
+	if err := validateGetProfileReq(req); err != nil {
+		return nil, err
+	}
+
+	_, err := h.repo.FindOne(req.Uid)
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	profile, err := h.profileRepo.FindOne(req.Uid)
+	if err == mongo.ErrNoDocuments {
+		profile = defaultProfile(req.Uid)
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.Profile
+	if err := copyFromProfileToPBProfile(profile, &resp); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	hidePrivateFields(&resp, req.ViewerUid)
+	return &resp, nil
+}
+
+// BatchGetProfiles skips unknown uids, accounts without a stored profile
+// get the default one.
+func (h *accountHandler) BatchGetProfiles(ctx context.Context,
+	req *uaa.BatchGetProfilesReq) (*uaa.BatchGetProfilesResp, error) {
+	if err := validateBatchGetProfilesReq(req); err != nil {
+		return nil, err
+	}
+
+	profiles, err := h.profileRepo.FindByUIDs(req.Uids)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	stored := make(map[string]*models.AccountProfile, len(profiles))
+	for _, v := range profiles {
+		stored[v.UID] = v
+	}
+
+	var resp uaa.BatchGetProfilesResp
+	seen := make(map[string]bool, len(req.Uids))
+	for _, uid := range req.Uids {
+		if seen[uid] {
+			continue
+		}
+		seen[uid] = true
+
+		profile, ok := stored[uid]
+		if !ok {
+			_, err := h.repo.FindOne(uid)
+			if err == mongo.ErrNoDocuments {
+				continue
+			} else if err != nil {
+				log.Error(err)
+				return nil, ErrInternal
+			}
+			profile = defaultProfile(uid)
+		}
+
+		var pbProfile uaa.Profile
+		if err := copyFromProfileToPBProfile(profile, &pbProfile); err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+		hidePrivateFields(&pbProfile, req.ViewerUid)
+		resp.Profiles = append(resp.Profiles, &pbProfile)
+	}
+	return &resp, nil
+}
+
+func (h *accountHandler) UpdateProfile(ctx context.Context, req *uaa.UpdateProfileReq) (*uaa.Profile, error) {
+	if err := validateUpdateProfileReq(req); err != nil {
+		return nil, err
+	}
+
+	_, err := h.repo.FindOne(req.Profile.Uid)
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	fields, err := profileFieldsFromPBProfile(req.Profile, req.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.profileRepo.UpsertOne(req.Profile.Uid, fields)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.Profile
+	if err := copyFromProfileToPBProfile(profile, &resp); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &resp, nil
+}
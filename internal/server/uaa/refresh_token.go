@@ -0,0 +1,197 @@
+package uaa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+const RefreshTokenExpiration = 30 * 24 * time.Hour
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueRefreshToken persists a new token of the family and returns the
+// opaque value, which is never stored.
+func (h *accountHandler) issueRefreshToken(uid, family string) (*uaa.RefreshToken, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	value := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now()
+	token := models.RefreshToken{
+		ID:         hashRefreshToken(value),
+		UID:        uid,
+		Family:     family,
+		ExpireTime: now.Add(RefreshTokenExpiration),
+		CreateDate: now,
+	}
+	err := h.tokenRepo.InsertRefreshToken(&token)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	expireTime, err := ptypes.TimestampProto(token.ExpireTime)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return &uaa.RefreshToken{
+		Token:      value,
+		ExpireTime: expireTime,
+		SessionId:  family,
+	}, nil
+}
+
+func (h *accountHandler) revokeRefreshTokenFamily(token *models.RefreshToken) {
+	log.Warnf("refresh token of family %s reused, revoke the family of user %s", token.Family, token.UID)
+	if err := h.revokeSession(token.Family); err != nil {
+		log.Error(err)
+	}
+}
+
+// IssueRefreshToken starts a new session, the family of its refresh tokens
+// is the session id.
+func (h *accountHandler) IssueRefreshToken(ctx context.Context, req *uaa.IssueRefreshTokenReq) (*uaa.RefreshToken, error) {
+	if err := validateIssueRefreshTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := models.Session{
+		ID:             uuid.New().String(),
+		UID:            req.GetUid(),
+		JTI:            req.GetJti(),
+		IP:             req.GetIp(),
+		UserAgent:      req.GetUserAgent(),
+		CreateDate:     now,
+		LastActiveDate: now,
+		ExpireTime:     now.Add(RefreshTokenExpiration),
+	}
+	err := h.sessionRepo.InsertSession(&session)
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	return h.issueRefreshToken(req.GetUid(), session.ID)
+}
+
+// RotateRefreshToken exchanges a refresh token for a new one of the same family,
+// a token presented again after rotation means it leaked so the whole family
+// is revoked.
+func (h *accountHandler) RotateRefreshToken(ctx context.Context,
+	req *uaa.RefreshTokenReq) (*uaa.RotateRefreshTokenResp, error) {
+	if err := validateRefreshTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	token, err := h.tokenRepo.FindOne(hashRefreshToken(req.GetToken()))
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrRefreshTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	if token.Revoked {
+		return nil, ErrRefreshTokenInvalid
+	} else if token.Used {
+		h.revokeRefreshTokenFamily(token)
+		return nil, ErrRefreshTokenInvalid
+	} else if token.ExpireTime.Before(time.Now()) {
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	// Lost the race with another rotation of the same token
+	err = h.tokenRepo.MarkUsed(token.ID)
+	if err == mongo.ErrNoDocuments {
+		h.revokeRefreshTokenFamily(token)
+		return nil, ErrRefreshTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	acc, err := h.repo.FindOne(token.UID)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrRefreshTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+	h.checkPasswordAge(acc)
+	if acc.MustChangePassword {
+		return nil, ErrMustChangePassword
+	} else if acc.CredentialsExpired {
+		return nil, ErrCredentialsExpired
+	}
+
+	session, err := h.sessionRepo.FindOne(token.Family)
+	if err == mongo.ErrNoDocuments {
+		// Family issued before sessions were recorded
+		session = &models.Session{
+			ID:         token.Family,
+			UID:        token.UID,
+			CreateDate: token.CreateDate,
+		}
+		err = h.sessionRepo.InsertSession(session)
+	}
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if session.Revoked {
+		if err := h.revokeSession(session.ID); err != nil {
+			log.Error(err)
+		}
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	refreshToken, err := h.issueRefreshToken(token.UID, token.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = h.sessionRepo.Touch(session.ID, map[string]interface{}{
+		"jti":              req.GetJti(),
+		"ip":               req.GetIp(),
+		"user_agent":       req.GetUserAgent(),
+		"last_active_date": now,
+		"expire_time":      now.Add(RefreshTokenExpiration),
+	})
+	if err == mongo.ErrNoDocuments {
+		// Revoked while rotating
+		if err := h.revokeSession(session.ID); err != nil {
+			log.Error(err)
+		}
+		return nil, ErrRefreshTokenInvalid
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.RotateRefreshTokenResp
+	resp.Account = &uaa.Account{}
+	copyFromAccountToPBAccount(acc, resp.Account)
+	resp.RefreshToken = refreshToken
+	return &resp, nil
+}
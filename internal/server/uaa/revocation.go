@@ -0,0 +1,182 @@
+package uaa
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"strings"
+	"teddy-backend/internal/gin_jwt"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"time"
+)
+
+func (h *accountHandler) RevokeToken(ctx context.Context, req *uaa.RevokeTokenReq) (*empty.Empty, error) {
+	if err := validateRevokeTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	expireTime, err := ptypes.Timestamp(req.GetExpireTime())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	// Already expired token needn't to be kept
+	if expireTime.After(time.Now()) {
+		_, err = h.revokedRepo.InsertRevokedToken(&models.RevokedToken{
+			JTI:        req.GetJti(),
+			UID:        req.GetUid(),
+			ExpireTime: expireTime,
+			CreateDate: time.Now(),
+		})
+		if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+	}
+
+	if req.GetSessionId() != "" {
+		session, err := h.sessionRepo.FindOne(req.GetSessionId())
+		if err == nil && session.UID == req.GetUid() {
+			if err := h.revokeSession(session.ID); err != nil {
+				log.Error(err)
+				return nil, ErrInternal
+			}
+		} else if err != nil && err != mongo.ErrNoDocuments {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+	}
+
+	if req.GetRefreshToken() != "" {
+		token, err := h.tokenRepo.FindOne(hashRefreshToken(req.GetRefreshToken()))
+		if err == nil && token.UID == req.GetUid() {
+			if err := h.revokeSession(token.Family); err != nil {
+				log.Error(err)
+				return nil, ErrInternal
+			}
+		} else if err != nil && err != mongo.ErrNoDocuments {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+	}
+
+	var resp empty.Empty
+	return &resp, nil
+}
+
+// RevokeAllTokens logs out all sessions, access tokens issued before now
+// are rejected and no refresh token can be used any more.
+func (h *accountHandler) RevokeAllTokens(ctx context.Context, req *uaa.UIDReq) (*empty.Empty, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateAccountState(req.GetUid(), map[string]interface{}{
+		"tokens_revoked_at": time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.revokeAllSessions(req.GetUid()); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	return resp, nil
+}
+
+// UseToken marks a one-time token used, only the first of concurrent calls
+// succeeds.
+func (h *accountHandler) UseToken(ctx context.Context, req *uaa.UseTokenReq) (*empty.Empty, error) {
+	if err := validateUseTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	expireTime, err := ptypes.Timestamp(req.GetExpireTime())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+	first, err := h.revokedRepo.InsertRevokedToken(&models.RevokedToken{
+		JTI:        req.GetJti(),
+		UID:        req.GetUid(),
+		ExpireTime: expireTime,
+		CreateDate: time.Now(),
+	})
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if !first {
+		return nil, ErrTokenUsed
+	}
+
+	var resp empty.Empty
+	return &resp, nil
+}
+
+func (h *accountHandler) IsTokenRevoked(ctx context.Context, req *uaa.IsTokenRevokedReq) (*uaa.IsTokenRevokedResp, error) {
+	if err := validateIsTokenRevokedReq(req); err != nil {
+		return nil, err
+	}
+
+	var resp uaa.IsTokenRevokedResp
+	revoked, err := h.revokedRepo.IsRevoked(req.GetJti())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if revoked {
+		resp.Revoked = true
+		return &resp, nil
+	}
+
+	if req.GetSessionId() != "" {
+		session, err := h.sessionRepo.FindOne(req.GetSessionId())
+		if err == mongo.ErrNoDocuments {
+			resp.Revoked = true
+			return &resp, nil
+		} else if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		} else if session.Revoked || session.UID != req.GetUid() {
+			resp.Revoked = true
+			return &resp, nil
+		}
+	}
+
+	if strings.HasPrefix(req.GetUid(), gin_jwt.ClientSubjectPrefix) {
+		revoked, err := h.isClientRevoked(req.GetUid())
+		if err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+		resp.Revoked = revoked
+		return &resp, nil
+	}
+
+	issuedAt, err := ptypes.Timestamp(req.GetIssuedAt())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		// Token of deleted account is useless
+		resp.Revoked = true
+		return &resp, nil
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	// iat only has second precision, tokens issued within the second
+	// of revocation are revoked too
+	if !acc.TokensRevokedAt.IsZero() && !issuedAt.After(acc.TokensRevokedAt.Truncate(time.Second)) {
+		resp.Revoked = true
+	}
+	return &resp, nil
+}
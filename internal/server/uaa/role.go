@@ -0,0 +1,126 @@
+package uaa
+
+import (
+	"context"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"regexp"
+	"strings"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/grpcadapter"
+)
+
+// DefaultRole is given to every new account, others are assigned by admins.
+const DefaultRole = "user"
+
+// Role names never look like a uid, so they can't be mixed up in policies
+var rolePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// bindRoles replaces the role bindings of uid in the policy store.
+func (h *accountHandler) bindRoles(uid string, roles []string) error {
+	ctx := context.Background()
+	_, err := h.policy.RemoveFilteredPolicy(ctx, &grpcadapter.RemoveFilteredPolicyReq{
+		Sec:         "g",
+		Ptype:       "g",
+		FieldIndex:  0,
+		FieldValues: []string{uid},
+	})
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		_, err := h.policy.AddPolicy(ctx, &grpcadapter.AddPolicyReq{
+			Sec:   "g",
+			Ptype: "g",
+			Rule:  []string{uid, role},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// boundRoles returns the roles uid is bound to in the policy store.
+func (h *accountHandler) boundRoles(ctx context.Context, uid string) ([]string, error) {
+	policies, err := h.policy.GetFilteredPolicy(ctx, &grpcadapter.GetFilteredPolicyReq{
+		Ptype:       "g",
+		FieldIndex:  0,
+		FieldValues: []string{uid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	for _, v := range policies.Policies {
+		if len(v.Rule) > 1 {
+			roles = append(roles, v.Rule[1])
+		}
+	}
+	return roles, nil
+}
+
+// GetRoles reads the bindings in the policy store, which are what is enforced.
+func (h *accountHandler) GetRoles(ctx context.Context, req *uaa.UIDReq) (*uaa.RolesResp, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	_, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	roles, err := h.boundRoles(ctx, req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	resp := uaa.RolesResp{Roles: roles}
+	return &resp, nil
+}
+
+func (h *accountHandler) SetRoles(ctx context.Context, req *uaa.SetRolesReq) (*uaa.RolesResp, error) {
+	if err := validateSetRolesReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err == mongo.ErrNoDocuments {
+		return nil, UserNotFoundErr
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var roles []string
+	for _, role := range req.GetRoles() {
+		if !components.ContainsString(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	if err := h.bindRoles(acc.UID, roles); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	if _, err := h.updateAccountState(acc.UID, map[string]interface{}{
+		"roles": roles,
+	}); err != nil {
+		return nil, err
+	}
+	h.recordEvent(ctx, models.AuditRoleSet, acc.UID, map[string]string{
+		"roles": strings.Join(roles, ", "),
+	})
+
+	return &uaa.RolesResp{
+		Roles: roles,
+	}, nil
+}
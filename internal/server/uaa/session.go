@@ -0,0 +1,94 @@
+package uaa
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"teddy-backend/internal/proto/uaa"
+)
+
+// revokeSession stops refreshing of the session and rejects its access tokens.
+func (h *accountHandler) revokeSession(id string) error {
+	if err := h.tokenRepo.RevokeFamily(id); err != nil {
+		return err
+	}
+	return h.sessionRepo.Revoke(id)
+}
+
+func (h *accountHandler) revokeAllSessions(uid string) error {
+	if err := h.tokenRepo.RevokeByUID(uid); err != nil {
+		return err
+	}
+	return h.sessionRepo.RevokeByUID(uid)
+}
+
+func (h *accountHandler) ListSessions(ctx context.Context, req *uaa.UIDReq) (*uaa.ListSessionsResp, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.sessionRepo.FindActiveByUID(req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+
+	var resp uaa.ListSessionsResp
+	for _, v := range sessions {
+		var pbSession uaa.Session
+		if err := copyFromSessionToPBSession(v, &pbSession); err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+		resp.Sessions = append(resp.Sessions, &pbSession)
+	}
+	return &resp, nil
+}
+
+func (h *accountHandler) RevokeSession(ctx context.Context, req *uaa.SessionReq) (*empty.Empty, error) {
+	if err := validateSessionReq(req); err != nil {
+		return nil, err
+	}
+
+	session, err := h.sessionRepo.FindOne(req.GetSessionId())
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrSessionNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if session.UID != req.GetUid() {
+		return nil, ErrSessionNotFound
+	}
+
+	if err := h.revokeSession(session.ID); err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
+
+// RevokeOtherSessions keeps only the given session of the account.
+func (h *accountHandler) RevokeOtherSessions(ctx context.Context, req *uaa.SessionReq) (*empty.Empty, error) {
+	if err := validateSessionReq(req); err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.sessionRepo.FindActiveByUID(req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	for _, v := range sessions {
+		if v.ID == req.GetSessionId() {
+			continue
+		}
+		if err := h.revokeSession(v.ID); err != nil {
+			log.Error(err)
+			return nil, ErrInternal
+		}
+	}
+	var resp empty.Empty
+	return &resp, nil
+}
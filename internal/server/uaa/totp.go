@@ -0,0 +1,290 @@
+package uaa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"strings"
+	"teddy-backend/internal/models"
+	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/totp"
+	"time"
+)
+
+const (
+	TOTPIssuer = "Teddy"
+	// Steps accepted on each side for clock drift
+	TOTPSkew = 1
+
+	RecoveryCodeCount    = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.Replace(code, "-", "", -1)
+	return strings.Replace(code, " ", "", -1)
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateRecoveryCodes returns the codes to show once and their hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := range codes {
+		code := make([]byte, recoveryCodeLength)
+		for j := range code {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, err
+			}
+			code[j] = recoveryCodeAlphabet[n.Int64()]
+		}
+		half := recoveryCodeLength / 2
+		codes[i] = string(code[:half]) + "-" + string(code[half:])
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// verifySecondFactor accepts a TOTP code of the enabled secret or a
+// recovery code, both can only be used once.
+func (h *accountHandler) verifySecondFactor(acc *models.Account, code string) error {
+	if counter, ok := totp.Validate(acc.TOTPSecret, code, time.Now(), TOTPSkew); ok {
+		err := h.repo.UpdateTOTPCounter(acc.UID, counter)
+		if err == mongo.ErrNoDocuments {
+			return ErrTOTPCodeInvalid
+		} else if err != nil {
+			log.Error(err)
+			return ErrInternal
+		}
+		return nil
+	}
+
+	err := h.repo.PullRecoveryCode(acc.UID, hashRecoveryCode(code))
+	if err == mongo.ErrNoDocuments {
+		return ErrTOTPCodeInvalid
+	} else if err != nil {
+		log.Error(err)
+		return ErrInternal
+	}
+	return nil
+}
+
+func (h *accountHandler) findTwoFactorAccount(uid string) (*models.Account, error) {
+	acc, err := h.repo.FindOne(uid)
+	if err != nil {
+		log.Error(err)
+		return nil, UserNotFoundErr
+	}
+	if !acc.TwoFactorEnabled {
+		return nil, ErrTOTPNotEnabled
+	}
+	return acc, nil
+}
+
+// EnrollTOTP generates a pending secret, it takes effect after ConfirmTOTP.
+func (h *accountHandler) EnrollTOTP(ctx context.Context, req *uaa.UIDReq) (*uaa.EnrollTOTPResp, error) {
+	if err := validateUIDReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, UserNotFoundErr
+	}
+	if acc.TwoFactorEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	_, err = h.updateAccountState(acc.UID, map[string]interface{}{
+		"pending_totp_secret": secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &uaa.EnrollTOTPResp{
+		Secret: secret,
+		Uri:    totp.URI(TOTPIssuer, acc.Username, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables two factor once user proves the pending secret works.
+func (h *accountHandler) ConfirmTOTP(ctx context.Context, req *uaa.TOTPCodeReq) (*uaa.RecoveryCodesResp, error) {
+	if err := validateTOTPCodeReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.repo.FindOne(req.GetUid())
+	if err != nil {
+		log.Error(err)
+		return nil, UserNotFoundErr
+	}
+	if acc.TwoFactorEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	} else if acc.PendingTOTPSecret == "" {
+		return nil, ErrTOTPNotEnrolled
+	}
+
+	counter, ok := totp.Validate(acc.PendingTOTPSecret, req.GetCode(), time.Now(), TOTPSkew)
+	if !ok {
+		return nil, ErrTOTPCodeInvalid
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	_, err = h.updateAccountState(acc.UID, map[string]interface{}{
+		"two_factor_enabled":  true,
+		"totp_secret":         acc.PendingTOTPSecret,
+		"pending_totp_secret": "",
+		"totp_last_counter":   counter,
+		"recovery_codes":      hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &uaa.RecoveryCodesResp{
+		Codes: codes,
+	}, nil
+}
+
+// VerifyTOTP is the second step of login for accounts with two factor. The
+// challenge of the first step is used once, wrong codes count as login
+// failures and lock the account when there are too many.
+func (h *accountHandler) VerifyTOTP(ctx context.Context, req *uaa.TOTPCodeReq) (*uaa.Account, error) {
+	if err := validateVerifyTOTPReq(req); err != nil {
+		return nil, err
+	}
+	expireTime, err := ptypes.Timestamp(req.GetChallengeExpireTime())
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	used, err := h.revokedRepo.IsRevoked(req.GetChallengeJti())
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if used {
+		return nil, ErrTOTPChallengeUsed
+	}
+
+	acc, err := h.findTwoFactorAccount(req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLocked(acc); err != nil {
+		return nil, err
+	}
+	failureKey := twoFactorFailureKey(acc.UID)
+	if err := h.verifySecondFactor(acc, req.GetCode()); err != nil {
+		if err == ErrTOTPCodeInvalid {
+			ipKey := ""
+			if req.GetIp() != "" {
+				ipKey = "ip:" + req.GetIp()
+			}
+			h.loginFailed(ctx, failureKey, ipKey, acc)
+			h.recordLogin(ctx, false, acc.UID, req.GetIp(), map[string]string{"reason": "two factor"})
+		}
+		return nil, err
+	}
+
+	// Only one of concurrent replays of the challenge gets here
+	first, err := h.revokedRepo.InsertRevokedToken(&models.RevokedToken{
+		JTI:        req.GetChallengeJti(),
+		UID:        acc.UID,
+		ExpireTime: expireTime,
+		CreateDate: time.Now(),
+	})
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	} else if !first {
+		return nil, ErrTOTPChallengeUsed
+	}
+	if err := h.failureRepo.Reset(failureKey); err != nil {
+		log.Error(err)
+	}
+	h.recordLogin(ctx, true, acc.UID, req.GetIp(), map[string]string{"factor": "two factor"})
+
+	var resp uaa.Account
+	copyFromAccountToPBAccount(acc, &resp)
+	return &resp, nil
+}
+
+func (h *accountHandler) DisableTOTP(ctx context.Context, req *uaa.TOTPCodeReq) (*empty.Empty, error) {
+	if err := validateTOTPCodeReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.findTwoFactorAccount(req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.verifySecondFactor(acc, req.GetCode()); err != nil {
+		return nil, err
+	}
+
+	return h.updateAccountState(acc.UID, map[string]interface{}{
+		"two_factor_enabled":  false,
+		"totp_secret":         "",
+		"pending_totp_secret": "",
+		"totp_last_counter":   int64(0),
+		"recovery_codes":      []string{},
+	})
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes, old ones stop working.
+func (h *accountHandler) RegenerateRecoveryCodes(ctx context.Context,
+	req *uaa.TOTPCodeReq) (*uaa.RecoveryCodesResp, error) {
+	if err := validateTOTPCodeReq(req); err != nil {
+		return nil, err
+	}
+
+	acc, err := h.findTwoFactorAccount(req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.verifySecondFactor(acc, req.GetCode()); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		log.Error(err)
+		return nil, ErrInternal
+	}
+	_, err = h.updateAccountState(acc.UID, map[string]interface{}{
+		"recovery_codes": hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &uaa.RecoveryCodesResp{
+		Codes: codes,
+	}, nil
+}
@@ -1,7 +1,13 @@
 package uaa
 
 import (
+	"net/mail"
+	"net/url"
+	"teddy-backend/internal/components"
+	"teddy-backend/internal/gin_jwt"
 	"teddy-backend/internal/proto/uaa"
+	"teddy-backend/pkg/sms"
+	"time"
 )
 
 func validateRegisterNormalReq(req *uaa.RegisterNormalReq) error {
@@ -9,16 +15,45 @@ func validateRegisterNormalReq(req *uaa.RegisterNormalReq) error {
 		return ErrPasswordEmpty
 	} else if req.Username == "" {
 		return ErrUsernameEmpty
-	} else if len(req.Roles) == 0 {
-		return ErrRolesEmpty
 	} else {
 		if req.GetContact() == nil {
 			return ErrEmailOrPhoneEmpty
+		} else if x, ok := req.GetContact().(*uaa.RegisterNormalReq_Phone); ok && !sms.IsE164(x.Phone) {
+			return ErrPhoneInvalid
 		}
 	}
 	return nil
 }
 
+func validateRegisterOAuthReq(req *uaa.RegisterOAuthReq) error {
+	if req.OauthProvider == "" {
+		return ErrOAuthProviderEmpty
+	} else if req.OauthUID == "" {
+		return ErrOAuthUIDEmpty
+	}
+	return nil
+}
+
+func validateLinkOAuthReq(req *uaa.LinkOAuthReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.OauthProvider == "" {
+		return ErrOAuthProviderEmpty
+	} else if req.OauthUID == "" {
+		return ErrOAuthUIDEmpty
+	}
+	return nil
+}
+
+func validateUnlinkOAuthReq(req *uaa.UnlinkOAuthReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.OauthProvider == "" {
+		return ErrOAuthProviderEmpty
+	}
+	return nil
+}
+
 func validateGetOneReq(req *uaa.GetOneReq) error {
 	if req.Principal == "" {
 		return ErrUsernameEmpty
@@ -33,6 +68,22 @@ func validateUIDReq(req *uaa.UIDReq) error {
 	return nil
 }
 
+func validateLockAccountReq(req *uaa.LockAccountReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.LockedUntil != nil && req.LockedUntil.Seconds < time.Now().Unix() {
+		return ErrLockedUntilInvalid
+	}
+	return nil
+}
+
+func validateCredentialsExpiredReq(req *uaa.CredentialsExpiredReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	}
+	return nil
+}
+
 func validateVerifyPasswordReq(req *uaa.VerifyAccountReq) error {
 	if req.Principal == "" {
 		return ErrUsernameEmpty
@@ -61,3 +112,280 @@ func validateUpdateSignInReq(req *uaa.UpdateSignInReq) error {
 	}
 	return nil
 }
+
+func validateRefreshTokenReq(req *uaa.RefreshTokenReq) error {
+	if req.Token == "" {
+		return ErrRefreshTokenEmpty
+	}
+	return nil
+}
+
+func validateRevokeTokenReq(req *uaa.RevokeTokenReq) error {
+	if req.Jti == "" {
+		return ErrJTIEmpty
+	} else if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.ExpireTime == nil {
+		return ErrExpireTimeEmpty
+	}
+	return nil
+}
+
+func validateUseTokenReq(req *uaa.UseTokenReq) error {
+	if req.Jti == "" {
+		return ErrJTIEmpty
+	} else if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.ExpireTime == nil {
+		return ErrExpireTimeEmpty
+	}
+	return nil
+}
+
+func validateIsTokenRevokedReq(req *uaa.IsTokenRevokedReq) error {
+	if req.Jti == "" {
+		return ErrJTIEmpty
+	} else if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.IssuedAt == nil {
+		return ErrIssuedAtEmpty
+	}
+	return nil
+}
+
+func validateResetPasswordReq(req *uaa.ResetPasswordReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.NewPassword == "" {
+		return ErrNewPasswordEmpty
+	}
+	return nil
+}
+
+func validateCheckPasswordReq(req *uaa.CheckPasswordReq) error {
+	if req.Password == "" {
+		return ErrPasswordEmpty
+	}
+	return nil
+}
+
+func validateTOTPCodeReq(req *uaa.TOTPCodeReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.Code == "" {
+		return ErrTOTPCodeEmpty
+	}
+	return nil
+}
+
+func validateVerifyTOTPReq(req *uaa.TOTPCodeReq) error {
+	if err := validateTOTPCodeReq(req); err != nil {
+		return err
+	} else if req.ChallengeJti == "" || req.ChallengeExpireTime == nil {
+		return ErrTOTPChallengeEmpty
+	}
+	return nil
+}
+
+func validateGetProfileReq(req *uaa.GetProfileReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	}
+	return nil
+}
+
+func validateBatchGetProfilesReq(req *uaa.BatchGetProfilesReq) error {
+	if len(req.Uids) == 0 {
+		return ErrUIDsEmpty
+	} else if len(req.Uids) > MaxBatchProfiles {
+		return ErrTooManyUIDs
+	}
+	return nil
+}
+
+func validateUpdateProfileReq(req *uaa.UpdateProfileReq) error {
+	if req.Profile == nil {
+		return ErrProfileEmpty
+	} else if req.Profile.Uid == "" {
+		return ErrUsernameEmpty
+	} else if len(req.Fields) == 0 {
+		return ErrProfileFieldsEmpty
+	}
+	for _, field := range req.Fields {
+		if field != profilePrivateFields && !components.ContainsString(profileFields, field) {
+			return ErrProfileFieldInvalid
+		}
+	}
+	for _, field := range req.Profile.PrivateFields {
+		if !components.ContainsString(profileFields, field) {
+			return ErrProfileFieldInvalid
+		}
+	}
+	return nil
+}
+
+func validateIssueRefreshTokenReq(req *uaa.IssueRefreshTokenReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	}
+	return nil
+}
+
+func validateSessionReq(req *uaa.SessionReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.SessionId == "" {
+		return ErrSessionIDEmpty
+	}
+	return nil
+}
+
+func validateSetRolesReq(req *uaa.SetRolesReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if len(req.Roles) == 0 {
+		return ErrRolesEmpty
+	}
+	for _, role := range req.Roles {
+		if !rolePattern.MatchString(role) {
+			return ErrRoleInvalid
+		}
+	}
+	return nil
+}
+
+func validateCreateClientReq(req *uaa.CreateClientReq) error {
+	if req.Name == "" {
+		return ErrClientNameEmpty
+	} else if len(req.Scopes) == 0 {
+		return ErrRolesEmpty
+	}
+	for _, scope := range req.Scopes {
+		if !rolePattern.MatchString(scope) {
+			return ErrRoleInvalid
+		}
+	}
+	for _, uri := range req.RedirectUris {
+		if !isRedirectURI(uri) {
+			return ErrRedirectURIInvalid
+		}
+	}
+	return nil
+}
+
+// isRedirectURI accepts absolute https urls without fragment, http only for
+// localhost while developing.
+func isRedirectURI(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" || u.Fragment != "" {
+		return false
+	}
+	return u.Scheme == "https" || (u.Scheme == "http" && u.Hostname() == "localhost")
+}
+
+func validateClientIDReq(req *uaa.ClientIDReq) error {
+	if req.ClientId == "" {
+		return ErrClientIDEmpty
+	}
+	return nil
+}
+
+func validateVerifyClientReq(req *uaa.VerifyClientReq) error {
+	if req.ClientId == "" {
+		return ErrClientIDEmpty
+	} else if req.Secret == "" {
+		return ErrClientSecretEmpty
+	}
+	return nil
+}
+
+func validateCreatePersonalTokenReq(req *uaa.CreatePersonalTokenReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.Name == "" {
+		return ErrPersonalTokenNameEmpty
+	} else if len(req.Scopes) == 0 {
+		return ErrPersonalTokenScopesEmpty
+	}
+	// Never roles, which would let a token do all the account does
+	for _, scope := range req.Scopes {
+		if !components.ContainsString(gin_jwt.PersonalTokenScopes, scope) {
+			return ErrPersonalTokenScopeInvalid
+		}
+	}
+	return nil
+}
+
+func validatePersonalTokenReq(req *uaa.PersonalTokenReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.Id == "" {
+		return ErrPersonalTokenIDEmpty
+	}
+	return nil
+}
+
+func validateVerifyPersonalTokenReq(req *uaa.VerifyPersonalTokenReq) error {
+	if req.Value == "" {
+		return ErrPersonalTokenEmpty
+	}
+	return nil
+}
+
+// sortableAccountFields are the indexed fields GetAll may sort by.
+var sortableAccountFields = []string{"username", "email", "create_date", "update_date", "last_sign_in_time"}
+
+func validateGetAllReq(req *uaa.GetAllReq) error {
+	for _, sort := range req.Sorts {
+		if sort == nil || !components.ContainsString(sortableAccountFields, sort.Name) {
+			return ErrSortInvalid
+		}
+	}
+	if req.Role != "" && !rolePattern.MatchString(req.Role) {
+		return ErrRoleInvalid
+	}
+	return nil
+}
+
+// isEmail accepts a bare address only, no display name.
+func isEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+func validateVerifyEmailReq(req *uaa.VerifyEmailReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.Email == "" {
+		return ErrEmailEmpty
+	}
+	return nil
+}
+
+func validateStartEmailChangeReq(req *uaa.StartEmailChangeReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	} else if req.Password == "" {
+		return ErrPasswordEmpty
+	} else if req.Email == "" {
+		return ErrEmailEmpty
+	} else if !isEmail(req.Email) {
+		return ErrEmailInvalid
+	}
+	return nil
+}
+
+// Password is checked by the account, accounts from oauth may have none
+func validateRequestDeletionReq(req *uaa.RequestDeletionReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	}
+	return nil
+}
+
+func validateConfirmEmailChangeReq(req *uaa.ConfirmEmailChangeReq) error {
+	if req.Uid == "" {
+		return ErrUsernameEmpty
+	}
+	return nil
+}
@@ -15,6 +15,9 @@ type Sort struct {
 type Server struct {
 	Address string `json:"address" mapstructure:"address"`
 	Port    int    `json:"port" mapstructure:"port"`
+	// Header the proxy in front sets to the ip of clients, e.g.
+	// "X-Forwarded-For", empty to take the ip of the connection
+	TrustedIPHeader string `json:"trusted_ip_header" mapstructure:"trusted_ip_header"`
 }
 
 type Mail struct {
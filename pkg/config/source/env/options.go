@@ -2,7 +2,7 @@ package env
 
 import (
 	"strings"
-	"teddy-backend/internal/config"
+	"teddy-backend/pkg/config"
 )
 
 type strippedPrefixKey struct{}
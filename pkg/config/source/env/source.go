@@ -4,7 +4,7 @@ import (
 	"github.com/imdario/mergo"
 	"os"
 	"strings"
-	"teddy-backend/internal/config"
+	"teddy-backend/pkg/config"
 	"time"
 )
 
@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: policy.proto
+// source: teddy-backend/pkg/grpcadapter/policy.proto
 
 package grpcadapter
 
@@ -36,7 +36,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_edd0b7aa982d151a, []int{0}
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{0}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policy.Unmarshal(m, b)
@@ -81,7 +81,7 @@ func (m *Policies) Reset()         { *m = Policies{} }
 func (m *Policies) String() string { return proto.CompactTextString(m) }
 func (*Policies) ProtoMessage()    {}
 func (*Policies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_edd0b7aa982d151a, []int{1}
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{1}
 }
 func (m *Policies) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policies.Unmarshal(m, b)
@@ -121,7 +121,7 @@ func (m *AddPolicyReq) Reset()         { *m = AddPolicyReq{} }
 func (m *AddPolicyReq) String() string { return proto.CompactTextString(m) }
 func (*AddPolicyReq) ProtoMessage()    {}
 func (*AddPolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_edd0b7aa982d151a, []int{2}
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{2}
 }
 func (m *AddPolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddPolicyReq.Unmarshal(m, b)
@@ -175,7 +175,7 @@ func (m *RemovePolicyReq) Reset()         { *m = RemovePolicyReq{} }
 func (m *RemovePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*RemovePolicyReq) ProtoMessage()    {}
 func (*RemovePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_edd0b7aa982d151a, []int{3}
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{3}
 }
 func (m *RemovePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePolicyReq.Unmarshal(m, b)
@@ -230,7 +230,7 @@ func (m *RemoveFilteredPolicyReq) Reset()         { *m = RemoveFilteredPolicyReq
 func (m *RemoveFilteredPolicyReq) String() string { return proto.CompactTextString(m) }
 func (*RemoveFilteredPolicyReq) ProtoMessage()    {}
 func (*RemoveFilteredPolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_edd0b7aa982d151a, []int{4}
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{4}
 }
 func (m *RemoveFilteredPolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveFilteredPolicyReq.Unmarshal(m, b)
@@ -278,12 +278,131 @@ func (m *RemoveFilteredPolicyReq) GetFieldValues() []string {
 	return nil
 }
 
+type GetFilteredPolicyReq struct {
+	Ptype      string `protobuf:"bytes,1,opt,name=ptype,proto3" json:"ptype,omitempty"`
+	FieldIndex int64  `protobuf:"varint,2,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
+	// Empty value matches any
+	FieldValues          []string `protobuf:"bytes,3,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFilteredPolicyReq) Reset()         { *m = GetFilteredPolicyReq{} }
+func (m *GetFilteredPolicyReq) String() string { return proto.CompactTextString(m) }
+func (*GetFilteredPolicyReq) ProtoMessage()    {}
+func (*GetFilteredPolicyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{5}
+}
+func (m *GetFilteredPolicyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFilteredPolicyReq.Unmarshal(m, b)
+}
+func (m *GetFilteredPolicyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFilteredPolicyReq.Marshal(b, m, deterministic)
+}
+func (dst *GetFilteredPolicyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFilteredPolicyReq.Merge(dst, src)
+}
+func (m *GetFilteredPolicyReq) XXX_Size() int {
+	return xxx_messageInfo_GetFilteredPolicyReq.Size(m)
+}
+func (m *GetFilteredPolicyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFilteredPolicyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFilteredPolicyReq proto.InternalMessageInfo
+
+func (m *GetFilteredPolicyReq) GetPtype() string {
+	if m != nil {
+		return m.Ptype
+	}
+	return ""
+}
+
+func (m *GetFilteredPolicyReq) GetFieldIndex() int64 {
+	if m != nil {
+		return m.FieldIndex
+	}
+	return 0
+}
+
+func (m *GetFilteredPolicyReq) GetFieldValues() []string {
+	if m != nil {
+		return m.FieldValues
+	}
+	return nil
+}
+
+type UpdatePolicyReq struct {
+	Sec                  string   `protobuf:"bytes,1,opt,name=sec,proto3" json:"sec,omitempty"`
+	Ptype                string   `protobuf:"bytes,2,opt,name=ptype,proto3" json:"ptype,omitempty"`
+	OldRule              []string `protobuf:"bytes,3,rep,name=oldRule,proto3" json:"oldRule,omitempty"`
+	NewRule              []string `protobuf:"bytes,4,rep,name=newRule,proto3" json:"newRule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdatePolicyReq) Reset()         { *m = UpdatePolicyReq{} }
+func (m *UpdatePolicyReq) String() string { return proto.CompactTextString(m) }
+func (*UpdatePolicyReq) ProtoMessage()    {}
+func (*UpdatePolicyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_311f2ca14a3e067c, []int{6}
+}
+func (m *UpdatePolicyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdatePolicyReq.Unmarshal(m, b)
+}
+func (m *UpdatePolicyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdatePolicyReq.Marshal(b, m, deterministic)
+}
+func (dst *UpdatePolicyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePolicyReq.Merge(dst, src)
+}
+func (m *UpdatePolicyReq) XXX_Size() int {
+	return xxx_messageInfo_UpdatePolicyReq.Size(m)
+}
+func (m *UpdatePolicyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePolicyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePolicyReq proto.InternalMessageInfo
+
+func (m *UpdatePolicyReq) GetSec() string {
+	if m != nil {
+		return m.Sec
+	}
+	return ""
+}
+
+func (m *UpdatePolicyReq) GetPtype() string {
+	if m != nil {
+		return m.Ptype
+	}
+	return ""
+}
+
+func (m *UpdatePolicyReq) GetOldRule() []string {
+	if m != nil {
+		return m.OldRule
+	}
+	return nil
+}
+
+func (m *UpdatePolicyReq) GetNewRule() []string {
+	if m != nil {
+		return m.NewRule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Policy)(nil), "grpcadapter.Policy")
 	proto.RegisterType((*Policies)(nil), "grpcadapter.Policies")
 	proto.RegisterType((*AddPolicyReq)(nil), "grpcadapter.AddPolicyReq")
 	proto.RegisterType((*RemovePolicyReq)(nil), "grpcadapter.RemovePolicyReq")
 	proto.RegisterType((*RemoveFilteredPolicyReq)(nil), "grpcadapter.RemoveFilteredPolicyReq")
+	proto.RegisterType((*GetFilteredPolicyReq)(nil), "grpcadapter.GetFilteredPolicyReq")
+	proto.RegisterType((*UpdatePolicyReq)(nil), "grpcadapter.UpdatePolicyReq")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -303,6 +422,10 @@ type PolicyAdapterClient interface {
 	AddPolicy(ctx context.Context, in *AddPolicyReq, opts ...grpc.CallOption) (*empty.Empty, error)
 	RemovePolicy(ctx context.Context, in *RemovePolicyReq, opts ...grpc.CallOption) (*empty.Empty, error)
 	RemoveFilteredPolicy(ctx context.Context, in *RemoveFilteredPolicyReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	// For administration, policies are changed in storage and picked up by
+	// enforcers on their next load
+	GetFilteredPolicy(ctx context.Context, in *GetFilteredPolicyReq, opts ...grpc.CallOption) (*Policies, error)
+	UpdatePolicy(ctx context.Context, in *UpdatePolicyReq, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type policyAdapterClient struct {
@@ -358,6 +481,24 @@ func (c *policyAdapterClient) RemoveFilteredPolicy(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *policyAdapterClient) GetFilteredPolicy(ctx context.Context, in *GetFilteredPolicyReq, opts ...grpc.CallOption) (*Policies, error) {
+	out := new(Policies)
+	err := c.cc.Invoke(ctx, "/grpcadapter.PolicyAdapter/getFilteredPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyAdapterClient) UpdatePolicy(ctx context.Context, in *UpdatePolicyReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/grpcadapter.PolicyAdapter/updatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PolicyAdapterServer is the server API for PolicyAdapter service.
 type PolicyAdapterServer interface {
 	LoadPolicy(context.Context, *empty.Empty) (*Policies, error)
@@ -365,6 +506,10 @@ type PolicyAdapterServer interface {
 	AddPolicy(context.Context, *AddPolicyReq) (*empty.Empty, error)
 	RemovePolicy(context.Context, *RemovePolicyReq) (*empty.Empty, error)
 	RemoveFilteredPolicy(context.Context, *RemoveFilteredPolicyReq) (*empty.Empty, error)
+	// For administration, policies are changed in storage and picked up by
+	// enforcers on their next load
+	GetFilteredPolicy(context.Context, *GetFilteredPolicyReq) (*Policies, error)
+	UpdatePolicy(context.Context, *UpdatePolicyReq) (*empty.Empty, error)
 }
 
 func RegisterPolicyAdapterServer(s *grpc.Server, srv PolicyAdapterServer) {
@@ -461,6 +606,42 @@ func _PolicyAdapter_RemoveFilteredPolicy_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PolicyAdapter_GetFilteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilteredPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyAdapterServer).GetFilteredPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcadapter.PolicyAdapter/GetFilteredPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyAdapterServer).GetFilteredPolicy(ctx, req.(*GetFilteredPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyAdapter_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyAdapterServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcadapter.PolicyAdapter/UpdatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyAdapterServer).UpdatePolicy(ctx, req.(*UpdatePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PolicyAdapter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "grpcadapter.PolicyAdapter",
 	HandlerType: (*PolicyAdapterServer)(nil),
@@ -485,36 +666,52 @@ var _PolicyAdapter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "removeFilteredPolicy",
 			Handler:    _PolicyAdapter_RemoveFilteredPolicy_Handler,
 		},
+		{
+			MethodName: "getFilteredPolicy",
+			Handler:    _PolicyAdapter_GetFilteredPolicy_Handler,
+		},
+		{
+			MethodName: "updatePolicy",
+			Handler:    _PolicyAdapter_UpdatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "policy.proto",
-}
-
-func init() { proto.RegisterFile("policy.proto", fileDescriptor_policy_edd0b7aa982d151a) }
-
-var fileDescriptor_policy_edd0b7aa982d151a = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0xc6, 0x9b, 0xa6, 0x6f, 0x69, 0xa7, 0x7d, 0x79, 0x5f, 0xc6, 0xaa, 0xb1, 0x88, 0x84, 0xe0,
-	0x21, 0xa7, 0x04, 0xda, 0xa3, 0x08, 0xf6, 0x60, 0x41, 0x41, 0x90, 0x1c, 0x7a, 0x4f, 0x93, 0x69,
-	0x0c, 0x6c, 0xbb, 0x6b, 0xfe, 0x88, 0x3d, 0xfb, 0x81, 0xfd, 0x0a, 0x92, 0xdd, 0x54, 0x53, 0x9b,
-	0xf6, 0x20, 0x5e, 0x96, 0xd9, 0x61, 0xe6, 0x37, 0x0f, 0xcf, 0x03, 0x7d, 0xc1, 0x59, 0x1c, 0xac,
-	0x1d, 0x91, 0xf0, 0x8c, 0x63, 0x2f, 0x4a, 0x44, 0xe0, 0x87, 0xbe, 0xc8, 0x28, 0x19, 0x8e, 0xa3,
-	0x38, 0x7b, 0xca, 0xe7, 0x4e, 0xc0, 0x97, 0x6e, 0xc4, 0x99, 0xbf, 0x8a, 0x5c, 0x39, 0x35, 0xcf,
-	0x17, 0xae, 0xc8, 0xd6, 0x82, 0x52, 0x97, 0x96, 0x22, 0x5b, 0xab, 0x57, 0x11, 0xac, 0x11, 0xb4,
-	0x1f, 0x25, 0x11, 0x07, 0xf0, 0x47, 0x4e, 0x19, 0x9a, 0xa9, 0xd9, 0x5d, 0x4f, 0x7d, 0x10, 0xa1,
-	0x95, 0xe4, 0x8c, 0x8c, 0xa6, 0xa9, 0xdb, 0x5d, 0x4f, 0xd6, 0xd6, 0x15, 0x74, 0xe4, 0x4e, 0x4c,
-	0x29, 0xba, 0xd0, 0x11, 0x65, 0x6d, 0x68, 0xa6, 0x6e, 0xf7, 0x46, 0x47, 0x4e, 0x45, 0x94, 0xa3,
-	0xe0, 0xde, 0xe7, 0x90, 0x75, 0x0f, 0xfd, 0x49, 0x18, 0x96, 0x6d, 0x7a, 0xc6, 0xff, 0xa0, 0xa7,
-	0x14, 0x94, 0x47, 0x8b, 0xf2, 0x4b, 0x48, 0xb3, 0x4e, 0x88, 0x5e, 0x11, 0xf2, 0x00, 0xff, 0x3c,
-	0x5a, 0xf2, 0x17, 0xfa, 0x1d, 0xdc, 0x9b, 0x06, 0xa7, 0x8a, 0x37, 0x8d, 0x59, 0x46, 0x09, 0xfd,
-	0x40, 0xe6, 0x05, 0xc0, 0x22, 0x26, 0x16, 0xde, 0xad, 0x42, 0x7a, 0x35, 0x74, 0x53, 0xb3, 0x75,
-	0xaf, 0xd2, 0x41, 0x13, 0x7a, 0xf2, 0x37, 0xf3, 0x59, 0x4e, 0xa9, 0xd1, 0x92, 0xe7, 0xab, 0xad,
-	0xd1, 0x7b, 0x13, 0xfe, 0xaa, 0xbb, 0x13, 0xe5, 0x21, 0x5e, 0x03, 0x30, 0xee, 0x97, 0x62, 0xf0,
-	0xc4, 0x89, 0x38, 0x8f, 0x18, 0x39, 0x9b, 0x70, 0x9d, 0xdb, 0x22, 0xcf, 0xe1, 0xf1, 0xae, 0xef,
-	0x85, 0xdf, 0x8d, 0x62, 0x3d, 0xf5, 0x37, 0x1e, 0x61, 0xfd, 0xd8, 0x70, 0x0f, 0xd5, 0x6a, 0xe0,
-	0x0d, 0x74, 0xfd, 0x4d, 0x60, 0x78, 0xb6, 0xb5, 0x5d, 0x0d, 0xf2, 0x00, 0x61, 0x0a, 0xfd, 0xa4,
-	0x12, 0x13, 0x9e, 0x6f, 0x41, 0xbe, 0x25, 0x78, 0x80, 0x33, 0x83, 0x41, 0x52, 0x13, 0x0f, 0x5e,
-	0xd6, 0xf0, 0x76, 0x12, 0xdc, 0xcf, 0x9d, 0xb7, 0x65, 0x67, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff,
-	0x60, 0x14, 0x50, 0x09, 0x5c, 0x03, 0x00, 0x00,
+	Metadata: "teddy-backend/pkg/grpcadapter/policy.proto",
+}
+
+func init() {
+	proto.RegisterFile("teddy-backend/pkg/grpcadapter/policy.proto", fileDescriptor_policy_311f2ca14a3e067c)
+}
+
+var fileDescriptor_policy_311f2ca14a3e067c = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0x97, 0xa6, 0x8c, 0xf5, 0xb5, 0x68, 0x60, 0x0a, 0x84, 0x0a, 0xa1, 0x10, 0x71, 0xa8,
+	0x90, 0x48, 0xa4, 0xee, 0x88, 0x90, 0xd8, 0x81, 0x22, 0x90, 0x10, 0x28, 0x12, 0xbb, 0xbb, 0xf1,
+	0x9b, 0x89, 0xe6, 0xc6, 0x26, 0x71, 0x80, 0x9e, 0xb9, 0xf0, 0x67, 0xa3, 0xd8, 0x0d, 0x73, 0xdb,
+	0xb4, 0x48, 0x13, 0x97, 0xc8, 0xef, 0xf9, 0xf9, 0xfb, 0x3e, 0x3b, 0x3f, 0x78, 0xa1, 0x91, 0xb1,
+	0xd5, 0xcb, 0x05, 0xcd, 0xae, 0xb0, 0x60, 0x89, 0xba, 0xe2, 0x09, 0x2f, 0x55, 0x46, 0x19, 0x55,
+	0x1a, 0xcb, 0x44, 0x49, 0x91, 0x67, 0xab, 0x58, 0x95, 0x52, 0x4b, 0x32, 0x74, 0x76, 0x26, 0x67,
+	0x3c, 0xd7, 0x5f, 0xeb, 0x45, 0x9c, 0xc9, 0x65, 0xc2, 0xa5, 0xa0, 0x05, 0x4f, 0xcc, 0xd4, 0xa2,
+	0xbe, 0x4c, 0x94, 0x5e, 0x29, 0xac, 0x12, 0x5c, 0x2a, 0xbd, 0xb2, 0x5f, 0xab, 0x10, 0xcd, 0xe0,
+	0xf8, 0xb3, 0x51, 0x24, 0x63, 0xb8, 0x65, 0xa6, 0x02, 0x2f, 0xf4, 0xa6, 0x83, 0xd4, 0x16, 0x84,
+	0x40, 0xbf, 0xac, 0x05, 0x06, 0xbd, 0xd0, 0x9f, 0x0e, 0x52, 0xb3, 0x8e, 0x5e, 0xc1, 0x89, 0x39,
+	0x93, 0x63, 0x45, 0x12, 0x38, 0x51, 0xeb, 0x75, 0xe0, 0x85, 0xfe, 0x74, 0x38, 0xbb, 0x1f, 0x3b,
+	0xa1, 0x62, 0x2b, 0x9e, 0xfe, 0x1d, 0x8a, 0x3e, 0xc0, 0xe8, 0x9c, 0xb1, 0x75, 0x1b, 0xbf, 0x91,
+	0xbb, 0xe0, 0x57, 0x98, 0xad, 0x4d, 0x9b, 0xe5, 0x75, 0x90, 0x5e, 0x57, 0x10, 0xdf, 0x09, 0xf2,
+	0x11, 0x4e, 0x53, 0x5c, 0xca, 0xef, 0xf8, 0x7f, 0xe4, 0x7e, 0x79, 0xf0, 0xc8, 0xea, 0xcd, 0x73,
+	0xa1, 0xb1, 0xc4, 0x1b, 0xc4, 0x7c, 0x0a, 0x70, 0x99, 0xa3, 0x60, 0xef, 0x0b, 0x86, 0x3f, 0x03,
+	0x3f, 0xf4, 0xa6, 0x7e, 0xea, 0x74, 0x48, 0x08, 0x43, 0x53, 0x5d, 0x50, 0x51, 0x63, 0x15, 0xf4,
+	0x8d, 0xbd, 0xdb, 0x8a, 0x0a, 0x18, 0xbf, 0x43, 0xbd, 0x9b, 0xa0, 0xfb, 0xff, 0x6c, 0xfa, 0xf5,
+	0xfe, 0xe5, 0xe7, 0xef, 0xfa, 0x2d, 0xe1, 0xf4, 0x8b, 0x62, 0x54, 0xdf, 0xe0, 0x11, 0x03, 0xb8,
+	0x2d, 0x05, 0x4b, 0xaf, 0xdf, 0xb1, 0x2d, 0x9b, 0x9d, 0x02, 0x7f, 0x98, 0x1d, 0x7b, 0xc5, 0xb6,
+	0x9c, 0xfd, 0xee, 0xc3, 0x1d, 0xeb, 0x74, 0x6e, 0x11, 0x21, 0xaf, 0x01, 0x84, 0xa4, 0xeb, 0x9b,
+	0x92, 0x87, 0x31, 0x97, 0x92, 0x0b, 0x8c, 0x5b, 0x76, 0xe3, 0xb7, 0x0d, 0xae, 0x93, 0x07, 0xbb,
+	0x58, 0x35, 0x38, 0x1d, 0x35, 0xc7, 0x2b, 0xda, 0x22, 0x40, 0xba, 0xc7, 0x26, 0x7b, 0x54, 0xa3,
+	0x23, 0xf2, 0x06, 0x06, 0xb4, 0xe5, 0x91, 0x3c, 0xde, 0x38, 0xed, 0x72, 0x7a, 0x40, 0x61, 0x0e,
+	0xa3, 0xd2, 0xa1, 0x90, 0x3c, 0xd9, 0x10, 0xd9, 0x02, 0xf4, 0x80, 0xce, 0x05, 0x8c, 0xcb, 0x0e,
+	0xfa, 0xc8, 0xf3, 0x0e, 0xbd, 0x1d, 0x3c, 0x0e, 0xe8, 0x7e, 0x82, 0x7b, 0x7c, 0x1b, 0x28, 0xf2,
+	0x6c, 0x43, 0xb4, 0x0b, 0xb8, 0xfd, 0x2f, 0x3e, 0x87, 0x51, 0xed, 0x10, 0xb3, 0x75, 0xe1, 0x2d,
+	0x98, 0xf6, 0x07, 0x5b, 0x1c, 0x9b, 0xce, 0xd9, 0x9f, 0x01, 0x00, 0x51, 0x0b, 0xf9, 0x3a, 0xf2,
+	0x04, 0x00, 0x00,
 }
@@ -0,0 +1,64 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+)
+
+// CenterSquare crops the largest centered square out of img.
+func CenterSquare(img image.Image) image.Rectangle {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return image.Rect(x0, y0, x0+side, y0+side)
+}
+
+// Square crops img to its centered square and scales it to size x size.
+// Shrinking averages every source pixel that falls into a destination
+// pixel, enlarging repeats the nearest one.
+func Square(img image.Image, size int) *image.RGBA {
+	src := CenterSquare(img)
+	side := src.Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if side == 0 || size <= 0 {
+		return dst
+	}
+
+	for y := 0; y < size; y++ {
+		sy0 := src.Min.Y + y*side/size
+		sy1 := src.Min.Y + (y+1)*side/size
+		if sy1 <= sy0 {
+			sy1 = sy0 + 1
+		}
+		for x := 0; x < size; x++ {
+			sx0 := src.Min.X + x*side/size
+			sx1 := src.Min.X + (x+1)*side/size
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
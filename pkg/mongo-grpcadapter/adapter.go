@@ -7,6 +7,9 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/mongodb/mongo-go-driver/bson"
 	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/mongodb/mongo-go-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"teddy-backend/pkg/grpcadapter"
 )
 
@@ -158,9 +161,11 @@ func (a *adapter) SavePolicy(ctx context.Context, req *grpcadapter.Policies) (*e
 	return &empty.Empty{}, nil
 }
 
+// AddPolicy doesn't duplicate an existing rule.
 func (a *adapter) AddPolicy(ctx context.Context, req *grpcadapter.AddPolicyReq) (*empty.Empty, error) {
 	line := savePolicyLine(req.Ptype, req.Rule)
-	_, err := a.collection.InsertOne(ctx, line)
+	update := bson.D{{"$setOnInsert", line}}
+	_, err := a.collection.UpdateOne(ctx, ruleFilter(req.Ptype, req.Rule), update, options.Update().SetUpsert(true))
 	if err != nil {
 		return nil, err
 	}
@@ -188,47 +193,94 @@ func (a *adapter) RemovePolicy(ctx context.Context, req *grpcadapter.RemovePolic
 	return &empty.Empty{}, nil
 }
 
-func (a *adapter) RemoveFilteredPolicy(ctx context.Context, req *grpcadapter.RemoveFilteredPolicyReq) (*empty.Empty, error) {
-	fieldIndex := req.FieldIndex
-	fieldValues := req.FieldValues
+// policyFilter matches rules whose fields from fieldIndex on equal
+// fieldValues, empty values match any.
+func policyFilter(ptype string, fieldIndex int64, fieldValues []string) bson.D {
 	filter := bson.D{
-		{"ptype", req.Ptype},
+		{"ptype", ptype},
 	}
 
-	if fieldIndex <= 0 && 0 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[0-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v0", Value: fieldValues[0-fieldIndex]})
+	for i := int64(0); i < 6; i++ {
+		if fieldIndex <= i && i < fieldIndex+int64(len(fieldValues)) {
+			if fieldValues[i-fieldIndex] != "" {
+				filter = append(filter, bson.E{Key: fmt.Sprintf("v%d", i), Value: fieldValues[i-fieldIndex]})
+			}
 		}
 	}
-	if fieldIndex <= 1 && 1 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[1-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v1", Value: fieldValues[0-fieldIndex]})
-		}
+	return filter
+}
+
+// ruleFilter matches exactly the rule, unused fields may be empty or missing.
+func ruleFilter(ptype string, rule []string) bson.D {
+	line := savePolicyLine(ptype, rule)
+	filter := bson.D{
+		{"ptype", line.PType},
 	}
-	if fieldIndex <= 2 && 2 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[2-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v2", Value: fieldValues[0-fieldIndex]})
+	for i, v := range []string{line.V0, line.V1, line.V2, line.V3, line.V4, line.V5} {
+		key := fmt.Sprintf("v%d", i)
+		if v == "" {
+			filter = append(filter, bson.E{Key: key, Value: bson.D{{"$in", bson.A{"", nil}}}})
+		} else {
+			filter = append(filter, bson.E{Key: key, Value: v})
 		}
 	}
-	if fieldIndex <= 3 && 3 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[3-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v3", Value: fieldValues[0-fieldIndex]})
-		}
+	return filter
+}
+
+func (a *adapter) RemoveFilteredPolicy(ctx context.Context, req *grpcadapter.RemoveFilteredPolicyReq) (*empty.Empty, error) {
+	filter := policyFilter(req.Ptype, req.FieldIndex, req.FieldValues)
+
+	_, err := a.collection.DeleteMany(context.Background(), filter)
+	if err != nil {
+		return nil, err
 	}
-	if fieldIndex <= 4 && 4 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[4-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v4", Value: fieldValues[0-fieldIndex]})
-		}
+	return &empty.Empty{}, nil
+}
+
+func (a *adapter) GetFilteredPolicy(ctx context.Context, req *grpcadapter.GetFilteredPolicyReq) (*grpcadapter.Policies, error) {
+	var policies []*grpcadapter.Policy
+
+	cur, err := a.collection.Find(ctx, policyFilter(req.Ptype, req.FieldIndex, req.FieldValues))
+	if err != nil {
+		return nil, err
 	}
-	if fieldIndex <= 5 && 5 < fieldIndex+int64(len(fieldValues)) {
-		if fieldValues[5-fieldIndex] != "" {
-			filter = append(filter, bson.E{Key: "v5", Value: fieldValues[0-fieldIndex]})
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		rule := CasbinRule{}
+		err = cur.Decode(&rule)
+		if err != nil {
+			return nil, err
 		}
+		policies = append(policies, &grpcadapter.Policy{
+			Ptype: rule.PType,
+			Rule:  trimRule([]string{rule.V0, rule.V1, rule.V2, rule.V3, rule.V4, rule.V5}),
+		})
 	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	return &grpcadapter.Policies{
+		Policies: policies,
+	}, nil
+}
 
-	_, err := a.collection.DeleteMany(context.Background(), filter)
+// UpdatePolicy replaces oldRule by newRule, it fails with codes.NotFound when
+// oldRule doesn't exist.
+func (a *adapter) UpdatePolicy(ctx context.Context, req *grpcadapter.UpdatePolicyReq) (*empty.Empty, error) {
+	line := savePolicyLine(req.Ptype, req.NewRule)
+	ur, err := a.collection.ReplaceOne(ctx, ruleFilter(req.Ptype, req.OldRule), line)
 	if err != nil {
 		return nil, err
+	} else if ur.MatchedCount == 0 {
+		return nil, status.Error(codes.NotFound, "policy not found")
 	}
 	return &empty.Empty{}, nil
 }
+
+// trimRule drops the empty trailing fields of a stored rule.
+func trimRule(rule []string) []string {
+	for len(rule) > 0 && rule[len(rule)-1] == "" {
+		rule = rule[:len(rule)-1]
+	}
+	return rule
+}
@@ -0,0 +1,17 @@
+package oauth
+
+import "errors"
+
+var (
+	ErrDriverNotFound = errors.New("oauth driver not found")
+
+	ErrMissingClientID = errors.New("oauth client id is missing")
+
+	ErrMissingEndpoint = errors.New("oauth endpoint is missing")
+
+	ErrExchangeFailed = errors.New("oauth code exchange failed")
+
+	ErrUserInfoFailed = errors.New("oauth fetch user info failed")
+
+	ErrUserIDMissing = errors.New("oauth user id is missing")
+)
@@ -0,0 +1,175 @@
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultHTTPTimeout = 10 * time.Second
+
+type genericProvider struct {
+	name   string
+	config Config
+	client *http.Client
+}
+
+// NewGenericProvider is a driver for servers that follow RFC 6749 and expose
+// the user as a JSON document on the user info endpoint.
+func NewGenericProvider(name string, config Config) (Provider, error) {
+	if config.ClientID == "" {
+		return nil, ErrMissingClientID
+	}
+
+	if config.AuthURL == "" || config.TokenURL == "" || config.UserInfoURL == "" {
+		return nil, ErrMissingEndpoint
+	}
+
+	if config.IDField == "" {
+		config.IDField = "id"
+	}
+
+	if config.UsernameField == "" {
+		config.UsernameField = "name"
+	}
+
+	if config.EmailField == "" {
+		config.EmailField = "email"
+	}
+
+	if config.EmailVerifiedField == "" {
+		config.EmailVerifiedField = "email_verified"
+	}
+
+	return &genericProvider{
+		name:   name,
+		config: config,
+		client: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+func (p *genericProvider) Name() string {
+	return p.name
+}
+
+func (p *genericProvider) AuthCodeURL(state string) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.config.ClientID},
+		"state":         {state},
+	}
+	if p.config.RedirectURL != "" {
+		v.Set("redirect_uri", p.config.RedirectURL)
+	}
+	if len(p.config.Scopes) > 0 {
+		v.Set("scope", strings.Join(p.config.Scopes, " "))
+	}
+
+	if strings.Contains(p.config.AuthURL, "?") {
+		return p.config.AuthURL + "&" + v.Encode()
+	}
+	return p.config.AuthURL + "?" + v.Encode()
+}
+
+func (p *genericProvider) Exchange(ctx context.Context, code string) (*Token, error) {
+	v := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+	}
+	if p.config.RedirectURL != "" {
+		v.Set("redirect_uri", p.config.RedirectURL)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.config.TokenURL, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	body, err := p.do(ctx, req)
+	if err != nil {
+		return nil, ErrExchangeFailed
+	}
+
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, ErrExchangeFailed
+	}
+	if token.AccessToken == "" {
+		return nil, ErrExchangeFailed
+	}
+	return &token, nil
+}
+
+func (p *genericProvider) UserInfo(ctx context.Context, token *Token) (*UserInfo, error) {
+	req, err := http.NewRequest(http.MethodGet, p.config.UserInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+
+	body, err := p.do(ctx, req)
+	if err != nil {
+		return nil, ErrUserInfoFailed
+	}
+
+	fields := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, ErrUserInfoFailed
+	}
+
+	info := &UserInfo{
+		ID:       stringField(fields, p.config.IDField),
+		Username: stringField(fields, p.config.UsernameField),
+		Email:    stringField(fields, p.config.EmailField),
+		// Some providers send it as string
+		EmailVerified: stringField(fields, p.config.EmailVerifiedField) == "true",
+	}
+	if info.ID == "" {
+		return nil, ErrUserIDMissing
+	}
+	return info, nil
+}
+
+func (p *genericProvider) do(ctx context.Context, req *http.Request) ([]byte, error) {
+	res, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oauth server %s response status %d", req.URL.Host, res.StatusCode)
+	}
+	return body, nil
+}
+
+func stringField(fields map[string]interface{}, key string) string {
+	switch v := fields[key].(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
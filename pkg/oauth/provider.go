@@ -0,0 +1,93 @@
+package oauth
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+}
+
+type UserInfo struct {
+	ID       string
+	Username string
+	Email    string
+	// Provider has verified the user owns Email
+	EmailVerified bool
+}
+
+// Provider runs the authorization code flow against one OAuth2 server.
+type Provider interface {
+	Name() string
+	AuthCodeURL(state string) string
+	Exchange(ctx context.Context, code string) (*Token, error)
+	UserInfo(ctx context.Context, token *Token) (*UserInfo, error)
+}
+
+type Config struct {
+	Driver        string   `mapstructure:"driver"`
+	ClientID      string   `mapstructure:"client_id"`
+	ClientSecret  string   `mapstructure:"client_secret"`
+	AuthURL       string   `mapstructure:"auth_url"`
+	TokenURL      string   `mapstructure:"token_url"`
+	UserInfoURL   string   `mapstructure:"user_info_url"`
+	RedirectURL   string   `mapstructure:"redirect_url"`
+	Scopes        []string `mapstructure:"scopes"`
+	IDField       string   `mapstructure:"id_field"`
+	UsernameField string   `mapstructure:"username_field"`
+	EmailField    string   `mapstructure:"email_field"`
+	// Field telling the email is verified, the email is taken as unverified
+	// without it
+	EmailVerifiedField string `mapstructure:"email_verified_field"`
+}
+
+// Driver builds a provider from its config, name is the upper case provider name.
+type Driver func(name string, config Config) (Provider, error)
+
+const DefaultDriver = "generic"
+
+var (
+	driversLock sync.RWMutex
+	drivers     = map[string]Driver{
+		DefaultDriver: NewGenericProvider,
+	}
+)
+
+func RegisterDriver(name string, driver Driver) {
+	driversLock.Lock()
+	defer driversLock.Unlock()
+	drivers[name] = driver
+}
+
+func NewProvider(name string, config Config) (Provider, error) {
+	if config.Driver == "" {
+		config.Driver = DefaultDriver
+	}
+
+	driversLock.RLock()
+	driver, ok := drivers[config.Driver]
+	driversLock.RUnlock()
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	return driver(strings.ToUpper(name), config)
+}
+
+// NewProviders builds all configured providers keyed by upper case name.
+func NewProviders(configs map[string]Config) (map[string]Provider, error) {
+	providers := make(map[string]Provider, len(configs))
+	for name, config := range configs {
+		provider, err := NewProvider(name, config)
+		if err != nil {
+			return nil, err
+		}
+		providers[provider.Name()] = provider
+	}
+	return providers, nil
+}
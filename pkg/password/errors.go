@@ -0,0 +1,21 @@
+package password
+
+import "errors"
+
+var (
+	ErrTooShort = errors.New("password is too short")
+
+	ErrTooLong = errors.New("password is too long")
+
+	ErrMissingClasses = errors.New("password misses required character classes")
+
+	ErrTooCommon = errors.New("password is too common")
+)
+
+var (
+	ErrHashAlgorithmNotSupport = errors.New("password hash algorithm not support")
+
+	ErrHashConfigInvalid = errors.New("password hash config is invalid")
+
+	ErrHashInvalid = errors.New("password hash is invalid")
+)
@@ -0,0 +1,194 @@
+package password
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+)
+
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+var argon2Encoding = base64.RawStdEncoding
+
+// HashConfig picks the algorithm new hashes are made with, scanned from
+// config like Policy.
+type HashConfig struct {
+	Algorithm  string `mapstructure:"algorithm"`
+	BcryptCost int    `mapstructure:"bcrypt_cost"`
+	// Iterations, memory in KiB and parallelism of argon2id
+	Argon2Time    uint32 `mapstructure:"argon2_time"`
+	Argon2Memory  uint32 `mapstructure:"argon2_memory"`
+	Argon2Threads uint8  `mapstructure:"argon2_threads"`
+	Argon2KeyLen  uint32 `mapstructure:"argon2_key_len"`
+	Argon2SaltLen uint32 `mapstructure:"argon2_salt_len"`
+	// Argon2id hashes made or verified at once, others wait, so memory stays
+	// below Argon2Memory times this
+	Argon2MaxConcurrent int `mapstructure:"argon2_max_concurrent"`
+}
+
+// DefaultHashConfig is the OWASP baseline of argon2id, 19 MiB and 2
+// iterations. More memory is stronger but each login takes it, lower
+// Argon2MaxConcurrent to keep the peak when raising it.
+func DefaultHashConfig() HashConfig {
+	return HashConfig{
+		Algorithm:           Argon2id,
+		BcryptCost:          bcrypt.DefaultCost,
+		Argon2Time:          2,
+		Argon2Memory:        19 * 1024,
+		Argon2Threads:       1,
+		Argon2KeyLen:        32,
+		Argon2SaltLen:       16,
+		Argon2MaxConcurrent: 8,
+	}
+}
+
+// Hasher makes hashes with the configured algorithm and parameters, which are
+// kept in the hash, and verifies hashes of every supported algorithm.
+type Hasher interface {
+	Hash(password string) ([]byte, error)
+	// Verify tells whether password matches hash, and whether hash should be
+	// made again since it isn't of the current algorithm or parameters.
+	Verify(hash []byte, password string) (match bool, rehash bool, err error)
+}
+
+func NewHasher(config HashConfig) (Hasher, error) {
+	switch config.Algorithm {
+	case Argon2id:
+		if config.Argon2Time == 0 || config.Argon2Memory == 0 || config.Argon2Threads == 0 ||
+			config.Argon2KeyLen == 0 || config.Argon2SaltLen == 0 {
+			return nil, ErrHashConfigInvalid
+		}
+	case Bcrypt:
+		if config.BcryptCost < bcrypt.MinCost || config.BcryptCost > bcrypt.MaxCost {
+			return nil, ErrHashConfigInvalid
+		}
+	default:
+		return nil, ErrHashAlgorithmNotSupport
+	}
+	// Older argon2id hashes are verified even when bcrypt is configured
+	if config.Argon2MaxConcurrent <= 0 {
+		return nil, ErrHashConfigInvalid
+	}
+	return &hasher{
+		config: config,
+		argon2: make(chan struct{}, config.Argon2MaxConcurrent),
+	}, nil
+}
+
+type hasher struct {
+	config HashConfig
+	// Semaphore of argon2id derivations
+	argon2 chan struct{}
+}
+
+func (h *hasher) derive(params *argon2Params, password string, keyLen uint32) []byte {
+	h.argon2 <- struct{}{}
+	defer func() { <-h.argon2 }()
+	return params.derive(password, keyLen)
+}
+
+func (h *hasher) Hash(password string) ([]byte, error) {
+	if h.config.Algorithm == Bcrypt {
+		return bcrypt.GenerateFromPassword([]byte(password), h.config.BcryptCost)
+	}
+
+	params := argon2Params{
+		time:    h.config.Argon2Time,
+		memory:  h.config.Argon2Memory,
+		threads: h.config.Argon2Threads,
+		salt:    make([]byte, h.config.Argon2SaltLen),
+	}
+	if _, err := rand.Read(params.salt); err != nil {
+		return nil, err
+	}
+	params.key = h.derive(&params, password, h.config.Argon2KeyLen)
+	return params.encode(), nil
+}
+
+func (h *hasher) Verify(hash []byte, password string) (bool, bool, error) {
+	if bytes.HasPrefix(hash, []byte("$"+Argon2id+"$")) {
+		params, err := decodeArgon2(hash)
+		if err != nil {
+			return false, false, err
+		}
+		key := h.derive(params, password, uint32(len(params.key)))
+		if subtle.ConstantTimeCompare(key, params.key) != 1 {
+			return false, false, nil
+		}
+		rehash := h.config.Algorithm != Argon2id ||
+			params.time != h.config.Argon2Time || params.memory != h.config.Argon2Memory ||
+			params.threads != h.config.Argon2Threads || uint32(len(params.key)) != h.config.Argon2KeyLen ||
+			uint32(len(params.salt)) != h.config.Argon2SaltLen
+		return true, rehash, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword(hash, []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
+	}
+	if h.config.Algorithm != Bcrypt {
+		return true, true, nil
+	}
+	cost, err := bcrypt.Cost(hash)
+	if err != nil {
+		return true, true, nil
+	}
+	return true, cost != h.config.BcryptCost, nil
+}
+
+// argon2Params is a hash in the PHC string format:
+// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (p *argon2Params) derive(password string, keyLen uint32) []byte {
+	return argon2.IDKey([]byte(password), p.salt, p.time, p.memory, p.threads, keyLen)
+}
+
+func (p *argon2Params) encode() []byte {
+	return []byte(fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", Argon2id, argon2.Version,
+		p.memory, p.time, p.threads,
+		argon2Encoding.EncodeToString(p.salt), argon2Encoding.EncodeToString(p.key)))
+}
+
+func decodeArgon2(hash []byte) (*argon2Params, error) {
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return nil, ErrHashInvalid
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, ErrHashInvalid
+	} else if version != argon2.Version {
+		return nil, ErrHashAlgorithmNotSupport
+	}
+
+	var p argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return nil, ErrHashInvalid
+	}
+	var err error
+	if p.salt, err = argon2Encoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrHashInvalid
+	}
+	if p.key, err = argon2Encoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return nil, ErrHashInvalid
+	}
+	return &p, nil
+}
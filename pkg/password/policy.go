@@ -0,0 +1,81 @@
+// Package password checks new passwords against a configurable policy and
+// hashes them with a configurable algorithm.
+package password
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Policy is scanned from config, fields not configured keep the values of
+// DefaultPolicy.
+type Policy struct {
+	MinLength     int  `mapstructure:"min_length"`
+	MaxLength     int  `mapstructure:"max_length"`
+	RequireLower  bool `mapstructure:"require_lower"`
+	RequireUpper  bool `mapstructure:"require_upper"`
+	RequireDigit  bool `mapstructure:"require_digit"`
+	RequireSymbol bool `mapstructure:"require_symbol"`
+	// Checked along with the common passwords built in, case insensitive
+	Denylist []string `mapstructure:"denylist"`
+	// Number of last passwords that can't be used again, 0 to allow any
+	HistorySize int `mapstructure:"history_size"`
+	// Days before a password expires, 0 never
+	MaxAgeDays int `mapstructure:"max_age_days"`
+}
+
+func DefaultPolicy() Policy {
+	return Policy{
+		MinLength: 8,
+		MaxLength: 128,
+	}
+}
+
+// MaxAge returns how long a password lasts, 0 if it never expires.
+func (p Policy) MaxAge() time.Duration {
+	if p.MaxAgeDays <= 0 {
+		return 0
+	}
+	return time.Duration(p.MaxAgeDays) * 24 * time.Hour
+}
+
+// Check returns the first rule password breaks, length is in characters.
+func (p Policy) Check(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length == 0 || length < p.MinLength {
+		return ErrTooShort
+	} else if p.MaxLength > 0 && length > p.MaxLength {
+		return ErrTooLong
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	if (p.RequireLower && !lower) || (p.RequireUpper && !upper) ||
+		(p.RequireDigit && !digit) || (p.RequireSymbol && !symbol) {
+		return ErrMissingClasses
+	}
+
+	folded := strings.ToLower(password)
+	if _, ok := commonPasswords[folded]; ok {
+		return ErrTooCommon
+	}
+	for _, v := range p.Denylist {
+		if strings.ToLower(v) == folded {
+			return ErrTooCommon
+		}
+	}
+	return nil
+}
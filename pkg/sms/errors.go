@@ -0,0 +1,15 @@
+package sms
+
+import "errors"
+
+var (
+	ErrDriverNotFound = errors.New("sms driver not found")
+
+	ErrMissingEndpoint = errors.New("sms endpoint is missing")
+
+	ErrMissingCredential = errors.New("sms credential is missing")
+
+	ErrSendFailed = errors.New("sms send failed")
+
+	ErrPhoneInvalid = errors.New("phone number is invalid")
+)
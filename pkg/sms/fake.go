@@ -0,0 +1,18 @@
+package sms
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+)
+
+type fakeSender struct{}
+
+// NewFakeSender is a driver for local development, messages are only logged.
+func NewFakeSender(config Config) (Sender, error) {
+	return &fakeSender{}, nil
+}
+
+func (s *fakeSender) Send(ctx context.Context, phone string, content string) error {
+	log.Infof("Fake SMS to %s: %s", phone, content)
+	return nil
+}
@@ -0,0 +1,44 @@
+package sms
+
+import "strings"
+
+// NormalizePhone converts a phone number to E.164. Number without "+" or
+// "00" prefix is treated as national number of defaultCallingCode, its
+// trunk prefix 0 is dropped. Separators like space, "-", "." and
+// brackets are ignored.
+func NormalizePhone(phone string, defaultCallingCode string) (string, error) {
+	var digits strings.Builder
+	for i, c := range strings.TrimSpace(phone) {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+		case c == '+' && i == 0:
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')':
+		default:
+			return "", ErrPhoneInvalid
+		}
+	}
+	number := digits.String()
+
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(phone), "+"):
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	case defaultCallingCode != "":
+		number = strings.TrimPrefix(defaultCallingCode, "+") + strings.TrimLeft(number, "0")
+	default:
+		return "", ErrPhoneInvalid
+	}
+
+	// E.164 allows at most 15 digits and country code never starts with 0
+	if len(number) < 8 || len(number) > 15 || number[0] == '0' {
+		return "", ErrPhoneInvalid
+	}
+	return "+" + number, nil
+}
+
+// IsE164 reports whether phone is already normalized.
+func IsE164(phone string) bool {
+	normalized, err := NormalizePhone(phone, "")
+	return err == nil && normalized == phone
+}
@@ -0,0 +1,53 @@
+package sms
+
+import (
+	"context"
+	"sync"
+)
+
+// Sender delivers a text message to a phone number in E.164 format.
+type Sender interface {
+	Send(ctx context.Context, phone string, content string) error
+}
+
+type Config struct {
+	Driver   string `mapstructure:"driver"`
+	Endpoint string `mapstructure:"endpoint"`
+	Account  string `mapstructure:"account"`
+	Token    string `mapstructure:"token"`
+	From     string `mapstructure:"from"`
+}
+
+// Driver builds a sender from its config.
+type Driver func(config Config) (Sender, error)
+
+const DefaultDriver = "webhook"
+
+var (
+	driversLock sync.RWMutex
+	drivers     = map[string]Driver{
+		DefaultDriver: NewWebhookSender,
+		"twilio":      NewTwilioSender,
+		"fake":        NewFakeSender,
+	}
+)
+
+func RegisterDriver(name string, driver Driver) {
+	driversLock.Lock()
+	defer driversLock.Unlock()
+	drivers[name] = driver
+}
+
+func NewSender(config Config) (Sender, error) {
+	if config.Driver == "" {
+		config.Driver = DefaultDriver
+	}
+
+	driversLock.RLock()
+	driver, ok := drivers[config.Driver]
+	driversLock.RUnlock()
+	if !ok {
+		return nil, ErrDriverNotFound
+	}
+	return driver(config)
+}
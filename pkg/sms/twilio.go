@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const twilioEndpoint = "https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json"
+
+type twilioSender struct {
+	config Config
+	client *http.Client
+}
+
+// NewTwilioSender is a driver for the Twilio messages API, account is the
+// account SID and token the auth token.
+func NewTwilioSender(config Config) (Sender, error) {
+	if config.Account == "" || config.Token == "" || config.From == "" {
+		return nil, ErrMissingCredential
+	}
+
+	if config.Endpoint == "" {
+		config.Endpoint = twilioEndpoint
+	}
+	config.Endpoint = strings.Replace(config.Endpoint, "%s", url.PathEscape(config.Account), 1)
+
+	return &twilioSender{
+		config: config,
+		client: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+func (s *twilioSender) Send(ctx context.Context, phone string, content string) error {
+	form := url.Values{
+		"From": {s.config.From},
+		"To":   {phone},
+		"Body": {content},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.config.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(s.config.Account, s.config.Token)
+
+	return doSend(s.client, req)
+}
@@ -0,0 +1,68 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const defaultHTTPTimeout = 10 * time.Second
+
+type webhookSender struct {
+	config Config
+	client *http.Client
+}
+
+// NewWebhookSender is a driver posting the message as JSON to endpoint,
+// for gateways fronted by our own adapter. Token is sent as bearer if set.
+func NewWebhookSender(config Config) (Sender, error) {
+	if config.Endpoint == "" {
+		return nil, ErrMissingEndpoint
+	}
+
+	return &webhookSender{
+		config: config,
+		client: &http.Client{Timeout: defaultHTTPTimeout},
+	}, nil
+}
+
+func (s *webhookSender) Send(ctx context.Context, phone string, content string) error {
+	body, err := json.Marshal(map[string]string{
+		"from":    s.config.From,
+		"to":      phone,
+		"content": content,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.config.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if s.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.config.Token)
+	}
+
+	return doSend(s.client, req)
+}
+
+func doSend(client *http.Client, req *http.Request) error {
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		buf, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("%v: status %d %s", ErrSendFailed, res.StatusCode, buf)
+	}
+	return nil
+}
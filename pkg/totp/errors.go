@@ -0,0 +1,5 @@
+package totp
+
+import "errors"
+
+var ErrSecretInvalid = errors.New("totp secret is invalid")